@@ -17,7 +17,7 @@ import (
 
 func NewGetCommand() *cobra.Command {
 	var output = common.EnumFlagValue{
-		AllowedValues: []string{"json", "yaml", "wide"},
+		AllowedValues: []string{"json", "yaml", "wide", common.GraphFormatDot, common.GraphFormatMermaid},
 		Value:         "wide",
 	}
 	command := &cobra.Command{
@@ -29,6 +29,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get information about an archived workflow in YAML format:
   argo archive get abc123-def456-ghi789-jkl012 -o yaml
+
+# Render the template and node graph of an archived workflow in DOT format:
+  argo archive get abc123-def456-ghi789-jkl012 -o dot
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			uid := args[0]
@@ -68,6 +71,12 @@ func printWorkflow(wf *wfv1.Workflow, output string) {
 			log.Fatal(err)
 		}
 		fmt.Println(string(output))
+	case common.GraphFormatDot, common.GraphFormatMermaid:
+		graph, err := common.PrintWorkflowGraph(wf, output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Print(graph)
 	default:
 		const fmtStr = "%-20s %v\n"
 		fmt.Printf(fmtStr, "Name:", wf.Name)
@@ -19,6 +19,7 @@ import (
 func NewListCommand() *cobra.Command {
 	var (
 		selector  string
+		query     string
 		output    = common.NewPrintWorkflowOutputValue("wide")
 		chunkSize int64
 	)
@@ -36,6 +37,9 @@ func NewListCommand() *cobra.Command {
 
 # List archived workflows that have both labels:
   argo archive list -l key1=value1,key2=value2
+
+# List archived workflows that have a node that was OOM killed:
+  argo archive list --query 'node.message~OOMKilled'
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
@@ -47,7 +51,7 @@ func NewListCommand() *cobra.Command {
 				return err
 			}
 			namespace := client.Namespace(ctx)
-			workflows, err := listArchivedWorkflows(ctx, serviceClient, namespace, selector, chunkSize)
+			workflows, err := listArchivedWorkflows(ctx, serviceClient, namespace, selector, query, chunkSize)
 			if err != nil {
 				return err
 			}
@@ -56,11 +60,12 @@ func NewListCommand() *cobra.Command {
 	}
 	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&query, "query", "", "Search query to filter on, a space separated list of terms: param.NAME=VALUE, template=NAME, node.phase=PHASE, node.message~TEXT, $.JSONPATH=VALUE, $.JSONPATH~TEXT or TEXT (e.g. --query 'param.message=hello node.phase=Failed')")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	return command
 }
 
-func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace string, labelSelector string, chunkSize int64) (wfv1.Workflows, error) {
+func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace string, labelSelector string, query string, chunkSize int64) (wfv1.Workflows, error) {
 	listOpts := &metav1.ListOptions{
 		LabelSelector: labelSelector,
 		Limit:         chunkSize,
@@ -69,7 +74,7 @@ func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg
 	for {
 		logger := logging.RequireLoggerFromContext(ctx)
 		logger.WithField("listOpts", listOpts).Debug(ctx, "Listing archived workflows")
-		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: namespace, ListOptions: listOpts})
+		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{Namespace: namespace, ListOptions: listOpts, Query: query})
 		if err != nil {
 			return nil, err
 		}
@@ -20,5 +20,6 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewListLabelValueCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewRetryCommand())
+	command.AddCommand(NewStatsCommand())
 	return command
 }
@@ -0,0 +1,139 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	argotime "github.com/argoproj/pkg/time"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewStatsCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		groupBy       = common.EnumFlagValue{AllowedValues: []string{"namespace", "workflowTemplate", "cronWorkflow", "label"}, Value: "namespace"}
+		labelKey      string
+		selector      string
+		since         string
+		output        = common.EnumFlagValue{AllowedValues: []string{"json", "yaml", "wide"}}
+	)
+	command := &cobra.Command{
+		Use:   "stats",
+		Short: "show success rate, duration percentiles and resources duration of archived workflows",
+		Example: `# Show the statistics of archived workflows by namespace:
+  argo archive stats -A
+
+# Show the statistics of archived workflows started in the last 7 days, by workflow template:
+  argo archive stats --group-by workflowTemplate --since 7d
+
+# Show the statistics of archived workflows by the value of the "team" label:
+  argo archive stats --group-by label --label-key team
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if allNamespaces {
+				namespace = ""
+			}
+			listOpts := &metav1.ListOptions{LabelSelector: selector}
+			if since != "" {
+				t, err := argotime.ParseSince(since)
+				if err != nil {
+					return err
+				}
+				listOpts.FieldSelector = "spec.startedAt>" + t.Format(time.RFC3339)
+			}
+			resp, err := serviceClient.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{
+				ListOptions: listOpts,
+				Namespace:   namespace,
+				GroupBy:     groupBy.String(),
+				LabelKey:    labelKey,
+			})
+			if err != nil {
+				return err
+			}
+			return printStats(os.Stdout, resp, groupBy.String(), output.String())
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show workflows from all namespaces")
+	command.Flags().Var(&groupBy, "group-by", "Group workflows by. "+groupBy.Usage())
+	command.Flags().StringVar(&labelKey, "label-key", "", "The label key to group workflows by, required with --group-by label")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&since, "since", "", "Only include workflows started after a relative duration (e.g. 10m, 3h, 7d)")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printStats(out io.Writer, resp *workflowarchivepkg.ArchivedWorkflowStatsResponse, groupBy, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(resp, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, string(data))
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintf(w, "%s\tTOTAL\tSUCCEEDED\tFAILED\tERRORED\tSUCCESS RATE\tP50 DURATION\tP95 DURATION", strings.ToUpper(groupBy))
+	if output == "wide" {
+		_, _ = fmt.Fprint(w, "\tRESOURCES DURATION")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, s := range resp.Items {
+		group := s.Group
+		if group == "" {
+			group = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.1f%%\t%s\t%s", group, s.Total, s.Succeeded, s.Failed, s.Errored, s.SuccessRate*100, secondsToDuration(s.P50Duration), secondsToDuration(s.P95Duration))
+		if output == "wide" {
+			_, _ = fmt.Fprintf(w, "\t%s", resourcesDurationString(s.ResourcesDuration))
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	return w.Flush()
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second)
+}
+
+func resourcesDurationString(resourcesDuration map[string]int64) string {
+	names := make([]string, 0, len(resourcesDuration))
+	for name := range resourcesDuration {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s*%s", time.Duration(resourcesDuration[name])*time.Second, name)
+	}
+	return strings.Join(parts, ",")
+}
@@ -88,11 +88,17 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 		if wf.Status.EstimatedDuration > 0 {
 			out += fmt.Sprintf(fmtStr, "EstimatedDuration:", humanize.Duration(wf.Status.EstimatedDuration.ToDuration()))
 		}
+		if wf.Status.EstimatedFinishedAt != nil {
+			out += fmt.Sprintf(fmtStr, "ETA:", humanize.Timestamp(wf.Status.EstimatedFinishedAt.Time))
+		}
 	}
 	out += fmt.Sprintf(fmtStr, "Progress:", wf.Status.Progress)
 	if !wf.Status.ResourcesDuration.IsZero() {
 		out += fmt.Sprintf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
 	}
+	if wf.Status.EstimatedCost != nil {
+		out += fmt.Sprintf(fmtStr, "EstimatedCost:", wf.Status.EstimatedCost.Value)
+	}
 	if len(wf.GetExecSpec().Arguments.Parameters) > 0 {
 		out += fmt.Sprintf(fmtStr, "Parameters:", "")
 		for _, param := range wf.GetExecSpec().Arguments.Parameters {
@@ -148,7 +154,7 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 		out += "\n"
 		// apply a dummy FgDefault format to align tab writer with the rest of the columns
 		if getArgs.Output.String() == "wide" {
-			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tARTIFACTS\tMESSAGE\tRESOURCESDURATION\tNODENAME\n", ansiFormat("STEP", FgDefault))
+			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tARTIFACTS\tMESSAGE\tRESOURCESDURATION\tESTIMATEDCOST\tNODENAME\n", ansiFormat("STEP", FgDefault))
 		} else if getArgs.Output.String() == "short" {
 			_, _ = fmt.Fprintf(w, "%s\tTEMPLATE\tPODNAME\tDURATION\tMESSAGE\tNODENAME\n", ansiFormat("STEP", FgDefault))
 		} else {
@@ -490,23 +496,32 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, wfName, nodePrefix str
 	} else if node.TemplateName != "" {
 		fmtTemplateName = node.TemplateName
 	}
+	// a running node without a message shows the progress it reported instead
+	message := node.Message
+	if message == "" && node.Phase == wfv1.NodeRunning && node.ProgressDetail.IsValid() {
+		message = node.ProgressDetail.Summary()
+	}
 	var args []interface{}
 	duration := humanize.RelativeDurationShort(node.StartedAt.Time, node.FinishedAt.Time)
 	if node.Type == wfv1.NodeTypePod {
 		podName := util.GeneratePodName(wfName, nodeName, templateName, node.ID, podNameVersion)
-		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, podName, duration, node.Message, ""}
+		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, podName, duration, message, ""}
 	} else {
-		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, "", "", node.Message, ""}
+		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, "", "", message, ""}
 	}
 	if getArgs.Output.String() == "wide" {
 		msg := args[len(args)-2]
 		args[len(args)-2] = getArtifactsString(node)
 		args[len(args)-1] = msg
-		args = append(args, node.ResourcesDuration, "")
+		estimatedCost := ""
+		if node.EstimatedCost != nil {
+			estimatedCost = string(node.EstimatedCost.Value)
+		}
+		args = append(args, node.ResourcesDuration, estimatedCost, "")
 		if node.Type == wfv1.NodeTypePod {
 			args[len(args)-1] = node.HostNodeName
 		}
-		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", args...)
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", args...)
 	} else if getArgs.Output.String() == "short" {
 		if node.Type == wfv1.NodeTypePod {
 			args[len(args)-1] = node.HostNodeName
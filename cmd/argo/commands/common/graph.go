@@ -0,0 +1,285 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const (
+	GraphFormatDot     = "dot"
+	GraphFormatMermaid = "mermaid"
+)
+
+type graphNode struct {
+	id    string
+	label string
+	// class is used to style the node, it is the lower-case node phase for executed nodes
+	class string
+}
+
+type graphEdge struct {
+	from string
+	to   string
+}
+
+type graphCluster struct {
+	id       string
+	label    string
+	nodes    []graphNode
+	clusters []graphCluster
+}
+
+type graph struct {
+	name     string
+	clusters []graphCluster
+	edges    []graphEdge
+}
+
+// PrintWorkflowGraph renders the template graph of the workflow's spec and the graph of its executed nodes
+func PrintWorkflowGraph(wf *wfv1.Workflow, format string) (string, error) {
+	g := &graph{name: wf.Name}
+	spec := newTemplateCluster(g, "spec", wf.GetExecSpec())
+	if spec != nil {
+		g.clusters = append(g.clusters, *spec)
+	}
+	if status := newNodeCluster(g, wf); status != nil {
+		g.clusters = append(g.clusters, *status)
+	}
+	return g.render(format)
+}
+
+// PrintWorkflowSpecGraph renders the template graph of a workflow spec, e.g. of a WorkflowTemplate
+func PrintWorkflowSpecGraph(name string, spec *wfv1.WorkflowSpec, format string) (string, error) {
+	g := &graph{name: name}
+	if c := newTemplateCluster(g, "spec", spec); c != nil {
+		g.clusters = append(g.clusters, *c)
+	}
+	return g.render(format)
+}
+
+// dagContext is a minimal common.DagContext used to resolve task dependencies without a running workflow
+type dagContext struct {
+	tasks map[string]*wfv1.DAGTask
+}
+
+func (d *dagContext) GetTask(_ context.Context, taskName string) *wfv1.DAGTask {
+	if task, ok := d.tasks[taskName]; ok {
+		return task
+	}
+	return &wfv1.DAGTask{Name: taskName}
+}
+
+func (d *dagContext) GetTaskDependencies(_ context.Context, _ string) []string {
+	return nil
+}
+
+func (d *dagContext) GetTaskFinishedAtTime(_ context.Context, _ string) time.Time {
+	return time.Time{}
+}
+
+func newTemplateCluster(g *graph, id string, spec *wfv1.WorkflowSpec) *graphCluster {
+	if spec == nil {
+		return nil
+	}
+	cluster := graphCluster{id: id, label: "spec"}
+	for _, tmpl := range spec.Templates {
+		prefix := id + "/" + tmpl.Name + "/"
+		c := graphCluster{id: prefix, label: tmpl.Name}
+		if tmpl.Name == spec.Entrypoint {
+			c.label += " (entrypoint)"
+		}
+		switch {
+		case tmpl.DAG != nil:
+			dctx := &dagContext{tasks: make(map[string]*wfv1.DAGTask)}
+			for i := range tmpl.DAG.Tasks {
+				dctx.tasks[tmpl.DAG.Tasks[i].Name] = &tmpl.DAG.Tasks[i]
+			}
+			for i := range tmpl.DAG.Tasks {
+				task := &tmpl.DAG.Tasks[i]
+				c.nodes = append(c.nodes, graphNode{id: prefix + task.Name, label: templateNodeLabel(task.Name, task.Template, task.TemplateRef)})
+				deps, _ := common.GetTaskDependencies(context.Background(), task, dctx)
+				names := make([]string, 0, len(deps))
+				for name := range deps {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					g.edges = append(g.edges, graphEdge{from: prefix + name, to: prefix + task.Name})
+				}
+			}
+		case tmpl.Steps != nil:
+			var previous []string
+			for i, group := range tmpl.Steps {
+				var current []string
+				for _, step := range group.Steps {
+					stepID := fmt.Sprintf("%s%d/%s", prefix, i, step.Name)
+					c.nodes = append(c.nodes, graphNode{id: stepID, label: templateNodeLabel(step.Name, step.Template, step.TemplateRef)})
+					for _, from := range previous {
+						g.edges = append(g.edges, graphEdge{from: from, to: stepID})
+					}
+					current = append(current, stepID)
+				}
+				previous = current
+			}
+		default:
+			continue
+		}
+		cluster.clusters = append(cluster.clusters, c)
+	}
+	if len(cluster.clusters) == 0 {
+		return nil
+	}
+	return &cluster
+}
+
+func templateNodeLabel(name, template string, templateRef *wfv1.TemplateRef) string {
+	if templateRef != nil {
+		return fmt.Sprintf("%s\n%s/%s", name, templateRef.Name, templateRef.Template)
+	}
+	if template != "" {
+		return fmt.Sprintf("%s\n%s", name, template)
+	}
+	return name
+}
+
+func newNodeCluster(g *graph, wf *wfv1.Workflow) *graphCluster {
+	if len(wf.Status.Nodes) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(wf.Status.Nodes))
+	for id := range wf.Status.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	cluster := graphCluster{id: "status", label: "status"}
+	for _, id := range ids {
+		node := wf.Status.Nodes[id]
+		label := fmt.Sprintf("%s\n%s", node.DisplayName, node.Phase)
+		if !node.StartedAt.IsZero() {
+			label += " " + humanize.RelativeDuration(node.StartedAt.Time, node.FinishedAt.Time)
+		}
+		cluster.nodes = append(cluster.nodes, graphNode{id: "status/" + id, label: label, class: strings.ToLower(string(node.Phase))})
+		for _, child := range node.Children {
+			g.edges = append(g.edges, graphEdge{from: "status/" + id, to: "status/" + child})
+		}
+	}
+	return &cluster
+}
+
+func (g *graph) render(format string) (string, error) {
+	switch format {
+	case GraphFormatDot:
+		return g.renderDot(), nil
+	case GraphFormatMermaid:
+		return g.renderMermaid(), nil
+	default:
+		return "", fmt.Errorf("unknown graph format: %s", format)
+	}
+}
+
+var dotPhaseColors = map[string]string{
+	"succeeded": "palegreen",
+	"failed":    "lightcoral",
+	"error":     "lightcoral",
+	"running":   "lightskyblue",
+	"pending":   "lightyellow",
+	"skipped":   "lightgrey",
+	"omitted":   "lightgrey",
+}
+
+func (g *graph) renderDot() string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "digraph %q {\n", g.name)
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+	var writeCluster func(c graphCluster, indent string)
+	writeCluster = func(c graphCluster, indent string) {
+		fmt.Fprintf(sb, "%ssubgraph %q {\n", indent, "cluster_"+c.id)
+		fmt.Fprintf(sb, "%s  label=%q;\n", indent, c.label)
+		for _, n := range c.nodes {
+			if color, ok := dotPhaseColors[n.class]; ok {
+				fmt.Fprintf(sb, "%s  %q [label=%q, fillcolor=%s];\n", indent, n.id, n.label, color)
+			} else {
+				fmt.Fprintf(sb, "%s  %q [label=%q];\n", indent, n.id, n.label)
+			}
+		}
+		for _, child := range c.clusters {
+			writeCluster(child, indent+"  ")
+		}
+		fmt.Fprintf(sb, "%s}\n", indent)
+	}
+	for _, c := range g.clusters {
+		writeCluster(c, "  ")
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(sb, "  %q -> %q;\n", e.from, e.to)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+var mermaidPhaseStyles = map[string]string{
+	"succeeded": "fill:#98fb98",
+	"failed":    "fill:#f08080",
+	"error":     "fill:#f08080",
+	"running":   "fill:#87cefa",
+	"pending":   "fill:#ffffe0",
+	"skipped":   "fill:#d3d3d3",
+	"omitted":   "fill:#d3d3d3",
+}
+
+func (g *graph) renderMermaid() string {
+	// Mermaid identifiers cannot contain most punctuation, so we assign short, stable identifiers
+	ids := make(map[string]string)
+	idFor := func(id string) string {
+		if v, ok := ids[id]; ok {
+			return v
+		}
+		v := fmt.Sprintf("n%d", len(ids))
+		ids[id] = v
+		return v
+	}
+	escape := func(label string) string {
+		label = strings.ReplaceAll(label, `"`, "#quot;")
+		return strings.ReplaceAll(label, "\n", "<br/>")
+	}
+	sb := &strings.Builder{}
+	sb.WriteString("flowchart TD\n")
+	classes := make(map[string]bool)
+	var writeCluster func(c graphCluster, indent string)
+	writeCluster = func(c graphCluster, indent string) {
+		fmt.Fprintf(sb, "%ssubgraph %s[\"%s\"]\n", indent, idFor("cluster_"+c.id), escape(c.label))
+		for _, n := range c.nodes {
+			fmt.Fprintf(sb, "%s  %s[\"%s\"]\n", indent, idFor(n.id), escape(n.label))
+			if _, ok := mermaidPhaseStyles[n.class]; ok {
+				fmt.Fprintf(sb, "%s  class %s %s\n", indent, idFor(n.id), n.class)
+				classes[n.class] = true
+			}
+		}
+		for _, child := range c.clusters {
+			writeCluster(child, indent+"  ")
+		}
+		fmt.Fprintf(sb, "%send\n", indent)
+	}
+	for _, c := range g.clusters {
+		writeCluster(c, "  ")
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(sb, "  %s --> %s\n", idFor(e.from), idFor(e.to))
+	}
+	names := make([]string, 0, len(classes))
+	for name := range classes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(sb, "  classDef %s %s\n", name, mermaidPhaseStyles[name])
+	}
+	return sb.String()
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newGraphTestWorkflow() *wfv1.Workflow {
+	started := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(time.Minute))
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates: []wfv1.Template{
+				{
+					Name: "main",
+					DAG: &wfv1.DAGTemplate{Tasks: []wfv1.DAGTask{
+						{Name: "a", Template: "echo"},
+						{Name: "b", Template: "echo", Dependencies: []string{"a"}},
+						{Name: "c", Template: "echo", Depends: "a.Succeeded || b.Failed"},
+					}},
+				},
+				{
+					Name: "steps",
+					Steps: []wfv1.ParallelSteps{
+						{Steps: []wfv1.WorkflowStep{{Name: "x", Template: "echo"}}},
+						{Steps: []wfv1.WorkflowStep{{Name: "y", Template: "echo"}}},
+					},
+				},
+				{Name: "echo", Container: &corev1.Container{}},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"my-wf":   {ID: "my-wf", DisplayName: "my-wf", Phase: wfv1.NodeSucceeded, Children: []string{"my-wf-1"}, StartedAt: started, FinishedAt: finished},
+				"my-wf-1": {ID: "my-wf-1", DisplayName: "a", Phase: wfv1.NodeFailed, StartedAt: started, FinishedAt: finished},
+			},
+		},
+	}
+}
+
+func TestPrintWorkflowGraph(t *testing.T) {
+	wf := newGraphTestWorkflow()
+	t.Run("Dot", func(t *testing.T) {
+		out, err := PrintWorkflowGraph(wf, GraphFormatDot)
+		require.NoError(t, err)
+		assert.Contains(t, out, `digraph "my-wf" {`)
+		assert.Contains(t, out, `subgraph "cluster_spec/main/" {`)
+		assert.Contains(t, out, `label="main (entrypoint)";`)
+		assert.Contains(t, out, `"spec/main/a" -> "spec/main/b";`)
+		assert.Contains(t, out, `"spec/main/a" -> "spec/main/c";`)
+		assert.Contains(t, out, `"spec/main/b" -> "spec/main/c";`)
+		assert.Contains(t, out, `"spec/steps/0/x" -> "spec/steps/1/y";`)
+		assert.Contains(t, out, `"status/my-wf-1" [label="a\nFailed 1 minute 0 seconds", fillcolor=lightcoral];`)
+		assert.Contains(t, out, `"status/my-wf" -> "status/my-wf-1";`)
+		assert.NotContains(t, out, "spec/echo/")
+	})
+	t.Run("Mermaid", func(t *testing.T) {
+		out, err := PrintWorkflowGraph(wf, GraphFormatMermaid)
+		require.NoError(t, err)
+		assert.Contains(t, out, "flowchart TD\n")
+		assert.Contains(t, out, `["a<br/>Failed 1 minute 0 seconds"]`)
+		assert.Contains(t, out, "classDef failed fill:#f08080\n")
+		assert.Contains(t, out, "classDef succeeded fill:#98fb98\n")
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := PrintWorkflowGraph(wf, "svg")
+		require.Error(t, err)
+	})
+}
+
+func TestPrintWorkflowSpecGraph(t *testing.T) {
+	wf := newGraphTestWorkflow()
+	out, err := PrintWorkflowSpecGraph("my-tmpl", &wf.Spec, GraphFormatDot)
+	require.NoError(t, err)
+	assert.Contains(t, out, `digraph "my-tmpl" {`)
+	assert.Contains(t, out, `"spec/main/a" [label="a\necho"];`)
+	assert.NotContains(t, out, "status/")
+}
@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewControllerCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "controller",
+		Short: "inspect the workflow controller",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewTopCommand())
+	return command
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
+)
+
+func NewTopCommand() *cobra.Command {
+	var (
+		controllerURL string
+		sortBy        = common.EnumFlagValue{AllowedValues: stats.SortKeys, Value: stats.SortByDuration}
+		limit         int
+		output        = common.EnumFlagValue{AllowedValues: []string{"json", "yaml", "wide"}}
+	)
+	command := &cobra.Command{
+		Use:   "top",
+		Short: "show the workflows that are the most expensive for the controller to reconcile",
+		Long: `Show the workflows that are the most expensive for the controller to reconcile, since the controller started.
+
+The statistics are read from the /debug/reconcile endpoint of the leader, on port 6060, which is not exposed by a service. Port-forward to it first:
+
+  kubectl -n argo port-forward deploy/workflow-controller 6060:6060
+`,
+		Example: `# Show the 20 workflows that took the longest to reconcile in total:
+  argo controller top
+
+# Show the 5 workflows whose status updates wrote the most bytes:
+  argo controller top --sort statusBytes --limit 5
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			items, err := getTop(cmd.Context(), controllerURL, sortBy.String(), limit)
+			if err != nil {
+				return err
+			}
+			return printTop(os.Stdout, items, output.String())
+		},
+	}
+	command.Flags().StringVar(&controllerURL, "controller-url", "http://localhost:6060", "The URL of the debug endpoints of the leading workflow controller")
+	command.Flags().Var(&sortBy, "sort", "Sort workflows by. "+sortBy.Usage())
+	command.Flags().IntVar(&limit, "limit", 20, "The maximum number of workflows to show, 0 for all")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func getTop(ctx context.Context, controllerURL, sortBy string, limit int) ([]stats.Stats, error) {
+	query := url.Values{"sort": {sortBy}, "limit": {strconv.Itoa(limit)}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(controllerURL, "/")+"/debug/reconcile?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reconcile statistics from the controller: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get reconcile statistics from the controller: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	var items []stats.Stats
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func printTop(out io.Writer, items []stats.Stats, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(items, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, string(data))
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAMESPACE\tNAME\tRECONCILIATIONS\tTOTAL DURATION\tMAX DURATION\tAPI CALLS\tPOD CREATIONS\tSTATUS BYTES")
+	if output == "wide" {
+		_, _ = fmt.Fprint(w, "\tLAST DURATION\tLAST RECONCILED")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, s := range items {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%d\t%d\t%s", s.Namespace, s.Name, s.Reconciliations, roundDuration(s.TotalDuration), roundDuration(s.MaxDuration), s.APICalls, s.PodCreations, resource.NewQuantity(s.StatusBytes, resource.BinarySI))
+		if output == "wide" {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s", roundDuration(s.LastDuration), humanize.RelativeDurationShort(s.LastReconciled, time.Now()))
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	return w.Flush()
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
+)
+
+func TestTop(t *testing.T) {
+	tracker := stats.NewTracker()
+	tracker.Record("argo", "my-wf", stats.Reconciliation{Duration: 1500 * time.Microsecond, APICalls: 3, PodCreations: 1, StatusBytes: 2048})
+	tracker.Record("argo", "other-wf", stats.Reconciliation{Duration: time.Millisecond, APICalls: 10})
+	server := httptest.NewServer(tracker)
+	defer server.Close()
+
+	items, err := getTop(t.Context(), server.URL, stats.SortByAPICalls, 1)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "other-wf", items[0].Name)
+
+	items, err = getTop(t.Context(), server.URL, stats.SortByDuration, 0)
+	require.NoError(t, err)
+	out := &bytes.Buffer{}
+	require.NoError(t, printTop(out, items, ""))
+	assert.Equal(t, `NAMESPACE   NAME       RECONCILIATIONS   TOTAL DURATION   MAX DURATION   API CALLS   POD CREATIONS   STATUS BYTES
+argo        my-wf      1                 2ms              2ms            3           1               2Ki
+argo        other-wf   1                 1ms              1ms            10          0               0
+`, out.String())
+
+	_, err = getTop(t.Context(), server.URL, "foo", 0)
+	require.ErrorContains(t, err, "400 Bad Request")
+}
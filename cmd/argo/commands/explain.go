@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+func NewExplainCommand() *cobra.Command {
+	output := common.EnumFlagValue{AllowedValues: []string{"json", "yaml", "wide"}}
+	command := &cobra.Command{
+		Use:   "explain WORKFLOW [NODE]",
+		Short: "explain why the controller did not start or run the nodes of a workflow",
+		Long:  "Print the decision log of a workflow: when and why the controller did not start or run its nodes, e.g. because of parallelism, a synchronization lock, a when expression or the pod creation rate limit.",
+		Example: `# Explain a workflow:
+
+  argo explain my-wf
+
+# Explain a node of a workflow, by its ID, name or display name:
+
+  argo explain my-wf my-wf.step-a
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			req := &workflowpkg.WorkflowExplainRequest{
+				Name:      args[0],
+				Namespace: client.Namespace(ctx),
+			}
+			if len(args) == 2 {
+				req.Node = args[1]
+			}
+			res, err := serviceClient.ExplainWorkflow(ctx, req)
+			if err != nil {
+				return err
+			}
+			return printDecisions(os.Stdout, res.Decisions, output.String())
+		},
+	}
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printDecisions(out io.Writer, decisions []*wfv1.Decision, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(decisions, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(decisions)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, string(data))
+		return err
+	case "wide", "":
+		if len(decisions) == 0 {
+			_, err := fmt.Fprintln(out, "No decisions found")
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "AGE\tNODE\tREASON\tMESSAGE")
+		for _, decision := range decisions {
+			node := decision.NodeName
+			if node == "" {
+				node = "-"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", humanize.RelativeDurationShort(decision.Time.Time, time.Now()), node, decision.Reason, decision.Message)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+}
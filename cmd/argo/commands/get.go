@@ -16,7 +16,7 @@ import (
 func NewGetCommand() *cobra.Command {
 	var getArgs = common.GetFlags{
 		Output: common.EnumFlagValue{
-			AllowedValues: []string{"name", "json", "yaml", "short", "wide"},
+			AllowedValues: []string{"name", "json", "yaml", "short", "wide", common.GraphFormatDot, common.GraphFormatMermaid},
 		},
 	}
 
@@ -29,6 +29,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Render the template and node graph of a workflow as a Mermaid diagram:
+  argo get my-wf -o mermaid
 `,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,6 +78,12 @@ func printWorkflow(wf *wfv1.Workflow, getArgs common.GetFlags) error {
 		fmt.Print(string(outBytes))
 	case "short", "wide", "":
 		fmt.Print(common.PrintWorkflowHelper(wf, getArgs))
+	case common.GraphFormatDot, common.GraphFormatMermaid:
+		out, err := common.PrintWorkflowGraph(wf, getArgs.Output.String())
+		if err != nil {
+			return err
+		}
+		fmt.Print(out)
 	default:
 		return fmt.Errorf("unknown output format: %s", getArgs.Output)
 	}
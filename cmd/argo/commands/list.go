@@ -36,6 +36,7 @@ type listFlags struct {
 	noHeaders      bool
 	labels         string
 	fields         string
+	query          string
 }
 
 var (
@@ -91,6 +92,9 @@ func NewListCommand() *cobra.Command {
 
 # List workflows that have both labels:
   argo list -l label1=value1,label2=value2
+
+# List workflows run with a parameter that have a failed node:
+  argo list --query 'param.message=hello node.phase=Failed'
 `,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +130,7 @@ func NewListCommand() *cobra.Command {
 	command.Flags().Int64VarP(&listArgs.chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	command.Flags().BoolVar(&listArgs.noHeaders, "no-headers", false, "Don't print headers (default print headers).")
 	command.Flags().StringVarP(&listArgs.labels, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&listArgs.query, "query", "", "Search query to filter on, a space separated list of terms: param.NAME=VALUE, template=NAME, node.phase=PHASE, node.message~TEXT, $.JSONPATH=VALUE, $.JSONPATH~TEXT or TEXT (e.g. --query 'param.message=hello node.phase=Failed')")
 	command.Flags().StringVar(&listArgs.fields, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
 }
@@ -168,6 +173,7 @@ func listWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServic
 			Namespace:   flags.namespace,
 			ListOptions: listOpts,
 			Fields:      flags.displayFields(),
+			Query:       flags.query,
 		})
 		if err != nil {
 			return nil, err
@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/controller"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/executorplugin"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
@@ -95,6 +96,7 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	}
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewExplainCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewListCommand())
@@ -113,6 +115,7 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
 	command.AddCommand(archive.NewArchiveCommand())
+	command.AddCommand(controller.NewControllerCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
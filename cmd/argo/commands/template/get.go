@@ -10,6 +10,7 @@ import (
 
 func NewGetCommand() *cobra.Command {
 	var output = common.NewPrintWorkflowOutputValue("")
+	output.AllowedValues = append(output.AllowedValues, common.GraphFormatDot, common.GraphFormatMermaid)
 
 	command := &cobra.Command{
 		Use:   "get WORKFLOW_TEMPLATE...",
@@ -20,6 +21,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get information about a workflow template in YAML format:
   argo template get my-template -o yaml
+
+# Render the template graph of a workflow template in DOT format:
+  argo template get my-template -o dot
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
@@ -8,6 +8,7 @@ import (
 
 	"sigs.k8s.io/yaml"
 
+	cmdcommon "github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/humanize"
 	argoJson "github.com/argoproj/argo-workflows/v3/util/json"
@@ -65,6 +66,12 @@ func printWorkflowTemplate(wf *wfv1.WorkflowTemplate, outFmt string) {
 		fmt.Print(string(outBytes))
 	case "wide", "":
 		printWorkflowTemplateHelper(wf)
+	case cmdcommon.GraphFormatDot, cmdcommon.GraphFormatMermaid:
+		out, err := cmdcommon.PrintWorkflowSpecGraph(wf.Name, &wf.Spec, outFmt)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Print(out)
 	default:
 		log.Fatalf("Unknown output format: %s", outFmt)
 	}
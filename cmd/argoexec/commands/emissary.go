@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -133,6 +134,26 @@ func NewEmissaryCommand() *cobra.Command {
 				}
 			}
 
+			stopArchivingCheckpoints := func() {}
+			if containerName == common.MainContainerName && template.Checkpoint != nil {
+				if err := executor.RestoreCheckpoint(ctx, template.Checkpoint); err != nil {
+					return fmt.Errorf("failed to restore checkpoint: %w", err)
+				}
+				stopArchivingCheckpoints, err = archiveCheckpoints(ctx, template.Checkpoint)
+				if err != nil {
+					return fmt.Errorf("failed to archive checkpoints: %w", err)
+				}
+			}
+
+			// sidecars are not measured, as they are not what the requests of the step are sized for
+			var resourceUsageSampler *executor.ResourceUsageSampler
+			if slices.Contains(template.GetMainContainerNames(), containerName) {
+				resourceUsageSampler = executor.NewResourceUsageSampler()
+				samplerCtx, stopSampling := context.WithCancel(ctx)
+				defer stopSampling()
+				go resourceUsageSampler.Run(samplerCtx)
+			}
+
 			backoff, err := template.GetRetryStrategy()
 			if err != nil {
 				return fmt.Errorf("failed to get retry strategy: %w", err)
@@ -212,6 +233,22 @@ func NewEmissaryCommand() *cobra.Command {
 			})
 			logger.WithError(cmdErr).Info(ctx, "sub-process exited")
 
+			stopArchivingCheckpoints()
+			if containerName == common.MainContainerName && template.Checkpoint != nil {
+				// archive the final checkpoint, even if the sub-process failed or was terminated, so a retry can restore it
+				if err := executor.ArchiveCheckpoint(ctx, template.Checkpoint); err != nil {
+					logger.WithError(err).Error(ctx, "failed to archive checkpoint")
+				}
+			}
+
+			if resourceUsageSampler != nil {
+				if usage, err := resourceUsageSampler.Usage(containerName); err != nil {
+					logger.WithError(err).Warn(ctx, "failed to measure resource usage")
+				} else if err := executor.WriteResourceUsage(usage); err != nil {
+					logger.WithError(err).Error(ctx, "failed to write resource usage")
+				}
+			}
+
 			if os.Getenv("ARGO_DEBUG_PAUSE_AFTER") == "true" {
 				for {
 					// User can create the file: /ctr/NAME_OF_THE_CONTAINER/after
@@ -236,6 +273,14 @@ func NewEmissaryCommand() *cobra.Command {
 			}
 
 			if containerName == common.MainContainerName {
+				var results *executor.Results
+				var resultsErr error
+				if template.Outputs.ResultsFile != "" {
+					if err := saveParameter(ctx, template.Outputs.ResultsFile); err != nil {
+						return err
+					}
+					results, resultsErr = executor.ReadResultsFile(template.Outputs.ResultsFile)
+				}
 				for _, x := range template.Outputs.Parameters {
 					if x.ValueFrom != nil && x.ValueFrom.Path != "" {
 						if err := saveParameter(ctx, x.ValueFrom.Path); err != nil {
@@ -244,6 +289,16 @@ func NewEmissaryCommand() *cobra.Command {
 					}
 				}
 				for _, x := range template.Outputs.Artifacts {
+					if x.ResultsKey != "" {
+						err := resultsErr
+						if results != nil {
+							x.Path, err = results.ArtifactPath(x.ResultsKey)
+						}
+						if err != nil { // might be optional, so we leave the wait container to report it
+							logger.WithField("name", x.Name).WithError(err).Warn(ctx, "cannot save artifact")
+							continue
+						}
+					}
 					if x.Path != "" {
 						if err := saveArtifact(ctx, x.Path); err != nil {
 							return err
@@ -269,6 +324,18 @@ func startCommand(ctx context.Context, name string, args []string, template *wfv
 	var stdout io.Writer = os.Stdout
 	var stderr io.Writer = os.Stderr
 
+	// the logs are wrapped in JSON, but the captured outputs are not
+	if os.Getenv(common.EnvVarContainerLogFormat) == "json" {
+		fields := emissary.LogFields()
+		stdoutw := emissary.NewLogWriter(os.Stdout, "stdout", fields)
+		stderrw := emissary.NewLogWriter(os.Stderr, "stderr", fields)
+		stdout, stderr = stdoutw, stderrw
+		closer = func() {
+			_ = stdoutw.Close()
+			_ = stderrw.Close()
+		}
+	}
+
 	// this may not be that important an optimisation, except for very long logs we don't want to capture
 	if includeScriptOutput || template.SaveLogsAsArtifact() {
 		logger.Info(ctx, "capturing logs")
@@ -283,7 +350,9 @@ func startCommand(ctx context.Context, name string, args []string, template *wfv
 		stdout = io.MultiWriter(stdout, stdoutf, combinedf)
 		stderr = io.MultiWriter(stderr, combinedf)
 
+		jsonCloser := closer
 		closer = func() {
+			jsonCloser()
 			_ = stdoutf.Close()
 			_ = combinedf.Close()
 		}
@@ -307,6 +376,36 @@ func startCommand(ctx context.Context, name string, args []string, template *wfv
 	return command, closer, nil
 }
 
+// archiveCheckpoints archives the checkpoint directory every interval, until the returned func is called
+func archiveCheckpoints(ctx context.Context, checkpoint *wfv1.Checkpoint) (func(), error) {
+	interval, err := checkpoint.GetInterval()
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := executor.ArchiveCheckpoint(ctx, checkpoint); err != nil {
+					logger.WithError(err).Warn(ctx, "failed to archive checkpoint")
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
+
 func saveArtifact(ctx context.Context, srcPath string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 
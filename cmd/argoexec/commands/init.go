@@ -3,9 +3,12 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/pkg/stats"
 	"github.com/spf13/cobra"
+
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
 )
 
 func NewInitCommand() *cobra.Command {
@@ -24,21 +27,37 @@ func NewInitCommand() *cobra.Command {
 }
 
 func loadArtifacts(ctx context.Context) error {
+	startTime := time.Now()
 	wfExecutor := initExecutor(ctx)
 	defer wfExecutor.HandleError(ctx)
 	defer stats.LogStats()
+	ctx, tracing := initTracing(ctx)
+	defer func() { _ = tracing.Shutdown(ctx) }()
+	metrics := initMetrics(ctx, wfExecutor)
+	defer func() {
+		metrics.PodOverhead(ctx, executormetrics.StageInit, time.Since(startTime))
+		_ = metrics.Shutdown(ctx)
+	}()
 
 	if err := wfExecutor.Init(); err != nil {
 		wfExecutor.AddError(ctx, err)
 		return err
 	}
 	// Download input artifacts
-	err := wfExecutor.StageFiles(ctx)
+	spanCtx, span := tracing.Start(ctx, "load artifacts")
+	err := wfExecutor.StageFiles(spanCtx)
+	if err != nil {
+		endSpan(span, err)
+		wfExecutor.AddError(ctx, err)
+		return err
+	}
+	err = wfExecutor.LoadArtifacts(spanCtx)
+	endSpan(span, err)
 	if err != nil {
 		wfExecutor.AddError(ctx, err)
 		return err
 	}
-	err = wfExecutor.LoadArtifacts(ctx)
+	err = wfExecutor.LoadCheckpoint(ctx)
 	if err != nil {
 		wfExecutor.AddError(ctx, err)
 		return err
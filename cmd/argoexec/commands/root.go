@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
@@ -23,9 +25,11 @@ import (
 	kubecli "github.com/argoproj/argo-workflows/v3/util/kube/cli"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/emissary"
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
 )
 
 const (
@@ -182,6 +186,36 @@ func initExecutor(ctx context.Context) *executor.WorkflowExecutor {
 	return &wfExecutor
 }
 
+// initTracing creates the tracing of the executor, which records its spans as children of the span of the node if
+// the controller exports traces. The returned context has the span of the node.
+func initTracing(ctx context.Context) (context.Context, *telemetry.Tracing) {
+	tracing, err := telemetry.NewTracing(ctx, CLIName, &telemetry.TracingConfig{})
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to create tracing")
+	}
+	return telemetry.ContextWithTraceparent(ctx, os.Getenv(telemetry.EnvVarTraceparent)), tracing
+}
+
+// initMetrics creates the metrics of the executor, which are only recorded if they are pushed with OTLP
+func initMetrics(ctx context.Context, wfExecutor *executor.WorkflowExecutor) *executormetrics.Metrics {
+	metrics, err := executormetrics.New(ctx, CLIName, wfExecutor.Namespace, wfExecutor.PodName)
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to create metrics")
+		return nil
+	}
+	wfExecutor.Metrics = metrics
+	return metrics
+}
+
+// endSpan ends the span, with the error if any
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 // checkErr is a convenience function to panic upon error
 func checkErr(err error) {
 	if err != nil {
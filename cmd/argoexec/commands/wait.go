@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
 )
 
 func NewWaitCommand() *cobra.Command {
@@ -38,12 +39,23 @@ func waitContainer(ctx context.Context) error {
 	defer wfExecutor.FinalizeOutput(bgCtx) // Ensures the LabelKeyReportOutputsCompleted is set to true.
 	defer stats.LogStats()
 	stats.StartStatsTicker(5 * time.Minute)
+	bgCtx, tracing := initTracing(bgCtx)
+	defer func() { _ = tracing.Shutdown(bgCtx) }()
+	metrics := initMetrics(bgCtx, wfExecutor)
+	var mainCompletedTime time.Time
+	defer func() {
+		if !mainCompletedTime.IsZero() {
+			metrics.PodOverhead(bgCtx, executormetrics.StageWait, time.Since(mainCompletedTime))
+		}
+		_ = metrics.Shutdown(bgCtx)
+	}()
 
 	// Create a new empty (placeholder) task result with LabelKeyReportOutputsCompleted set to false.
 	wfExecutor.InitializeOutput(bgCtx)
 
 	// Wait for main container to complete
 	err := wfExecutor.Wait(ctx)
+	mainCompletedTime = time.Now()
 	if err != nil {
 		wfExecutor.AddError(ctx, err)
 	}
@@ -57,6 +69,12 @@ func waitContainer(ctx context.Context) error {
 		return wfExecutor.HasError()
 	}
 
+	// Save the final checkpoint. This is not an error of the step, which may still have succeeded.
+	err = wfExecutor.SaveCheckpoint(bgCtx)
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "failed to save checkpoint")
+	}
+
 	// Capture output script result
 	err = wfExecutor.CaptureScriptResult(bgCtx)
 	if err != nil {
@@ -70,14 +88,16 @@ func waitContainer(ctx context.Context) error {
 	}
 
 	// Saving output artifacts
-	artifacts, err := wfExecutor.SaveArtifacts(bgCtx)
+	spanCtx, span := tracing.Start(bgCtx, "save artifacts")
+	artifacts, err := wfExecutor.SaveArtifacts(spanCtx)
 	if err != nil {
 		wfExecutor.AddError(ctx, err)
 	}
 
 	// Save log artifacts
-	logArtifacts := wfExecutor.SaveLogs(bgCtx)
+	logArtifacts := wfExecutor.SaveLogs(spanCtx)
 	artifacts = append(artifacts, logArtifacts...)
+	endSpan(span, err)
 
 	// Try to upsert TaskResult. If it fails, we will try to update the Pod's Annotations
 	err = wfExecutor.ReportOutputs(bgCtx, artifacts)
@@ -180,6 +180,7 @@ func NewRootCommand() *cobra.Command {
 				})
 			}
 			http.Handle("/healthz", controller.LogMiddleware(log, http.HandlerFunc(wfController.Healthz)))
+			http.Handle("/debug/reconcile", controller.LogMiddleware(log, http.HandlerFunc(wfController.ReconcileStats)))
 
 			go func() {
 				log.Error(ctx, http.ListenAndServe(":6060", nil).Error())
@@ -37,6 +37,11 @@ type Config struct {
 	// MainContainer holds container customization for the main container
 	MainContainer *apiv1.Container `json:"mainContainer,omitempty"`
 
+	// ContainerLogFormat is the format of the logs of the main containers, which the emissary writes. "json" wraps each
+	// line of their stdout and stderr in a JSON object with the workflow, node, template and retry attempt. Defaults to
+	// "text", which leaves the logs unchanged.
+	ContainerLogFormat ContainerLogFormat `json:"containerLogFormat,omitempty"`
+
 	// KubeConfig specifies a kube config file for the wait & init containers
 	KubeConfig *KubeConfig `json:"kubeConfig,omitempty"`
 
@@ -63,6 +68,10 @@ type Config struct {
 	// as metrics by default, but can be overridden using this config.
 	TelemetryConfig MetricsConfig `json:"telemetryConfig,omitempty"`
 
+	// TracingConfig specifies configuration for OpenTelemetry tracing of workflows and API calls. Tracing is disabled
+	// by default, unless the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variable is set.
+	TracingConfig TracingConfig `json:"tracingConfig,omitempty"`
+
 	// Parallelism limits the max total parallel workflows that can execute at the same time
 	Parallelism int `json:"parallelism,omitempty"`
 
@@ -118,8 +127,19 @@ type Config struct {
 
 	// Synchronization via databases config
 	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
+	// Pricing contains the prices used to estimate the cost of workflows. Costs are not estimated if it is not set.
+	Pricing *PricingConfig `json:"pricing,omitempty"`
 }
 
+// ContainerLogFormat is the format of the logs of the main containers
+type ContainerLogFormat string
+
+const (
+	ContainerLogFormatText ContainerLogFormat = "text"
+	ContainerLogFormatJSON ContainerLogFormat = "json"
+)
+
 func (c Config) GetExecutor() *apiv1.Container {
 	if c.Executor != nil {
 		return c.Executor
@@ -208,6 +228,8 @@ type PersistConfig struct {
 	DBConfig
 	// NodeStatusOffload saves node status only to the persistence DB to avoid the 1MB limit in etcd
 	NodeStatusOffload bool `json:"nodeStatusOffLoad,omitempty"`
+	// DecisionLogOffload saves the decision logs of workflows only to the persistence DB, rather than in their status
+	DecisionLogOffload bool `json:"decisionLogOffload,omitempty"`
 	// Archive completed and Workflows to persistence so you can access them after they're
 	// removed from kubernetes
 	Archive bool `json:"archive,omitempty"`
@@ -354,6 +376,35 @@ type MetricsConfig struct {
 	// Enum of Cumulative or Delta, defaulting to Cumulative.
 	// No effect on Prometheus metrics, which are always Cumulative.
 	Temporality MetricsTemporality `json:"temporality,omitempty"`
+	// CustomMetrics limits the cardinality of the custom metrics of templates
+	CustomMetrics *CustomMetricsPolicy `json:"customMetrics,omitempty"`
+}
+
+// CustomMetricsPolicy limits the labels and the number of series of the custom metrics of templates, so that a label
+// such as the name of a workflow cannot create a series per workflow
+type CustomMetricsPolicy struct {
+	// AllowedLabels are the keys of the only labels that custom metrics may have, other labels are dropped. Default allows all labels
+	AllowedLabels []string `json:"allowedLabels,omitempty"`
+	// DeniedLabels are the keys of labels that are dropped from custom metrics
+	DeniedLabels []string `json:"deniedLabels,omitempty"`
+	// MaxSeriesPerNamespace is the maximum number of series of custom metrics that the workflows of a namespace may create. Default is unlimited
+	MaxSeriesPerNamespace int `json:"maxSeriesPerNamespace,omitempty"`
+	// AggregateLabels are the keys of the labels that are dropped from a metric that would create a series beyond
+	// MaxSeriesPerNamespace, so that it is aggregated into an existing series. If there is no such series, the metric is not recorded
+	AggregateLabels []string `json:"aggregateLabels,omitempty"`
+}
+
+// TracingConfig defines how traces are exported with OTLP over gRPC
+type TracingConfig struct {
+	// Enabled turns on the export of traces. Default is false
+	Enabled bool `json:"enabled,omitempty"`
+	// Endpoint is the host:port of the OTLP collector. Defaults to the OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable, or "localhost:4317"
+	Endpoint string `json:"endpoint,omitempty"`
+	// Insecure disables TLS to the collector
+	Insecure bool `json:"insecure,omitempty"`
+	// Headers are sent to the collector with each export, e.g. for authentication
+	Headers map[string]string `json:"headers,omitempty"`
 }
 
 func (mc *MetricsConfig) GetSecure(defaultValue bool) bool {
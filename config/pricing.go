@@ -0,0 +1,74 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// LabelKeyInstanceType is the well-known label of the instance type of a node
+const LabelKeyInstanceType = "node.kubernetes.io/instance-type"
+
+// Prices are the prices of resources per hour, for one CPU, one Gi of memory, storage or ephemeral storage, or one
+// unit of any other resource, e.g. one GPU
+type Prices map[apiv1.ResourceName]float64
+
+// PricingConfig contains the prices the controller uses to estimate the cost of workflows
+type PricingConfig struct {
+	// Resources are the default prices of the resources of pods, e.g. {"cpu": 0.03, "memory": 0.004}
+	Resources Prices `json:"resources,omitempty"`
+	// Rules override the prices of the resources of the pods that match them. The first matching rule is used.
+	Rules []PricingRule `json:"rules,omitempty"`
+	// StorageClasses are the prices of one Gi of persistent volume claims per hour, by storage class.
+	// Use "" for the claims without a storage class.
+	StorageClasses map[string]float64 `json:"storageClasses,omitempty"`
+}
+
+// PricingRule overrides the prices of the resources of the pods that match all of its selectors
+type PricingRule struct {
+	// NodeSelector matches the pods whose node selector has all of these labels
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// InstanceType matches the pods whose node selector has this node.kubernetes.io/instance-type label
+	InstanceType string `json:"instanceType,omitempty"`
+	// PriorityClassName matches the pods with this priority class
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Resources are the prices of the resources of the matching pods. Resources without a price here use the default.
+	Resources Prices `json:"resources,omitempty"`
+}
+
+func (r PricingRule) matches(pod *apiv1.Pod) bool {
+	for key, value := range r.NodeSelector {
+		if pod.Spec.NodeSelector[key] != value {
+			return false
+		}
+	}
+	if r.InstanceType != "" && pod.Spec.NodeSelector[LabelKeyInstanceType] != r.InstanceType {
+		return false
+	}
+	return r.PriorityClassName == "" || pod.Spec.PriorityClassName == r.PriorityClassName
+}
+
+// PodPrices returns the prices of the resources of a pod, from the first rule it matches and the default prices
+func (c PricingConfig) PodPrices(pod *apiv1.Pod) Prices {
+	prices := Prices{}
+	for name, price := range c.Resources {
+		prices[name] = price
+	}
+	for _, rule := range c.Rules {
+		if rule.matches(pod) {
+			for name, price := range rule.Resources {
+				prices[name] = price
+			}
+			break
+		}
+	}
+	return prices
+}
+
+// StorageClassPrice returns the price of one Gi of persistent volume claims of a storage class per hour
+func (c PricingConfig) StorageClassPrice(storageClassName *string) (float64, bool) {
+	name := ""
+	if storageClassName != nil {
+		name = *storageClassName
+	}
+	price, ok := c.StorageClasses[name]
+	return price, ok
+}
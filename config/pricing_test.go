@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestPricingConfig(t *testing.T) {
+	c := PricingConfig{
+		Resources: Prices{apiv1.ResourceCPU: 0.04, apiv1.ResourceMemory: 0.005},
+		Rules: []PricingRule{
+			{PriorityClassName: "spot", InstanceType: "m5.large", Resources: Prices{apiv1.ResourceCPU: 0.01}},
+			{NodeSelector: map[string]string{"pool": "gpu"}, Resources: Prices{"nvidia.com/gpu": 2.5}},
+			{PriorityClassName: "spot", Resources: Prices{apiv1.ResourceCPU: 0.02}},
+		},
+		StorageClasses: map[string]float64{"": 0.0001, "fast": 0.0003},
+	}
+	pod := func(priorityClassName string, nodeSelector map[string]string) *apiv1.Pod {
+		return &apiv1.Pod{Spec: apiv1.PodSpec{PriorityClassName: priorityClassName, NodeSelector: nodeSelector}}
+	}
+	t.Run("Default", func(t *testing.T) {
+		assert.Equal(t, Prices{apiv1.ResourceCPU: 0.04, apiv1.ResourceMemory: 0.005}, c.PodPrices(pod("", nil)))
+	})
+	t.Run("InstanceTypeAndPriorityClass", func(t *testing.T) {
+		prices := c.PodPrices(pod("spot", map[string]string{LabelKeyInstanceType: "m5.large"}))
+		assert.Equal(t, Prices{apiv1.ResourceCPU: 0.01, apiv1.ResourceMemory: 0.005}, prices)
+	})
+	t.Run("FirstMatchingRule", func(t *testing.T) {
+		prices := c.PodPrices(pod("spot", map[string]string{LabelKeyInstanceType: "m5.xlarge", "pool": "gpu"}))
+		assert.Equal(t, Prices{apiv1.ResourceCPU: 0.04, apiv1.ResourceMemory: 0.005, "nvidia.com/gpu": 2.5}, prices)
+	})
+	t.Run("PriorityClass", func(t *testing.T) {
+		assert.InDelta(t, 0.02, c.PodPrices(pod("spot", nil))[apiv1.ResourceCPU], 0)
+	})
+	t.Run("StorageClass", func(t *testing.T) {
+		price, ok := c.StorageClassPrice(nil)
+		assert.True(t, ok)
+		assert.InDelta(t, 0.0001, price, 0)
+		price, ok = c.StorageClassPrice(ptr.To("fast"))
+		assert.True(t, ok)
+		assert.InDelta(t, 0.0003, price, 0)
+		_, ok = c.StorageClassPrice(ptr.To("slow"))
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,173 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/upper/db/v4"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// WorkflowStatsGroupBy is how archived workflows are grouped when computing statistics
+type WorkflowStatsGroupBy string
+
+const (
+	WorkflowStatsGroupByNamespace        WorkflowStatsGroupBy = "namespace"
+	WorkflowStatsGroupByWorkflowTemplate WorkflowStatsGroupBy = "workflowTemplate"
+	WorkflowStatsGroupByCronWorkflow     WorkflowStatsGroupBy = "cronWorkflow"
+	WorkflowStatsGroupByLabel            WorkflowStatsGroupBy = "label"
+)
+
+// WorkflowStats are the statistics of a group of archived workflows
+type WorkflowStats struct {
+	// Group is the namespace, workflow template, cron workflow or label value, empty for workflows without one
+	Group     string
+	Total     int64
+	Succeeded int64
+	Failed    int64
+	Errored   int64
+	// P50Duration and P95Duration are the nearest-rank percentiles of the workflow durations
+	P50Duration time.Duration
+	P95Duration time.Duration
+	// ResourcesDuration is the total resources duration of the workflows
+	ResourcesDuration wfv1.ResourcesDuration
+}
+
+type archivedWorkflowStatsRecord struct {
+	Group       string          `db:"groupkey"`
+	Total       int64           `db:"total"`
+	Succeeded   int64           `db:"succeeded"`
+	Failed      int64           `db:"failed"`
+	Errored     int64           `db:"errored"`
+	P50Duration sql.NullFloat64 `db:"p50duration"`
+	P95Duration sql.NullFloat64 `db:"p95duration"`
+}
+
+type archivedWorkflowResourcesDurationRecord struct {
+	Group    string `db:"groupkey"`
+	Resource string `db:"resource"`
+	Duration int64  `db:"duration"`
+}
+
+// archivedWorkflowStatsQuery aggregates the workflows of the inner query, which has the columns groupkey, phase and
+// duration. Percentiles are computed with window functions, which are supported by Postgres and MySQL 8.
+const archivedWorkflowStatsQuery = `select groupkey,
+  count(*) as total,
+  sum(case when phase = 'Succeeded' then 1 else 0 end) as succeeded,
+  sum(case when phase = 'Failed' then 1 else 0 end) as failed,
+  sum(case when phase = 'Error' then 1 else 0 end) as errored,
+  min(case when rn >= 0.5 * cnt then duration end) as p50duration,
+  min(case when rn >= 0.95 * cnt then duration end) as p95duration
+from (select w.groupkey, w.phase, w.duration, row_number() over (partition by w.groupkey order by w.duration) as rn, count(*) over (partition by w.groupkey) as cnt from ? w) t
+group by groupkey
+order by groupkey`
+
+// archivedWorkflowResourcesDurationQuery sums the resources durations of the inner query, which has the columns
+// groupkey and resourcesduration
+var archivedWorkflowResourcesDurationQuery = map[sqldb.DBType]string{
+	sqldb.MySQL: `select t.groupkey, r.resource, sum(json_extract(t.resourcesduration, concat('$."', r.resource, '"'))) as duration
+from ? t, json_table(json_keys(t.resourcesduration), '$[*]' columns (resource varchar(256) path '$')) r
+group by t.groupkey, r.resource`,
+	sqldb.Postgres: `select t.groupkey, r.key as resource, sum(r.value::bigint) as duration
+from ? t, jsonb_each_text(t.resourcesduration) r
+group by t.groupkey, r.key`,
+}
+
+// GetWorkflowStats returns the success rate, duration percentiles and resources duration of the archived workflows
+// matching the options, grouped by namespace, workflow template, cron workflow or a label.
+func (r *workflowArchive) GetWorkflowStats(ctx context.Context, options sutils.ListOptions, groupBy WorkflowStatsGroupBy, labelKey string) ([]WorkflowStats, error) {
+	groupKey, err := r.workflowStatsGroupKey(groupBy, labelKey)
+	if err != nil {
+		return nil, err
+	}
+	var duration, resourcesDuration *db.RawExpr
+	switch r.dbType {
+	case sqldb.MySQL:
+		duration = db.Raw("timestampdiff(second, startedat, finishedat) as duration")
+		resourcesDuration = db.Raw("coalesce(workflow->'$.status.resourcesDuration', json_object()) as resourcesduration")
+	case sqldb.Postgres:
+		duration = db.Raw("extract(epoch from (finishedat - startedat)) as duration")
+		resourcesDuration = db.Raw("coalesce(workflow::jsonb->'status'->'resourcesDuration', '{}'::jsonb) as resourcesduration")
+	default:
+		return nil, fmt.Errorf("unsupported db type %s", r.dbType)
+	}
+
+	selector := r.session.SQL().
+		Select(groupKey, "phase", duration).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID())
+	selector, err = BuildArchivedWorkflowSelector(selector, archiveTableName, archiveLabelsTableName, r.dbType, options, true)
+	if err != nil {
+		return nil, err
+	}
+	var records []archivedWorkflowStatsRecord
+	err = r.session.SQL().Iterator(archivedWorkflowStatsQuery, selector).All(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	selector = r.session.SQL().
+		Select(groupKey, resourcesDuration).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID())
+	selector, err = BuildArchivedWorkflowSelector(selector, archiveTableName, archiveLabelsTableName, r.dbType, options, true)
+	if err != nil {
+		return nil, err
+	}
+	var resourcesDurations []archivedWorkflowResourcesDurationRecord
+	err = r.session.SQL().Iterator(archivedWorkflowResourcesDurationQuery[r.dbType], selector).All(&resourcesDurations)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWorkflowStats(records, resourcesDurations), nil
+}
+
+func (r *workflowArchive) workflowStatsGroupKey(groupBy WorkflowStatsGroupBy, labelKey string) (*db.RawExpr, error) {
+	switch groupBy {
+	case "", WorkflowStatsGroupByNamespace:
+		return db.Raw("namespace as groupkey"), nil
+	case WorkflowStatsGroupByWorkflowTemplate:
+		labelKey = common.LabelKeyWorkflowTemplate
+	case WorkflowStatsGroupByCronWorkflow:
+		labelKey = common.LabelKeyCronWorkflow
+	case WorkflowStatsGroupByLabel:
+		if labelKey == "" {
+			return nil, fmt.Errorf("a label key is required to group by label")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported group by %q", groupBy)
+	}
+	return db.Raw(fmt.Sprintf("coalesce((select value from %s where clustername = %s.clustername and uid = %s.uid and name = ?), '') as groupkey", archiveLabelsTableName, archiveTableName, archiveTableName), labelKey), nil
+}
+
+func toWorkflowStats(records []archivedWorkflowStatsRecord, resourcesDurations []archivedWorkflowResourcesDurationRecord) []WorkflowStats {
+	stats := make([]WorkflowStats, len(records))
+	index := make(map[string]int, len(records))
+	for i, record := range records {
+		stats[i] = WorkflowStats{
+			Group:             record.Group,
+			Total:             record.Total,
+			Succeeded:         record.Succeeded,
+			Failed:            record.Failed,
+			Errored:           record.Errored,
+			P50Duration:       time.Duration(record.P50Duration.Float64 * float64(time.Second)),
+			P95Duration:       time.Duration(record.P95Duration.Float64 * float64(time.Second)),
+			ResourcesDuration: wfv1.ResourcesDuration{},
+		}
+		index[record.Group] = i
+	}
+	for _, record := range resourcesDurations {
+		if i, ok := index[record.Group]; ok {
+			stats[i].ResourcesDuration[corev1.ResourceName(record.Resource)] = wfv1.NewResourceDuration(time.Duration(record.Duration) * time.Second)
+		}
+	}
+	return stats
+}
@@ -0,0 +1,69 @@
+package sqldb
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_workflowStatsGroupKey(t *testing.T) {
+	r := &workflowArchive{}
+	labelGroupKey := "coalesce((select value from argo_archived_workflows_labels where clustername = argo_archived_workflows.clustername and uid = argo_archived_workflows.uid and name = ?), '') as groupkey"
+	tests := []struct {
+		name     string
+		groupBy  WorkflowStatsGroupBy
+		labelKey string
+		want     *db.RawExpr
+	}{
+		{"Default", "", "", db.Raw("namespace as groupkey")},
+		{"Namespace", WorkflowStatsGroupByNamespace, "", db.Raw("namespace as groupkey")},
+		{"WorkflowTemplate", WorkflowStatsGroupByWorkflowTemplate, "", db.Raw(labelGroupKey, "workflows.argoproj.io/workflow-template")},
+		{"CronWorkflow", WorkflowStatsGroupByCronWorkflow, "", db.Raw(labelGroupKey, "workflows.argoproj.io/cron-workflow")},
+		{"Label", WorkflowStatsGroupByLabel, "team", db.Raw(labelGroupKey, "team")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.workflowStatsGroupKey(tt.groupBy, tt.labelKey)
+			require.NoError(t, err)
+			assert.Equal(t, *tt.want, *got)
+		})
+	}
+	t.Run("LabelWithoutKey", func(t *testing.T) {
+		_, err := r.workflowStatsGroupKey(WorkflowStatsGroupByLabel, "")
+		require.Error(t, err)
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := r.workflowStatsGroupKey("phase", "")
+		require.Error(t, err)
+	})
+}
+
+func Test_toWorkflowStats(t *testing.T) {
+	stats := toWorkflowStats([]archivedWorkflowStatsRecord{
+		{Group: "", Total: 1, Failed: 1},
+		{Group: "my-wftmpl", Total: 10, Succeeded: 8, Errored: 2, P50Duration: sql.NullFloat64{Float64: 1.5, Valid: true}, P95Duration: sql.NullFloat64{Float64: 60, Valid: true}},
+	}, []archivedWorkflowResourcesDurationRecord{
+		{Group: "my-wftmpl", Resource: "cpu", Duration: 100},
+		{Group: "my-wftmpl", Resource: "memory", Duration: 200},
+		{Group: "unknown", Resource: "cpu", Duration: 1},
+	})
+	assert.Equal(t, []WorkflowStats{
+		{Group: "", Total: 1, Failed: 1, ResourcesDuration: wfv1.ResourcesDuration{}},
+		{
+			Group:             "my-wftmpl",
+			Total:             10,
+			Succeeded:         8,
+			Errored:           2,
+			P50Duration:       1500 * time.Millisecond,
+			P95Duration:       time.Minute,
+			ResourcesDuration: wfv1.ResourcesDuration{corev1.ResourceCPU: 100, corev1.ResourceMemory: 200},
+		},
+	}, stats)
+}
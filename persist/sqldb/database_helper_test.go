@@ -0,0 +1,116 @@
+package sqldb_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	testcontainers "github.com/testcontainers/testcontainers-go"
+	testmysql "github.com/testcontainers/testcontainers-go/modules/mysql"
+	testpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	utilsqldb "github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+const (
+	testDBName     = `archive`
+	testDBUser     = `user`
+	testDBPassword = `pass`
+)
+
+// createTestArchive starts a database container of the type and returns a migrated workflow archive using it
+func createTestArchive(ctx context.Context, t *testing.T, dbType utilsqldb.DBType) sqldb.WorkflowArchive {
+	t.Helper()
+
+	var dbConfig config.DBConfig
+	switch dbType {
+	case utilsqldb.Postgres:
+		dbConfig = setupPostgresContainer(ctx, t)
+	case utilsqldb.MySQL:
+		dbConfig = setupMySQLContainer(ctx, t)
+	default:
+		t.Fatalf("unsupported db type %s", dbType)
+	}
+
+	session, err := utilsqldb.CreateDBSessionWithCreds(dbConfig, testDBUser, testDBPassword)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	require.NoError(t, sqldb.Migrate(ctx, session, "test-cluster", "argo_workflows"))
+	return sqldb.NewWorkflowArchive(session, "test-cluster", "", instanceid.NewService(""))
+}
+
+// setupPostgresContainer starts a Postgres container, which is terminated when the test completes
+func setupPostgresContainer(ctx context.Context, t *testing.T) config.DBConfig {
+	postgresContainer, err := testpostgres.Run(ctx,
+		"postgres:17.4-alpine",
+		testpostgres.WithDatabase(testDBName),
+		testpostgres.WithUsername(testDBUser),
+		testpostgres.WithPassword(testDBPassword),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(15*time.Second)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := testcontainers.TerminateContainer(postgresContainer); err != nil {
+			t.Logf("failed to terminate container: %s", err)
+		}
+	})
+
+	host, err := postgresContainer.Host(ctx)
+	require.NoError(t, err)
+	portS, err := postgresContainer.MappedPort(ctx, "5432/tcp")
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portS.Port())
+	require.NoError(t, err)
+
+	return config.DBConfig{
+		PostgreSQL: &config.PostgreSQLConfig{
+			DatabaseConfig: config.DatabaseConfig{
+				Database: testDBName,
+				Host:     host,
+				Port:     port,
+			},
+		},
+	}
+}
+
+// setupMySQLContainer starts a MySQL container, which is terminated when the test completes
+func setupMySQLContainer(ctx context.Context, t *testing.T) config.DBConfig {
+	mysqlContainer, err := testmysql.Run(ctx,
+		"mysql:8.4.5",
+		testmysql.WithDatabase(testDBName),
+		testmysql.WithUsername(testDBUser),
+		testmysql.WithPassword(testDBPassword),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := testcontainers.TerminateContainer(mysqlContainer); err != nil {
+			t.Logf("failed to terminate container: %s", err)
+		}
+	})
+
+	host, err := mysqlContainer.Host(ctx)
+	require.NoError(t, err)
+	portS, err := mysqlContainer.MappedPort(ctx, "3306/tcp")
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portS.Port())
+	require.NoError(t, err)
+
+	return config.DBConfig{
+		MySQL: &config.MySQLConfig{
+			DatabaseConfig: config.DatabaseConfig{
+				Database: testDBName,
+				Host:     host,
+				Port:     port,
+			},
+		},
+	}
+}
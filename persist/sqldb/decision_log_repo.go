@@ -0,0 +1,133 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const decisionLogTableName = "argo_workflow_decisions"
+
+// DecisionLogRepo stores the decision logs of workflows, one record per workflow
+type DecisionLogRepo interface {
+	Save(ctx context.Context, uid, namespace string, decisions wfv1.Decisions) error
+	Get(ctx context.Context, uid string) (wfv1.Decisions, error)
+	ListUIDs(ctx context.Context, namespace string) ([]string, error)
+	Delete(ctx context.Context, uid string) error
+	IsEnabled() bool
+}
+
+func NewDecisionLogRepo(log logging.Logger, session db.Session, clusterName string) DecisionLogRepo {
+	return &decisionLogRepo{session: session, clusterName: clusterName, log: log}
+}
+
+type decisionsRecord struct {
+	ClusterName string    `db:"clustername"`
+	UID         string    `db:"uid"`
+	Namespace   string    `db:"namespace"`
+	Decisions   string    `db:"decisions"`
+	UpdatedAt   time.Time `db:"updatedat"`
+}
+
+type decisionLogRepo struct {
+	session     db.Session
+	clusterName string
+	log         logging.Logger
+}
+
+func (r *decisionLogRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *decisionLogRepo) Save(ctx context.Context, uid, namespace string, decisions wfv1.Decisions) error {
+	marshalled, err := json.Marshal(decisions)
+	if err != nil {
+		return err
+	}
+	r.log.WithFields(logging.Fields{"uid": uid, "decisions": len(decisions)}).Debug(ctx, "Saving decisions")
+	rs, err := r.session.SQL().
+		Update(decisionLogTableName).
+		Set("decisions", string(marshalled)).
+		Set("updatedat", time.Now().UTC()).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"uid": uid}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+	_, err = r.session.Collection(decisionLogTableName).Insert(&decisionsRecord{
+		ClusterName: r.clusterName,
+		UID:         uid,
+		Namespace:   namespace,
+		Decisions:   string(marshalled),
+		UpdatedAt:   time.Now().UTC(),
+	})
+	return err
+}
+
+func (r *decisionLogRepo) Get(ctx context.Context, uid string) (wfv1.Decisions, error) {
+	r.log.WithField("uid", uid).Debug(ctx, "Getting decisions")
+	record := &decisionsRecord{}
+	err := r.session.SQL().
+		SelectFrom(decisionLogTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"uid": uid}).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var decisions wfv1.Decisions
+	err = json.Unmarshal([]byte(record.Decisions), &decisions)
+	if err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+func (r *decisionLogRepo) ListUIDs(ctx context.Context, namespace string) ([]string, error) {
+	r.log.WithField("namespace", namespace).Debug(ctx, "Listing decisions")
+	var records []decisionsRecord
+	err := r.session.SQL().
+		Select("uid").
+		From(decisionLogTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(namespaceEqual(namespace)).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]string, len(records))
+	for i, record := range records {
+		uids[i] = record.UID
+	}
+	return uids, nil
+}
+
+func (r *decisionLogRepo) Delete(ctx context.Context, uid string) error {
+	if uid == "" {
+		return fmt.Errorf("invalid uid")
+	}
+	r.log.WithField("uid", uid).Debug(ctx, "Deleting decisions")
+	_, err := r.session.SQL().
+		DeleteFrom(decisionLogTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"uid": uid}).
+		Exec()
+	return err
+}
@@ -226,5 +226,15 @@ func Migrate(ctx context.Context, session db.Session, clusterName, tableName str
 		}),
 		// add index on creationtimestamp column
 		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (creationtimestamp)`),
+		// add argo_workflow_decisions table for offloaded decision logs
+		sqldb.AnsiSQLChange(`create table if not exists argo_workflow_decisions (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    decisions json not null,
+    updatedat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, uid)
+)`),
+		sqldb.AnsiSQLChange(`create index argo_workflow_decisions_i1 on argo_workflow_decisions (clustername,namespace)`),
 	})
 }
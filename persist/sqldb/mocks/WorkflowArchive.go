@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/utils"
 	mock "github.com/stretchr/testify/mock"
@@ -432,6 +433,86 @@ func (_c *WorkflowArchive_GetWorkflowForEstimator_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetWorkflowStats provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) GetWorkflowStats(ctx context.Context, options utils.ListOptions, groupBy sqldb.WorkflowStatsGroupBy, labelKey string) ([]sqldb.WorkflowStats, error) {
+	ret := _mock.Called(ctx, options, groupBy, labelKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowStats")
+	}
+
+	var r0 []sqldb.WorkflowStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, utils.ListOptions, sqldb.WorkflowStatsGroupBy, string) ([]sqldb.WorkflowStats, error)); ok {
+		return returnFunc(ctx, options, groupBy, labelKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, utils.ListOptions, sqldb.WorkflowStatsGroupBy, string) []sqldb.WorkflowStats); ok {
+		r0 = returnFunc(ctx, options, groupBy, labelKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.WorkflowStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, utils.ListOptions, sqldb.WorkflowStatsGroupBy, string) error); ok {
+		r1 = returnFunc(ctx, options, groupBy, labelKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_GetWorkflowStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowStats'
+type WorkflowArchive_GetWorkflowStats_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options utils.ListOptions
+//   - groupBy sqldb.WorkflowStatsGroupBy
+//   - labelKey string
+func (_e *WorkflowArchive_Expecter) GetWorkflowStats(ctx interface{}, options interface{}, groupBy interface{}, labelKey interface{}) *WorkflowArchive_GetWorkflowStats_Call {
+	return &WorkflowArchive_GetWorkflowStats_Call{Call: _e.mock.On("GetWorkflowStats", ctx, options, groupBy, labelKey)}
+}
+
+func (_c *WorkflowArchive_GetWorkflowStats_Call) Run(run func(ctx context.Context, options utils.ListOptions, groupBy sqldb.WorkflowStatsGroupBy, labelKey string)) *WorkflowArchive_GetWorkflowStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 utils.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(utils.ListOptions)
+		}
+		var arg2 sqldb.WorkflowStatsGroupBy
+		if args[2] != nil {
+			arg2 = args[2].(sqldb.WorkflowStatsGroupBy)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_GetWorkflowStats_Call) Return(workflowStatss []sqldb.WorkflowStats, err error) *WorkflowArchive_GetWorkflowStats_Call {
+	_c.Call.Return(workflowStatss, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_GetWorkflowStats_Call) RunAndReturn(run func(ctx context.Context, options utils.ListOptions, groupBy sqldb.WorkflowStatsGroupBy, labelKey string) ([]sqldb.WorkflowStats, error)) *WorkflowArchive_GetWorkflowStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEnabled provides a mock function for the type WorkflowArchive
 func (_mock *WorkflowArchive) IsEnabled() bool {
 	ret := _mock.Called()
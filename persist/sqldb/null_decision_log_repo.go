@@ -0,0 +1,31 @@
+package sqldb
+
+import (
+	"context"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var NullDecisionLogRepo DecisionLogRepo = &nullDecisionLogRepo{}
+
+type nullDecisionLogRepo struct{}
+
+func (r *nullDecisionLogRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullDecisionLogRepo) Save(context.Context, string, string, wfv1.Decisions) error {
+	return nil
+}
+
+func (r *nullDecisionLogRepo) Get(context.Context, string) (wfv1.Decisions, error) {
+	return nil, nil
+}
+
+func (r *nullDecisionLogRepo) ListUIDs(context.Context, string) ([]string, error) {
+	return nil, nil
+}
+
+func (r *nullDecisionLogRepo) Delete(context.Context, string) error {
+	return nil
+}
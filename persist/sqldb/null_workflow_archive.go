@@ -54,3 +54,7 @@ func (r *nullWorkflowArchive) ListWorkflowsLabelKeys(ctx context.Context) (*wfv1
 func (r *nullWorkflowArchive) ListWorkflowsLabelValues(ctx context.Context, key string) (*wfv1.LabelValues, error) {
 	return &wfv1.LabelValues{}, nil
 }
+
+func (r *nullWorkflowArchive) GetWorkflowStats(ctx context.Context, options sutils.ListOptions, groupBy WorkflowStatsGroupBy, labelKey string) ([]WorkflowStats, error) {
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+func queryClause(selector db.Selector, t sqldb.DBType, query utils.Query) (db.Selector, error) {
+	for _, term := range query {
+		cond, err := queryTermToCondition(t, term)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.And(cond)
+	}
+	return selector, nil
+}
+
+// likeContains returns a `like` pattern matching values containing s, escaping the `like` wildcards with `\`
+func likeContains(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s) + "%"
+}
+
+// queryTermToCondition converts a search query term into a condition on the archived workflow JSON. All values are
+// passed as arguments, never interpolated.
+func queryTermToCondition(t sqldb.DBType, term utils.QueryTerm) (*db.RawExpr, error) {
+	switch t {
+	case sqldb.MySQL:
+		switch term.Kind {
+		case utils.QueryTermParameter:
+			return db.Raw("json_contains(coalesce(workflow->'$.spec.arguments.parameters', json_array()), json_object('name', ?, 'value', ?))", term.Key, term.Value), nil
+		case utils.QueryTermTemplate:
+			return db.Raw("(workflow->>'$.spec.workflowTemplateRef.name' = ? or json_contains(coalesce(json_extract(workflow, '$.status.nodes.*.templateName'), json_array()), json_quote(?)))", term.Value, term.Value), nil
+		case utils.QueryTermNodePhase:
+			return db.Raw("json_contains(coalesce(json_extract(workflow, '$.status.nodes.*.phase'), json_array()), json_quote(?))", term.Value), nil
+		case utils.QueryTermNodeMessage:
+			return db.Raw("json_search(workflow, 'one', ?, null, '$.status.nodes.*.message') is not null", likeContains(term.Value)), nil
+		case utils.QueryTermJSONPath:
+			if term.Operator == utils.QueryOperatorContains {
+				return db.Raw("json_unquote(json_extract(workflow, ?)) like ?", term.QuotedJSONPath(), likeContains(term.Value)), nil
+			}
+			return db.Raw("json_unquote(json_extract(workflow, ?)) = ?", term.QuotedJSONPath(), term.Value), nil
+		case utils.QueryTermText:
+			return db.Raw("cast(workflow as char) like ?", likeContains(term.Value)), nil
+		}
+	case sqldb.Postgres:
+		switch term.Kind {
+		case utils.QueryTermParameter:
+			return db.Raw("exists (select 1 from jsonb_array_elements(coalesce(workflow::jsonb->'spec'->'arguments'->'parameters', '[]'::jsonb)) p where p->>'name' = ? and p->>'value' = ?)", term.Key, term.Value), nil
+		case utils.QueryTermTemplate:
+			return db.Raw("(workflow::jsonb->'spec'->'workflowTemplateRef'->>'name' = ? or exists (select 1 from jsonb_each(coalesce(workflow::jsonb->'status'->'nodes', '{}'::jsonb)) n where n.value->>'templateName' = ?))", term.Value, term.Value), nil
+		case utils.QueryTermNodePhase:
+			return db.Raw("exists (select 1 from jsonb_each(coalesce(workflow::jsonb->'status'->'nodes', '{}'::jsonb)) n where n.value->>'phase' = ?)", term.Value), nil
+		case utils.QueryTermNodeMessage:
+			return db.Raw("exists (select 1 from jsonb_each(coalesce(workflow::jsonb->'status'->'nodes', '{}'::jsonb)) n where n.value->>'message' like ?)", likeContains(term.Value)), nil
+		case utils.QueryTermJSONPath:
+			if term.Operator == utils.QueryOperatorContains {
+				return db.Raw("jsonb_path_query_first(workflow::jsonb, ?::jsonpath) #>> '{}' like ?", term.QuotedJSONPath(), likeContains(term.Value)), nil
+			}
+			return db.Raw("jsonb_path_query_first(workflow::jsonb, ?::jsonpath) #>> '{}' = ?", term.QuotedJSONPath(), term.Value), nil
+		case utils.QueryTermText:
+			return db.Raw("workflow::text like ?", likeContains(term.Value)), nil
+		}
+	}
+	return nil, fmt.Errorf("query term %q is not supported by %s", term.Kind, t)
+}
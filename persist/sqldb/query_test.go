@@ -0,0 +1,43 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+func Test_queryTermToCondition(t *testing.T) {
+	tests := []struct {
+		name   string
+		dbType sqldb.DBType
+		query  string
+		want   db.RawExpr
+	}{
+		{"ParameterPostgres", sqldb.Postgres, "param.message=hello", *db.Raw("exists (select 1 from jsonb_array_elements(coalesce(workflow::jsonb->'spec'->'arguments'->'parameters', '[]'::jsonb)) p where p->>'name' = ? and p->>'value' = ?)", "message", "hello")},
+		{"ParameterMySQL", sqldb.MySQL, "param.message=hello", *db.Raw("json_contains(coalesce(workflow->'$.spec.arguments.parameters', json_array()), json_object('name', ?, 'value', ?))", "message", "hello")},
+		{"NodePhasePostgres", sqldb.Postgres, "node.phase=Failed", *db.Raw("exists (select 1 from jsonb_each(coalesce(workflow::jsonb->'status'->'nodes', '{}'::jsonb)) n where n.value->>'phase' = ?)", "Failed")},
+		{"NodeMessageMySQL", sqldb.MySQL, "node.message~100%", *db.Raw("json_search(workflow, 'one', ?, null, '$.status.nodes.*.message') is not null", `%100\%%`)},
+		{"JSONPathPostgres", sqldb.Postgres, "$.metadata.labels.my-label=foo", *db.Raw("jsonb_path_query_first(workflow::jsonb, ?::jsonpath) #>> '{}' = ?", `$."metadata"."labels"."my-label"`, "foo")},
+		{"JSONPathMySQL", sqldb.MySQL, "$.spec.templates[0].name~ma", *db.Raw("json_unquote(json_extract(workflow, ?)) like ?", `$."spec"."templates"[0]."name"`, "%ma%")},
+		{"TextPostgres", sqldb.Postgres, "my_text", *db.Raw("workflow::text like ?", `%my\_text%`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := utils.ParseQuery(tt.query)
+			require.NoError(t, err)
+			require.Len(t, query, 1)
+			got, err := queryTermToCondition(tt.dbType, query[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := queryTermToCondition(sqldb.SQLite, utils.QueryTerm{Kind: utils.QueryTermText, Value: "x"})
+		require.Error(t, err)
+	})
+}
@@ -38,6 +38,10 @@ func BuildArchivedWorkflowSelector(selector db.Selector, tableName, labelTableNa
 	if err != nil {
 		return nil, err
 	}
+	selector, err = queryClause(selector, t, options.Query)
+	if err != nil {
+		return nil, err
+	}
 	if count {
 		return selector, nil
 	}
@@ -48,7 +52,7 @@ func BuildArchivedWorkflowSelector(selector db.Selector, tableName, labelTableNa
 		options.Offset = -1
 	}
 	return selector.
-		OrderBy("-startedat").
+		OrderBy("-startedat", "-uid").
 		Limit(options.Limit).
 		Offset(options.Offset), nil
 }
@@ -108,9 +112,9 @@ func BuildWorkflowSelector(in string, inArgs []any, tableName, labelTableName st
 		return out, outArgs, nil
 	}
 	if options.StartedAtAscending {
-		out += " order by startedat asc"
+		out += " order by startedat asc, uid asc"
 	} else {
-		out += " order by startedat desc"
+		out += " order by startedat desc, uid desc"
 	}
 
 	// If we were passed 0 as the limit, then we should load all available archived workflows
@@ -79,6 +79,7 @@ type WorkflowArchive interface {
 	IsEnabled() bool
 	ListWorkflowsLabelKeys(ctx context.Context) (*wfv1.LabelKeys, error)
 	ListWorkflowsLabelValues(ctx context.Context, key string) (*wfv1.LabelValues, error)
+	GetWorkflowStats(ctx context.Context, options sutils.ListOptions, groupBy WorkflowStatsGroupBy, labelKey string) ([]WorkflowStats, error)
 }
 
 type workflowArchive struct {
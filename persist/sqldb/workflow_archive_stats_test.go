@@ -0,0 +1,92 @@
+package sqldb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	utilsqldb "github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+func statsTestWorkflow(namespace, name, team string, phase wfv1.WorkflowPhase, duration time.Duration) *wfv1.Workflow {
+	startedAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:               types.UID("uid-" + name),
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(startedAt),
+			Labels:            map[string]string{},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:      phase,
+			StartedAt:  metav1.NewTime(startedAt),
+			FinishedAt: metav1.NewTime(startedAt.Add(duration)),
+			ResourcesDuration: wfv1.ResourcesDuration{
+				corev1.ResourceCPU:    wfv1.NewResourceDuration(5 * time.Second),
+				corev1.ResourceMemory: wfv1.NewResourceDuration(10 * time.Second),
+			},
+		},
+	}
+	if team != "" {
+		wf.Labels["team"] = team
+	}
+	return wf
+}
+
+// TestWorkflowArchiveStats runs the statistics queries, which use window functions and JSON aggregation, against
+// each database
+func TestWorkflowArchiveStats(t *testing.T) {
+	workflows := []*wfv1.Workflow{
+		statsTestWorkflow("ns-a", "wf-1", "data", wfv1.WorkflowSucceeded, 10*time.Second),
+		statsTestWorkflow("ns-a", "wf-2", "data", wfv1.WorkflowFailed, 20*time.Second),
+		statsTestWorkflow("ns-a", "wf-3", "", wfv1.WorkflowSucceeded, 30*time.Second),
+		statsTestWorkflow("ns-b", "wf-4", "", wfv1.WorkflowError, time.Minute),
+	}
+	resourcesDuration := func(n int64) wfv1.ResourcesDuration {
+		return wfv1.ResourcesDuration{
+			corev1.ResourceCPU:    wfv1.NewResourceDuration(time.Duration(5*n) * time.Second),
+			corev1.ResourceMemory: wfv1.NewResourceDuration(time.Duration(10*n) * time.Second),
+		}
+	}
+	for _, dbType := range []utilsqldb.DBType{utilsqldb.Postgres, utilsqldb.MySQL} {
+		t.Run(string(dbType), func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			archive := createTestArchive(ctx, t, dbType)
+			for _, wf := range workflows {
+				require.NoError(t, archive.ArchiveWorkflow(ctx, wf.DeepCopy()))
+			}
+			t.Run("Namespace", func(t *testing.T) {
+				stats, err := archive.GetWorkflowStats(ctx, sutils.ListOptions{}, sqldb.WorkflowStatsGroupByNamespace, "")
+				require.NoError(t, err)
+				assert.Equal(t, []sqldb.WorkflowStats{
+					{Group: "ns-a", Total: 3, Succeeded: 2, Failed: 1, P50Duration: 20 * time.Second, P95Duration: 30 * time.Second, ResourcesDuration: resourcesDuration(3)},
+					{Group: "ns-b", Total: 1, Errored: 1, P50Duration: time.Minute, P95Duration: time.Minute, ResourcesDuration: resourcesDuration(1)},
+				}, stats)
+			})
+			t.Run("Label", func(t *testing.T) {
+				stats, err := archive.GetWorkflowStats(ctx, sutils.ListOptions{}, sqldb.WorkflowStatsGroupByLabel, "team")
+				require.NoError(t, err)
+				assert.Equal(t, []sqldb.WorkflowStats{
+					{Group: "", Total: 2, Succeeded: 1, Errored: 1, P50Duration: 30 * time.Second, P95Duration: time.Minute, ResourcesDuration: resourcesDuration(2)},
+					{Group: "data", Total: 2, Succeeded: 1, Failed: 1, P50Duration: 10 * time.Second, P95Duration: 20 * time.Second, ResourcesDuration: resourcesDuration(2)},
+				}, stats)
+			})
+			t.Run("Namespaced", func(t *testing.T) {
+				stats, err := archive.GetWorkflowStats(ctx, sutils.ListOptions{Namespace: "ns-b"}, sqldb.WorkflowStatsGroupByNamespace, "")
+				require.NoError(t, err)
+				require.Len(t, stats, 1)
+				assert.Equal(t, "ns-b", stats[0].Group)
+			})
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package sqldb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/server/workflow/store"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	utilsqldb "github.com/argoproj/argo-workflows/v3/util/sqldb"
+)
+
+func searchTestWorkflow(name, parameter, nodeMessage string) *wfv1.Workflow {
+	startedAt := time.Now().Add(-time.Hour)
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:               types.UID("uid-" + name),
+			Name:              name,
+			Namespace:         "argo",
+			CreationTimestamp: metav1.NewTime(startedAt),
+			Labels:            map[string]string{"team": name + "-team"},
+		},
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr(parameter)}}},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.WorkflowSucceeded,
+			StartedAt:  metav1.NewTime(startedAt),
+			FinishedAt: metav1.NewTime(startedAt.Add(time.Minute)),
+			Nodes: wfv1.Nodes{
+				name: wfv1.NodeStatus{ID: name, Name: name, Type: wfv1.NodeTypePod, TemplateName: "main", Phase: wfv1.NodeSucceeded, Message: nodeMessage},
+			},
+		},
+	}
+}
+
+// TestWorkflowSearch runs the same search queries against the archive and the live workflow store, which must match
+// the same workflows
+func TestWorkflowSearch(t *testing.T) {
+	workflows := []*wfv1.Workflow{
+		searchTestWorkflow("wf-a", "hello", "OOMKilled (exit code 137)"),
+		searchTestWorkflow("wf-b", "world", "connection refused"),
+		searchTestWorkflow("wf-c", "hello-world", ""),
+	}
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"param.message=hello", []string{"wf-a"}},
+		{"template=main", []string{"wf-a", "wf-b", "wf-c"}},
+		{"node.message~Kill", []string{"wf-a"}},
+		{"$.metadata.labels.team=wf-b-team", []string{"wf-b"}},
+		{"$.metadata.labels.team~-team", []string{"wf-a", "wf-b", "wf-c"}},
+		{"OOM", []string{"wf-a"}},
+		{"Killed", []string{"wf-a"}},
+		{`"tion refu"`, []string{"wf-b"}},
+		{"world", []string{"wf-b", "wf-c"}},
+		{"world param.message=world", []string{"wf-b"}},
+	}
+
+	liveStore, err := store.NewSQLiteStore(instanceid.NewService(""))
+	require.NoError(t, err)
+	for _, wf := range workflows {
+		require.NoError(t, liveStore.Add(wf.DeepCopy()))
+	}
+
+	for _, dbType := range []utilsqldb.DBType{utilsqldb.Postgres, utilsqldb.MySQL} {
+		t.Run(string(dbType), func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			archive := createTestArchive(ctx, t, dbType)
+			for _, wf := range workflows {
+				require.NoError(t, archive.ArchiveWorkflow(ctx, wf.DeepCopy()))
+			}
+			for _, tt := range tests {
+				t.Run(tt.query, func(t *testing.T) {
+					search, err := sutils.ParseQuery(tt.query)
+					require.NoError(t, err)
+
+					archived, err := archive.ListWorkflows(ctx, sutils.ListOptions{Namespace: "argo", Query: search})
+					require.NoError(t, err)
+					var archivedNames []string
+					for _, wf := range archived {
+						archivedNames = append(archivedNames, wf.Name)
+					}
+					assert.ElementsMatch(t, tt.want, archivedNames, "archive")
+
+					live, err := liveStore.ListWorkflows(ctx, "argo", "", "", "", search, metav1.ListOptions{})
+					require.NoError(t, err)
+					var liveNames []string
+					for _, wf := range live.Items {
+						liveNames = append(liveNames, wf.Name)
+					}
+					assert.ElementsMatch(t, tt.want, liveNames, "live")
+				})
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/artifacts"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	clusterworkflowtmplserver "github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/argoproj/argo-workflows/v3/server/cronworkflow"
@@ -28,7 +29,10 @@ import (
 	workflowtemplateserver "github.com/argoproj/argo-workflows/v3/server/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/util/help"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 	rbacutil "github.com/argoproj/argo-workflows/v3/util/rbac"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
 var (
@@ -73,6 +77,7 @@ type argoKubeClient struct {
 	wfStore           store.WorkflowStore
 	namespace         string
 	kubeClient        *kubernetes.Clientset
+	gatekeeper        auth.Gatekeeper
 }
 
 var _ Client = &argoKubeClient{}
@@ -125,6 +130,7 @@ func newArgoKubeClient(ctx context.Context, opts ArgoKubeOpts, clientConfig clie
 		wfClient:          wfClient,
 		namespace:         namespace,
 		kubeClient:        kubeClient,
+		gatekeeper:        gatekeeper,
 	}
 	err = client.startStores(ctx, restConfig)
 	if err != nil {
@@ -177,7 +183,10 @@ func (a *argoKubeClient) startStores(ctx context.Context, restConfig *restclient
 
 func (a *argoKubeClient) NewWorkflowServiceClient(ctx context.Context) workflowpkg.WorkflowServiceClient {
 	wfArchive := sqldb.NullWorkflowArchive
-	wfServer := workflowserver.NewWorkflowServer(ctx, a.instanceIDService, argoKubeOffloadNodeStatusRepo, wfArchive, a.wfClient, a.wfLister, a.wfStore, a.wfTmplStore, a.cwfTmplStore, nil, &a.namespace)
+	// the archived logs of pods are read from the artifact repository recorded in the status of their workflow, as the
+	// default artifact repository of the controller is not known
+	artifactServer := artifacts.NewArtifactServer(a.gatekeeper, hydrator.New(argoKubeOffloadNodeStatusRepo), wfArchive, a.instanceIDService, artifactrepositories.New(a.kubeClient, a.namespace, nil), logging.RequireLoggerFromContext(ctx))
+	wfServer := workflowserver.NewWorkflowServer(ctx, a.instanceIDService, argoKubeOffloadNodeStatusRepo, wfArchive, sqldb.NullDecisionLogRepo, a.wfClient, a.wfLister, a.wfStore, a.wfTmplStore, a.cwfTmplStore, nil, artifactServer, &a.namespace)
 	go wfServer.Run(a.opts.CachingCloseCh)
 	return &errorTranslatingWorkflowServiceClient{&argoKubeWorkflowServiceClient{wfServer}}
 }
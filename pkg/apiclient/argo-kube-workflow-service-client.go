@@ -28,6 +28,10 @@ func (c *argoKubeWorkflowServiceClient) ListWorkflows(ctx context.Context, req *
 	return c.delegate.ListWorkflows(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) ListAllWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	return c.delegate.ListAllWorkflows(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) WatchWorkflows(ctx context.Context, req *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	intermediary := newWorkflowWatchIntermediary(ctx)
 	go func() {
@@ -88,6 +92,30 @@ func (c *argoKubeWorkflowServiceClient) TerminateWorkflow(ctx context.Context, r
 	return c.delegate.TerminateWorkflow(ctx, req)
 }
 
+func (c *argoKubeWorkflowServiceClient) StopWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.StopWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) TerminateWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.TerminateWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) RetryWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.RetryWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) ResubmitWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.ResubmitWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) SuspendWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.SuspendWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) ResumeWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return c.delegate.ResumeWorkflows(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.LintWorkflow(ctx, req)
 }
@@ -118,6 +146,10 @@ func (c *argoKubeWorkflowServiceClient) WorkflowLogs(ctx context.Context, req *w
 	})
 }
 
+func (c *argoKubeWorkflowServiceClient) ExplainWorkflow(ctx context.Context, req *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	return c.delegate.ExplainWorkflow(ctx, req)
+}
+
 func (c *argoKubeWorkflowServiceClient) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SubmitWorkflow(ctx, req)
 }
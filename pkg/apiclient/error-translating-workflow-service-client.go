@@ -31,6 +31,11 @@ func (c *errorTranslatingWorkflowServiceClient) ListWorkflows(ctx context.Contex
 	return workflows, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) ListAllWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	workflows, err := c.delegate.ListAllWorkflows(ctx, req)
+	return workflows, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) WatchWorkflows(ctx context.Context, req *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	workflows, err := c.delegate.WatchWorkflows(ctx, req)
 	return workflows, grpcutil.TranslateError(err)
@@ -81,6 +86,36 @@ func (c *errorTranslatingWorkflowServiceClient) TerminateWorkflow(ctx context.Co
 	return workflow, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) StopWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.StopWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) TerminateWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.TerminateWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) RetryWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.RetryWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) ResubmitWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.ResubmitWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) SuspendWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.SuspendWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) ResumeWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	res, err := c.delegate.ResumeWorkflows(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	workflow, err := c.delegate.LintWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
@@ -96,6 +131,11 @@ func (c *errorTranslatingWorkflowServiceClient) WorkflowLogs(ctx context.Context
 	return logs, grpcutil.TranslateError(err)
 }
 
+func (c *errorTranslatingWorkflowServiceClient) ExplainWorkflow(ctx context.Context, req *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	res, err := c.delegate.ExplainWorkflow(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
+
 func (c *errorTranslatingWorkflowServiceClient) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	workflow, err := c.delegate.SubmitWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
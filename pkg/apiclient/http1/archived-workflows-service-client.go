@@ -47,6 +47,11 @@ func (h ArchivedWorkflowsServiceClient) ListArchivedWorkflowLabelValues(ctx cont
 	return out, h.Get(ctx, in, out, "/api/v1/archived-workflows-label-values")
 }
 
+func (h ArchivedWorkflowsServiceClient) GetArchivedWorkflowStats(ctx context.Context, in *workflowarchivepkg.GetArchivedWorkflowStatsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ArchivedWorkflowStatsResponse, error) {
+	out := &workflowarchivepkg.ArchivedWorkflowStatsResponse{}
+	return out, h.Get(ctx, in, out, "/api/v1/archived-workflows-stats")
+}
+
 func (h ArchivedWorkflowsServiceClient) RetryArchivedWorkflow(ctx context.Context, in *workflowarchivepkg.RetryArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(ctx, in, out, "/api/v1/archived-workflows/{uid}/retry")
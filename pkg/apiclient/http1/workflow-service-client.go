@@ -26,6 +26,11 @@ func (h WorkflowServiceClient) ListWorkflows(ctx context.Context, in *workflowpk
 	return out, h.Get(ctx, in, out, "/api/v1/workflows/{namespace}")
 }
 
+func (h WorkflowServiceClient) ListAllWorkflows(ctx context.Context, in *workflowpkg.WorkflowListRequest, _ ...grpc.CallOption) (*wfv1.WorkflowList, error) {
+	out := &wfv1.WorkflowList{}
+	return out, h.Get(ctx, in, out, "/api/v1/all-workflows/{namespace}")
+}
+
 func (h WorkflowServiceClient) WatchWorkflows(ctx context.Context, in *workflowpkg.WatchWorkflowsRequest, _ ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	reader, err := h.EventStreamReader(ctx, in, "/api/v1/workflow-events/{namespace}")
 	if err != nil {
@@ -82,6 +87,36 @@ func (h WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflowpkg.
 	return out, h.Put(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/set")
 }
 
+func (h WorkflowServiceClient) StopWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/stop")
+}
+
+func (h WorkflowServiceClient) TerminateWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/terminate")
+}
+
+func (h WorkflowServiceClient) RetryWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/retry")
+}
+
+func (h WorkflowServiceClient) ResubmitWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/resubmit")
+}
+
+func (h WorkflowServiceClient) SuspendWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/suspend")
+}
+
+func (h WorkflowServiceClient) ResumeWorkflows(ctx context.Context, in *workflowpkg.WorkflowBulkRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	out := &workflowpkg.WorkflowBulkResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/resume")
+}
+
 func (h WorkflowServiceClient) LintWorkflow(ctx context.Context, in *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/lint")
@@ -103,6 +138,11 @@ func (h WorkflowServiceClient) WorkflowLogs(ctx context.Context, in *workflowpkg
 	return &podLogsClient{serverSentEventsClient{ctx, reader}}, nil
 }
 
+func (h WorkflowServiceClient) ExplainWorkflow(ctx context.Context, in *workflowpkg.WorkflowExplainRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	out := &workflowpkg.WorkflowExplainResponse{}
+	return out, h.Get(ctx, in, out, "/api/v1/workflows/{namespace}/{name}/decisions")
+}
+
 func (h WorkflowServiceClient) SubmitWorkflow(ctx context.Context, in *workflowpkg.WorkflowSubmitRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Post(ctx, in, out, "/api/v1/workflows/{namespace}/submit")
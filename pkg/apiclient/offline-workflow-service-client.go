@@ -30,6 +30,10 @@ func (o OfflineWorkflowServiceClient) ListWorkflows(context.Context, *workflowpk
 	return nil, ErrOffline
 }
 
+func (o OfflineWorkflowServiceClient) ListAllWorkflows(context.Context, *workflowpkg.WorkflowListRequest, ...grpc.CallOption) (*wfv1.WorkflowList, error) {
+	return nil, ErrOffline
+}
+
 func (o OfflineWorkflowServiceClient) WatchWorkflows(context.Context, *workflowpkg.WatchWorkflowsRequest, ...grpc.CallOption) (workflowpkg.WorkflowService_WatchWorkflowsClient, error) {
 	return nil, ErrOffline
 }
@@ -70,6 +74,30 @@ func (o OfflineWorkflowServiceClient) SetWorkflow(context.Context, *workflowpkg.
 	return nil, ErrOffline
 }
 
+func (o OfflineWorkflowServiceClient) StopWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) TerminateWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) RetryWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) ResubmitWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) SuspendWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
+func (o OfflineWorkflowServiceClient) ResumeWorkflows(context.Context, *workflowpkg.WorkflowBulkRequest, ...grpc.CallOption) (*workflowpkg.WorkflowBulkResponse, error) {
+	return nil, ErrOffline
+}
+
 func (o OfflineWorkflowServiceClient) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	err := validate.ValidateWorkflow(ctx, o.namespacedWorkflowTemplateGetterMap.GetNamespaceGetter(req.Namespace), o.clusterWorkflowTemplateGetter, req.Workflow, nil, validate.ValidateOpts{Lint: true})
 	if err != nil {
@@ -86,6 +114,10 @@ func (o OfflineWorkflowServiceClient) WorkflowLogs(context.Context, *workflowpkg
 	return nil, ErrOffline
 }
 
+func (o OfflineWorkflowServiceClient) ExplainWorkflow(context.Context, *workflowpkg.WorkflowExplainRequest, ...grpc.CallOption) (*workflowpkg.WorkflowExplainResponse, error) {
+	return nil, ErrOffline
+}
+
 func (o OfflineWorkflowServiceClient) SubmitWorkflow(context.Context, *workflowpkg.WorkflowSubmitRequest, ...grpc.CallOption) (*wfv1.Workflow, error) {
 	return nil, ErrOffline
 }
@@ -214,6 +214,93 @@ func (_c *WorkflowServiceClient_DeleteWorkflow_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// ExplainWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ExplainWorkflow(ctx context.Context, in *workflow.WorkflowExplainRequest, opts ...grpc.CallOption) (*workflow.WorkflowExplainResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainWorkflow")
+	}
+
+	var r0 *workflow.WorkflowExplainResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowExplainRequest, ...grpc.CallOption) (*workflow.WorkflowExplainResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowExplainRequest, ...grpc.CallOption) *workflow.WorkflowExplainResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowExplainResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowExplainRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_ExplainWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExplainWorkflow'
+type WorkflowServiceClient_ExplainWorkflow_Call struct {
+	*mock.Call
+}
+
+// ExplainWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowExplainRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) ExplainWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ExplainWorkflow_Call {
+	return &WorkflowServiceClient_ExplainWorkflow_Call{Call: _e.mock.On("ExplainWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_ExplainWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowExplainRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ExplainWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowExplainRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowExplainRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ExplainWorkflow_Call) Return(workflowExplainResponse *workflow.WorkflowExplainResponse, err error) *WorkflowServiceClient_ExplainWorkflow_Call {
+	_c.Call.Return(workflowExplainResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ExplainWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowExplainRequest, opts ...grpc.CallOption) (*workflow.WorkflowExplainResponse, error)) *WorkflowServiceClient_ExplainWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflow provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) GetWorkflow(ctx context.Context, in *workflow.WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
@@ -388,6 +475,93 @@ func (_c *WorkflowServiceClient_LintWorkflow_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListAllWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ListAllWorkflows(ctx context.Context, in *workflow.WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllWorkflows")
+	}
+
+	var r0 *v1alpha1.WorkflowList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowListRequest, ...grpc.CallOption) (*v1alpha1.WorkflowList, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowListRequest, ...grpc.CallOption) *v1alpha1.WorkflowList); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowListRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_ListAllWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllWorkflows'
+type WorkflowServiceClient_ListAllWorkflows_Call struct {
+	*mock.Call
+}
+
+// ListAllWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowListRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) ListAllWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ListAllWorkflows_Call {
+	return &WorkflowServiceClient_ListAllWorkflows_Call{Call: _e.mock.On("ListAllWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_ListAllWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowListRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ListAllWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowListRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ListAllWorkflows_Call) Return(workflowList *v1alpha1.WorkflowList, err error) *WorkflowServiceClient_ListAllWorkflows_Call {
+	_c.Call.Return(workflowList, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_ListAllWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)) *WorkflowServiceClient_ListAllWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflows provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) ListWorkflows(ctx context.Context, in *workflow.WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
 	// grpc.CallOption
@@ -649,8 +823,8 @@ func (_c *WorkflowServiceClient_ResubmitWorkflow_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
-// ResumeWorkflow provides a mock function for the type WorkflowServiceClient
-func (_mock *WorkflowServiceClient) ResumeWorkflow(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+// ResubmitWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ResubmitWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -662,22 +836,22 @@ func (_mock *WorkflowServiceClient) ResumeWorkflow(ctx context.Context, in *work
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResumeWorkflow")
+		panic("no return value specified for ResubmitWorkflows")
 	}
 
-	var r0 *v1alpha1.Workflow
+	var r0 *workflow.WorkflowBulkResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.Workflow)
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -685,29 +859,29 @@ func (_mock *WorkflowServiceClient) ResumeWorkflow(ctx context.Context, in *work
 	return r0, r1
 }
 
-// WorkflowServiceClient_ResumeWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflow'
-type WorkflowServiceClient_ResumeWorkflow_Call struct {
+// WorkflowServiceClient_ResubmitWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResubmitWorkflows'
+type WorkflowServiceClient_ResubmitWorkflows_Call struct {
 	*mock.Call
 }
 
-// ResumeWorkflow is a helper method to define mock.On call
+// ResubmitWorkflows is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *workflow.WorkflowResumeRequest
+//   - in *workflow.WorkflowBulkRequest
 //   - opts ...grpc.CallOption
-func (_e *WorkflowServiceClient_Expecter) ResumeWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ResumeWorkflow_Call {
-	return &WorkflowServiceClient_ResumeWorkflow_Call{Call: _e.mock.On("ResumeWorkflow",
+func (_e *WorkflowServiceClient_Expecter) ResubmitWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ResubmitWorkflows_Call {
+	return &WorkflowServiceClient_ResubmitWorkflows_Call{Call: _e.mock.On("ResubmitWorkflows",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *WorkflowServiceClient_ResumeWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ResumeWorkflow_Call {
+func (_c *WorkflowServiceClient_ResubmitWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ResubmitWorkflows_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *workflow.WorkflowResumeRequest
+		var arg1 *workflow.WorkflowBulkRequest
 		if args[1] != nil {
-			arg1 = args[1].(*workflow.WorkflowResumeRequest)
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -726,18 +900,18 @@ func (_c *WorkflowServiceClient_ResumeWorkflow_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *WorkflowServiceClient_ResumeWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_ResumeWorkflow_Call {
-	_c.Call.Return(workflow1, err)
+func (_c *WorkflowServiceClient_ResubmitWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_ResubmitWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
 	return _c
 }
 
-func (_c *WorkflowServiceClient_ResumeWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_ResumeWorkflow_Call {
+func (_c *WorkflowServiceClient_ResubmitWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_ResubmitWorkflows_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RetryWorkflow provides a mock function for the type WorkflowServiceClient
-func (_mock *WorkflowServiceClient) RetryWorkflow(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+// ResumeWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ResumeWorkflow(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -749,22 +923,22 @@ func (_mock *WorkflowServiceClient) RetryWorkflow(ctx context.Context, in *workf
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RetryWorkflow")
+		panic("no return value specified for ResumeWorkflow")
 	}
 
 	var r0 *v1alpha1.Workflow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.Workflow)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowResumeRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -772,29 +946,29 @@ func (_mock *WorkflowServiceClient) RetryWorkflow(ctx context.Context, in *workf
 	return r0, r1
 }
 
-// WorkflowServiceClient_RetryWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryWorkflow'
-type WorkflowServiceClient_RetryWorkflow_Call struct {
+// WorkflowServiceClient_ResumeWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflow'
+type WorkflowServiceClient_ResumeWorkflow_Call struct {
 	*mock.Call
 }
 
-// RetryWorkflow is a helper method to define mock.On call
+// ResumeWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *workflow.WorkflowRetryRequest
+//   - in *workflow.WorkflowResumeRequest
 //   - opts ...grpc.CallOption
-func (_e *WorkflowServiceClient_Expecter) RetryWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_RetryWorkflow_Call {
-	return &WorkflowServiceClient_RetryWorkflow_Call{Call: _e.mock.On("RetryWorkflow",
+func (_e *WorkflowServiceClient_Expecter) ResumeWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ResumeWorkflow_Call {
+	return &WorkflowServiceClient_ResumeWorkflow_Call{Call: _e.mock.On("ResumeWorkflow",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *WorkflowServiceClient_RetryWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_RetryWorkflow_Call {
+func (_c *WorkflowServiceClient_ResumeWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ResumeWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *workflow.WorkflowRetryRequest
+		var arg1 *workflow.WorkflowResumeRequest
 		if args[1] != nil {
-			arg1 = args[1].(*workflow.WorkflowRetryRequest)
+			arg1 = args[1].(*workflow.WorkflowResumeRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -813,18 +987,18 @@ func (_c *WorkflowServiceClient_RetryWorkflow_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *WorkflowServiceClient_RetryWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_RetryWorkflow_Call {
+func (_c *WorkflowServiceClient_ResumeWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_ResumeWorkflow_Call {
 	_c.Call.Return(workflow1, err)
 	return _c
 }
 
-func (_c *WorkflowServiceClient_RetryWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_RetryWorkflow_Call {
+func (_c *WorkflowServiceClient_ResumeWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_ResumeWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetWorkflow provides a mock function for the type WorkflowServiceClient
-func (_mock *WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+// ResumeWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) ResumeWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -836,22 +1010,22 @@ func (_mock *WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflo
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetWorkflow")
+		panic("no return value specified for ResumeWorkflows")
 	}
 
-	var r0 *v1alpha1.Workflow
+	var r0 *workflow.WorkflowBulkResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.Workflow)
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -859,29 +1033,29 @@ func (_mock *WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflo
 	return r0, r1
 }
 
-// WorkflowServiceClient_SetWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkflow'
-type WorkflowServiceClient_SetWorkflow_Call struct {
+// WorkflowServiceClient_ResumeWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeWorkflows'
+type WorkflowServiceClient_ResumeWorkflows_Call struct {
 	*mock.Call
 }
 
-// SetWorkflow is a helper method to define mock.On call
+// ResumeWorkflows is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *workflow.WorkflowSetRequest
+//   - in *workflow.WorkflowBulkRequest
 //   - opts ...grpc.CallOption
-func (_e *WorkflowServiceClient_Expecter) SetWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_SetWorkflow_Call {
-	return &WorkflowServiceClient_SetWorkflow_Call{Call: _e.mock.On("SetWorkflow",
+func (_e *WorkflowServiceClient_Expecter) ResumeWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_ResumeWorkflows_Call {
+	return &WorkflowServiceClient_ResumeWorkflows_Call{Call: _e.mock.On("ResumeWorkflows",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *WorkflowServiceClient_SetWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_SetWorkflow_Call {
+func (_c *WorkflowServiceClient_ResumeWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_ResumeWorkflows_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *workflow.WorkflowSetRequest
+		var arg1 *workflow.WorkflowBulkRequest
 		if args[1] != nil {
-			arg1 = args[1].(*workflow.WorkflowSetRequest)
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -900,18 +1074,18 @@ func (_c *WorkflowServiceClient_SetWorkflow_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *WorkflowServiceClient_SetWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_SetWorkflow_Call {
-	_c.Call.Return(workflow1, err)
+func (_c *WorkflowServiceClient_ResumeWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_ResumeWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
 	return _c
 }
 
-func (_c *WorkflowServiceClient_SetWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_SetWorkflow_Call {
+func (_c *WorkflowServiceClient_ResumeWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_ResumeWorkflows_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StopWorkflow provides a mock function for the type WorkflowServiceClient
-func (_mock *WorkflowServiceClient) StopWorkflow(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+// RetryWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) RetryWorkflow(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -923,22 +1097,22 @@ func (_mock *WorkflowServiceClient) StopWorkflow(ctx context.Context, in *workfl
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StopWorkflow")
+		panic("no return value specified for RetryWorkflow")
 	}
 
 	var r0 *v1alpha1.Workflow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.Workflow)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowRetryRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -946,29 +1120,29 @@ func (_mock *WorkflowServiceClient) StopWorkflow(ctx context.Context, in *workfl
 	return r0, r1
 }
 
-// WorkflowServiceClient_StopWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopWorkflow'
-type WorkflowServiceClient_StopWorkflow_Call struct {
+// WorkflowServiceClient_RetryWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryWorkflow'
+type WorkflowServiceClient_RetryWorkflow_Call struct {
 	*mock.Call
 }
 
-// StopWorkflow is a helper method to define mock.On call
+// RetryWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *workflow.WorkflowStopRequest
+//   - in *workflow.WorkflowRetryRequest
 //   - opts ...grpc.CallOption
-func (_e *WorkflowServiceClient_Expecter) StopWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_StopWorkflow_Call {
-	return &WorkflowServiceClient_StopWorkflow_Call{Call: _e.mock.On("StopWorkflow",
+func (_e *WorkflowServiceClient_Expecter) RetryWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_RetryWorkflow_Call {
+	return &WorkflowServiceClient_RetryWorkflow_Call{Call: _e.mock.On("RetryWorkflow",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *WorkflowServiceClient_StopWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_StopWorkflow_Call {
+func (_c *WorkflowServiceClient_RetryWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_RetryWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *workflow.WorkflowStopRequest
+		var arg1 *workflow.WorkflowRetryRequest
 		if args[1] != nil {
-			arg1 = args[1].(*workflow.WorkflowStopRequest)
+			arg1 = args[1].(*workflow.WorkflowRetryRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -987,18 +1161,18 @@ func (_c *WorkflowServiceClient_StopWorkflow_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *WorkflowServiceClient_StopWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_StopWorkflow_Call {
+func (_c *WorkflowServiceClient_RetryWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_RetryWorkflow_Call {
 	_c.Call.Return(workflow1, err)
 	return _c
 }
 
-func (_c *WorkflowServiceClient_StopWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_StopWorkflow_Call {
+func (_c *WorkflowServiceClient_RetryWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowRetryRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_RetryWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SubmitWorkflow provides a mock function for the type WorkflowServiceClient
-func (_mock *WorkflowServiceClient) SubmitWorkflow(ctx context.Context, in *workflow.WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+// RetryWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) RetryWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -1010,12 +1184,360 @@ func (_mock *WorkflowServiceClient) SubmitWorkflow(ctx context.Context, in *work
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SubmitWorkflow")
+		panic("no return value specified for RetryWorkflows")
 	}
 
-	var r0 *v1alpha1.Workflow
+	var r0 *workflow.WorkflowBulkResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSubmitRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_RetryWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryWorkflows'
+type WorkflowServiceClient_RetryWorkflows_Call struct {
+	*mock.Call
+}
+
+// RetryWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowBulkRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) RetryWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_RetryWorkflows_Call {
+	return &WorkflowServiceClient_RetryWorkflows_Call{Call: _e.mock.On("RetryWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_RetryWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_RetryWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_RetryWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_RetryWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_RetryWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_RetryWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) SetWorkflow(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWorkflow")
+	}
+
+	var r0 *v1alpha1.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowSetRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_SetWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkflow'
+type WorkflowServiceClient_SetWorkflow_Call struct {
+	*mock.Call
+}
+
+// SetWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowSetRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) SetWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_SetWorkflow_Call {
+	return &WorkflowServiceClient_SetWorkflow_Call{Call: _e.mock.On("SetWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_SetWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_SetWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowSetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowSetRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_SetWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_SetWorkflow_Call {
+	_c.Call.Return(workflow1, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_SetWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_SetWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) StopWorkflow(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopWorkflow")
+	}
+
+	var r0 *v1alpha1.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowStopRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_StopWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopWorkflow'
+type WorkflowServiceClient_StopWorkflow_Call struct {
+	*mock.Call
+}
+
+// StopWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowStopRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) StopWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_StopWorkflow_Call {
+	return &WorkflowServiceClient_StopWorkflow_Call{Call: _e.mock.On("StopWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_StopWorkflow_Call) Run(run func(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_StopWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowStopRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowStopRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_StopWorkflow_Call) Return(workflow1 *v1alpha1.Workflow, err error) *WorkflowServiceClient_StopWorkflow_Call {
+	_c.Call.Return(workflow1, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_StopWorkflow_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)) *WorkflowServiceClient_StopWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) StopWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopWorkflows")
+	}
+
+	var r0 *workflow.WorkflowBulkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_StopWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopWorkflows'
+type WorkflowServiceClient_StopWorkflows_Call struct {
+	*mock.Call
+}
+
+// StopWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowBulkRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) StopWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_StopWorkflows_Call {
+	return &WorkflowServiceClient_StopWorkflows_Call{Call: _e.mock.On("StopWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_StopWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_StopWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_StopWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_StopWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_StopWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_StopWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitWorkflow provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) SubmitWorkflow(ctx context.Context, in *workflow.WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitWorkflow")
+	}
+
+	var r0 *v1alpha1.Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSubmitRequest, ...grpc.CallOption) (*v1alpha1.Workflow, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowSubmitRequest, ...grpc.CallOption) *v1alpha1.Workflow); ok {
@@ -1171,6 +1693,93 @@ func (_c *WorkflowServiceClient_SuspendWorkflow_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// SuspendWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) SuspendWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuspendWorkflows")
+	}
+
+	var r0 *workflow.WorkflowBulkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_SuspendWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendWorkflows'
+type WorkflowServiceClient_SuspendWorkflows_Call struct {
+	*mock.Call
+}
+
+// SuspendWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowBulkRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) SuspendWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_SuspendWorkflows_Call {
+	return &WorkflowServiceClient_SuspendWorkflows_Call{Call: _e.mock.On("SuspendWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_SuspendWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_SuspendWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_SuspendWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_SuspendWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_SuspendWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_SuspendWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateWorkflow provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) TerminateWorkflow(ctx context.Context, in *workflow.WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	// grpc.CallOption
@@ -1258,6 +1867,93 @@ func (_c *WorkflowServiceClient_TerminateWorkflow_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// TerminateWorkflows provides a mock function for the type WorkflowServiceClient
+func (_mock *WorkflowServiceClient) TerminateWorkflows(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TerminateWorkflows")
+	}
+
+	var r0 *workflow.WorkflowBulkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) *workflow.WorkflowBulkResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowServiceClient_TerminateWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TerminateWorkflows'
+type WorkflowServiceClient_TerminateWorkflows_Call struct {
+	*mock.Call
+}
+
+// TerminateWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workflow.WorkflowBulkRequest
+//   - opts ...grpc.CallOption
+func (_e *WorkflowServiceClient_Expecter) TerminateWorkflows(ctx interface{}, in interface{}, opts ...interface{}) *WorkflowServiceClient_TerminateWorkflows_Call {
+	return &WorkflowServiceClient_TerminateWorkflows_Call{Call: _e.mock.On("TerminateWorkflows",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *WorkflowServiceClient_TerminateWorkflows_Call) Run(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption)) *WorkflowServiceClient_TerminateWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *workflow.WorkflowBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*workflow.WorkflowBulkRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowServiceClient_TerminateWorkflows_Call) Return(workflowBulkResponse *workflow.WorkflowBulkResponse, err error) *WorkflowServiceClient_TerminateWorkflows_Call {
+	_c.Call.Return(workflowBulkResponse, err)
+	return _c
+}
+
+func (_c *WorkflowServiceClient_TerminateWorkflows_Call) RunAndReturn(run func(ctx context.Context, in *workflow.WorkflowBulkRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkResponse, error)) *WorkflowServiceClient_TerminateWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchEvents provides a mock function for the type WorkflowServiceClient
 func (_mock *WorkflowServiceClient) WatchEvents(ctx context.Context, in *workflow.WatchEventsRequest, opts ...grpc.CallOption) (workflow.WorkflowService_WatchEventsClient, error) {
 	// grpc.CallOption
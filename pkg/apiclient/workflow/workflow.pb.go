@@ -193,9 +193,11 @@ type WorkflowListRequest struct {
 	// Fields to be included or excluded in the response. e.g. "items.spec,items.status.phase", "-items.status.nodes"
 	Fields string `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
 	// Filter type used for name filtering. Exact | Contains | Prefix. Default to Exact
-	NameFilter           string   `protobuf:"bytes,4,opt,name=nameFilter,proto3" json:"nameFilter,omitempty"`
-	CreatedAfter         string   `protobuf:"bytes,5,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
-	FinishedBefore       string   `protobuf:"bytes,6,opt,name=finishedBefore,proto3" json:"finishedBefore,omitempty"`
+	NameFilter     string `protobuf:"bytes,4,opt,name=nameFilter,proto3" json:"nameFilter,omitempty"`
+	CreatedAfter   string `protobuf:"bytes,5,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	FinishedBefore string `protobuf:"bytes,6,opt,name=finishedBefore,proto3" json:"finishedBefore,omitempty"`
+	// Search query, e.g. "param.message=hello node.phase=Failed". See the workflow search docs for the syntax.
+	Query                string   `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,6 +278,13 @@ func (m *WorkflowListRequest) GetFinishedBefore() string {
 	return ""
 }
 
+func (m *WorkflowListRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type WorkflowResubmitRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1310,6 +1319,392 @@ func (m *WorkflowSubmitRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
 	return nil
 }
 
+// WorkflowBulkRequest selects workflows by label/field selector, phase and age, and applies an action to each of them.
+type WorkflowBulkRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Label and field selectors used to select workflows
+	ListOptions *v1.ListOptions `protobuf:"bytes,2,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// Only select workflows in one of these phases, e.g. "Running", "Failed"
+	Phases []string `protobuf:"bytes,3,rep,name=phases,proto3" json:"phases,omitempty"`
+	// Only select workflows that finished (or, if not yet finished, were created) longer ago than this duration, e.g. "10m", "3h", "1d"
+	Older string `protobuf:"bytes,4,opt,name=older,proto3" json:"older,omitempty"`
+	// Maximum number of workflows to act on concurrently. Defaults to 10.
+	Parallelism int32 `protobuf:"varint,5,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// Only report the workflows that would be acted on, without acting on them
+	DryRun bool `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Used by stop
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Used by stop, retry and resume
+	NodeFieldSelector string `protobuf:"bytes,8,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	// Used by retry
+	RestartSuccessful bool `protobuf:"varint,9,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	// Used by retry and resubmit
+	Parameters []string `protobuf:"bytes,10,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// Used by resubmit
+	Memoized             bool     `protobuf:"varint,11,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowBulkRequest) Reset()         { *m = WorkflowBulkRequest{} }
+func (m *WorkflowBulkRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkRequest) ProtoMessage()    {}
+func (*WorkflowBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{19}
+}
+func (m *WorkflowBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkRequest.Merge(m, src)
+}
+func (m *WorkflowBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowBulkRequest proto.InternalMessageInfo
+
+func (m *WorkflowBulkRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowBulkRequest) GetListOptions() *v1.ListOptions {
+	if m != nil {
+		return m.ListOptions
+	}
+	return nil
+}
+
+func (m *WorkflowBulkRequest) GetPhases() []string {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *WorkflowBulkRequest) GetOlder() string {
+	if m != nil {
+		return m.Older
+	}
+	return ""
+}
+
+func (m *WorkflowBulkRequest) GetParallelism() int32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
+func (m *WorkflowBulkRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *WorkflowBulkRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *WorkflowBulkRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+func (m *WorkflowBulkRequest) GetRestartSuccessful() bool {
+	if m != nil {
+		return m.RestartSuccessful
+	}
+	return false
+}
+
+func (m *WorkflowBulkRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *WorkflowBulkRequest) GetMemoized() bool {
+	if m != nil {
+		return m.Memoized
+	}
+	return false
+}
+
+type WorkflowBulkResult struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The phase of the workflow after the action, or the phase it was in for a dry-run or on error
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// The name of the workflow created by the action, only set by resubmit
+	CreatedName string `protobuf:"bytes,4,opt,name=createdName,proto3" json:"createdName,omitempty"`
+	// Non-empty if the action failed for this workflow
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowBulkResult) Reset()         { *m = WorkflowBulkResult{} }
+func (m *WorkflowBulkResult) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkResult) ProtoMessage()    {}
+func (*WorkflowBulkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{20}
+}
+func (m *WorkflowBulkResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowBulkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowBulkResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowBulkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkResult.Merge(m, src)
+}
+func (m *WorkflowBulkResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowBulkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowBulkResult proto.InternalMessageInfo
+
+func (m *WorkflowBulkResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowBulkResult) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowBulkResult) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *WorkflowBulkResult) GetCreatedName() string {
+	if m != nil {
+		return m.CreatedName
+	}
+	return ""
+}
+
+func (m *WorkflowBulkResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type WorkflowBulkResponse struct {
+	Results              []*WorkflowBulkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun               bool                  `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WorkflowBulkResponse) Reset()         { *m = WorkflowBulkResponse{} }
+func (m *WorkflowBulkResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowBulkResponse) ProtoMessage()    {}
+func (*WorkflowBulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{21}
+}
+func (m *WorkflowBulkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowBulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowBulkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowBulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBulkResponse.Merge(m, src)
+}
+func (m *WorkflowBulkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowBulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBulkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowBulkResponse proto.InternalMessageInfo
+
+func (m *WorkflowBulkResponse) GetResults() []*WorkflowBulkResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *WorkflowBulkResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type WorkflowExplainRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only explain the node with this ID, name or display name
+	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExplainRequest) Reset()         { *m = WorkflowExplainRequest{} }
+func (m *WorkflowExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExplainRequest) ProtoMessage()    {}
+func (*WorkflowExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{22}
+}
+func (m *WorkflowExplainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExplainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExplainRequest.Merge(m, src)
+}
+func (m *WorkflowExplainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExplainRequest proto.InternalMessageInfo
+
+func (m *WorkflowExplainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowExplainRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowExplainRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+type WorkflowExplainResponse struct {
+	Decisions            []*v1alpha1.Decision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WorkflowExplainResponse) Reset()         { *m = WorkflowExplainResponse{} }
+func (m *WorkflowExplainResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExplainResponse) ProtoMessage()    {}
+func (*WorkflowExplainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{23}
+}
+func (m *WorkflowExplainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExplainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExplainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExplainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExplainResponse.Merge(m, src)
+}
+func (m *WorkflowExplainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExplainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExplainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExplainResponse proto.InternalMessageInfo
+
+func (m *WorkflowExplainResponse) GetDecisions() []*v1alpha1.Decision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
 	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
@@ -1330,6 +1725,11 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
 	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
 	proto.RegisterType((*WorkflowSubmitRequest)(nil), "workflow.WorkflowSubmitRequest")
+	proto.RegisterType((*WorkflowBulkRequest)(nil), "workflow.WorkflowBulkRequest")
+	proto.RegisterType((*WorkflowBulkResult)(nil), "workflow.WorkflowBulkResult")
+	proto.RegisterType((*WorkflowBulkResponse)(nil), "workflow.WorkflowBulkResponse")
+	proto.RegisterType((*WorkflowExplainRequest)(nil), "workflow.WorkflowExplainRequest")
+	proto.RegisterType((*WorkflowExplainResponse)(nil), "workflow.WorkflowExplainResponse")
 }
 
 func init() {
@@ -1337,100 +1737,124 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcd, 0x8f, 0x14, 0x45,
-	0x1b, 0xc0, 0x53, 0xb3, 0xb0, 0x2c, 0xb5, 0x1f, 0x40, 0xbd, 0xc0, 0x3b, 0x6f, 0x07, 0x96, 0xa5,
-	0x78, 0xc1, 0x65, 0x61, 0xbb, 0xf7, 0x03, 0x15, 0x4c, 0x34, 0x01, 0x16, 0x36, 0xe2, 0x88, 0x64,
-	0xc6, 0xc4, 0xe8, 0xc5, 0xf4, 0xf6, 0x3c, 0xd3, 0xdb, 0x6c, 0x4f, 0x57, 0x5b, 0x55, 0x33, 0x64,
-	0x45, 0x4c, 0xf4, 0xa2, 0x07, 0x12, 0x0f, 0x1e, 0xbd, 0x99, 0x18, 0x3d, 0x18, 0x35, 0x26, 0x26,
-	0x46, 0x13, 0xe3, 0xc1, 0x83, 0x47, 0x12, 0xae, 0x1e, 0x0c, 0xf1, 0x1f, 0xf0, 0x3f, 0x30, 0x55,
-	0xfd, 0xbd, 0x33, 0x0c, 0xed, 0xee, 0xa0, 0xdc, 0xaa, 0xaa, 0xab, 0xea, 0xf9, 0xd5, 0xf3, 0x54,
-	0x3d, 0x1f, 0x33, 0xf8, 0x64, 0xb8, 0xe1, 0x5a, 0x76, 0xe8, 0x39, 0xbe, 0x07, 0x81, 0xb4, 0x6e,
-	0x31, 0xbe, 0xd1, 0xf2, 0xd9, 0xad, 0xb4, 0x61, 0x86, 0x9c, 0x49, 0x46, 0xc6, 0x92, 0xbe, 0x71,
-	0xc4, 0x65, 0xcc, 0xf5, 0x41, 0xad, 0xb1, 0xec, 0x20, 0x60, 0xd2, 0x96, 0x1e, 0x0b, 0x44, 0x34,
-	0xcf, 0x38, 0xb7, 0x71, 0x5e, 0x98, 0x1e, 0x53, 0x5f, 0xdb, 0xb6, 0xb3, 0xee, 0x05, 0xc0, 0x37,
-	0xad, 0x58, 0x84, 0xb0, 0xda, 0x20, 0x6d, 0xab, 0xbb, 0x68, 0xb9, 0x10, 0x00, 0xb7, 0x25, 0x34,
-	0xe3, 0x55, 0x2f, 0xbb, 0x9e, 0x5c, 0xef, 0xac, 0x99, 0x0e, 0x6b, 0x5b, 0x36, 0x77, 0x59, 0xc8,
-	0xd9, 0x4d, 0xdd, 0x98, 0x4f, 0xc4, 0x8a, 0x6c, 0x93, 0x14, 0xb1, 0xbb, 0x68, 0xfb, 0xe1, 0xba,
-	0xdd, 0xbb, 0x1d, 0xcd, 0x20, 0x2c, 0x87, 0x71, 0xe8, 0x23, 0x92, 0xfe, 0x5c, 0xc1, 0x87, 0x5e,
-	0x8b, 0x77, 0xba, 0xcc, 0xc1, 0x96, 0x50, 0x87, 0xb7, 0x3a, 0x20, 0x24, 0x39, 0x82, 0xf7, 0x06,
-	0x76, 0x1b, 0x44, 0x68, 0x3b, 0x50, 0x45, 0x33, 0x68, 0x76, 0x6f, 0x3d, 0x1b, 0x20, 0x2d, 0x9c,
-	0xaa, 0xa2, 0x5a, 0x99, 0x41, 0xb3, 0xe3, 0x4b, 0xd7, 0xcc, 0x8c, 0xde, 0x4c, 0xe8, 0x75, 0xe3,
-	0xcd, 0x94, 0xde, 0xec, 0x2e, 0x9b, 0xe1, 0x86, 0x6b, 0xaa, 0x03, 0x98, 0xa9, 0x6a, 0x93, 0x03,
-	0x98, 0x09, 0x48, 0x3d, 0xdd, 0x9b, 0x50, 0x8c, 0xbd, 0x40, 0x48, 0x3b, 0x70, 0xe0, 0xc5, 0x95,
-	0xea, 0x88, 0xc2, 0xb8, 0x54, 0xa9, 0xa2, 0x7a, 0x6e, 0x94, 0x50, 0x3c, 0x21, 0x80, 0x77, 0x81,
-	0xaf, 0xf0, 0xcd, 0x7a, 0x27, 0xa8, 0xee, 0x9a, 0x41, 0xb3, 0x63, 0xf5, 0xc2, 0x18, 0x79, 0x1d,
-	0x4f, 0x3a, 0xfa, 0x78, 0xaf, 0x84, 0xda, 0x4e, 0xd5, 0xdd, 0x1a, 0x7a, 0xd9, 0x8c, 0x74, 0x64,
-	0xe6, 0x0d, 0x95, 0x21, 0x2a, 0x43, 0x99, 0xdd, 0x45, 0xf3, 0x72, 0x7e, 0x69, 0xbd, 0xb8, 0x13,
-	0xfd, 0x06, 0x61, 0x92, 0x90, 0xaf, 0x82, 0x4c, 0xf4, 0x47, 0xf0, 0x2e, 0xa5, 0xae, 0x58, 0x75,
-	0xba, 0x5d, 0xd4, 0x69, 0x65, 0xab, 0x4e, 0x6f, 0x60, 0xec, 0x82, 0x4c, 0x00, 0x47, 0x34, 0xe0,
-	0x42, 0x39, 0xc0, 0xd5, 0x74, 0x5d, 0x3d, 0xb7, 0x07, 0x39, 0x8c, 0x47, 0x5b, 0x1e, 0xf8, 0x4d,
-	0xa1, 0x75, 0xb2, 0xb7, 0x1e, 0xf7, 0xe8, 0xdd, 0x0a, 0xfe, 0x4f, 0x82, 0x5c, 0xf3, 0x84, 0x2c,
-	0x67, 0xf3, 0x06, 0x1e, 0xf7, 0x3d, 0x91, 0x02, 0x46, 0x66, 0x5f, 0x2c, 0x07, 0x58, 0xcb, 0x16,
-	0xd6, 0xf3, 0xbb, 0xe4, 0x10, 0x47, 0xf2, 0x88, 0x64, 0x1a, 0x63, 0x25, 0xf9, 0xaa, 0xe7, 0x4b,
-	0xe0, 0x31, 0x7e, 0x6e, 0x44, 0x19, 0x3d, 0x32, 0x43, 0xf3, 0x62, 0x4b, 0xcd, 0xd8, 0xad, 0x67,
-	0x14, 0xc6, 0xc8, 0x29, 0x3c, 0xd5, 0xf2, 0x02, 0x4f, 0xac, 0x43, 0xf3, 0x12, 0xb4, 0x18, 0x87,
-	0xea, 0xa8, 0x9e, 0xb5, 0x65, 0x94, 0x7e, 0x80, 0xf0, 0x7f, 0xd3, 0xbb, 0x07, 0xa2, 0xb3, 0xd6,
-	0xf6, 0x76, 0x60, 0x46, 0x03, 0x8f, 0xb5, 0xa1, 0xcd, 0xbc, 0xb7, 0xa1, 0xa9, 0xcf, 0x34, 0x56,
-	0x4f, 0xfb, 0xea, 0x54, 0xa1, 0xcd, 0xed, 0x36, 0x48, 0xe0, 0xea, 0x0e, 0x8e, 0xa8, 0x53, 0x65,
-	0x23, 0xf4, 0x17, 0x84, 0x0f, 0x66, 0x24, 0x92, 0x6f, 0x6e, 0x1f, 0xe3, 0x2c, 0x3e, 0xc0, 0x41,
-	0x48, 0x9b, 0xcb, 0x46, 0xc7, 0x71, 0x40, 0x88, 0x56, 0xc7, 0x8f, 0x79, 0x7a, 0x3f, 0xa8, 0xd9,
-	0x01, 0x6b, 0xc2, 0x55, 0xa5, 0xfc, 0x06, 0xf8, 0xe0, 0x48, 0x96, 0x68, 0xbd, 0xf7, 0xc3, 0x23,
-	0x8f, 0x71, 0x2b, 0x73, 0x2a, 0x4a, 0x9f, 0x6d, 0xd8, 0xd1, 0x31, 0x7a, 0xc1, 0x46, 0x1e, 0x02,
-	0x46, 0x6b, 0xb8, 0x9a, 0x08, 0x7e, 0x15, 0x78, 0xdb, 0x0b, 0x72, 0x0e, 0xed, 0x6f, 0xcb, 0xa6,
-	0x1f, 0xa1, 0xec, 0x99, 0x34, 0x24, 0x0b, 0xff, 0xa1, 0x53, 0x90, 0x2a, 0xde, 0xd3, 0x06, 0x21,
-	0x6c, 0x17, 0x62, 0x13, 0x24, 0x5d, 0x7a, 0x2f, 0xe7, 0x6b, 0x1a, 0x3b, 0xf1, 0x35, 0x43, 0x02,
-	0x22, 0x07, 0xf1, 0xee, 0x70, 0xdd, 0x16, 0x10, 0xbf, 0xbf, 0xa8, 0x43, 0xe6, 0xf0, 0x7e, 0xd6,
-	0x91, 0x61, 0x47, 0xde, 0xc8, 0x6e, 0x49, 0xf4, 0xf4, 0x7a, 0xc6, 0xe9, 0x35, 0x7c, 0x38, 0x3d,
-	0x51, 0x47, 0x84, 0x10, 0x34, 0xb7, 0x6f, 0xb0, 0xfb, 0x39, 0xf5, 0xd4, 0x98, 0xbb, 0x7d, 0xf5,
-	0x54, 0xf1, 0x9e, 0x90, 0x35, 0xaf, 0xab, 0x45, 0x91, 0x52, 0x92, 0x2e, 0xb9, 0x88, 0xb1, 0xcf,
-	0xdc, 0xc4, 0x07, 0xee, 0xd2, 0x3e, 0xf0, 0x78, 0xce, 0x07, 0x9a, 0x2a, 0xd2, 0x2a, 0x8f, 0x77,
-	0x83, 0x35, 0x6b, 0xe9, 0xc4, 0x7a, 0x6e, 0x91, 0xc2, 0x71, 0x39, 0x84, 0xb1, 0xca, 0x74, 0x5b,
-	0x39, 0x0d, 0x91, 0x98, 0x21, 0xd2, 0x54, 0xda, 0xa7, 0x3f, 0xa0, 0xec, 0x39, 0xad, 0x80, 0x0f,
-	0x3b, 0xb8, 0xd2, 0x2a, 0x0e, 0x36, 0xf5, 0x16, 0xc5, 0x30, 0x53, 0x32, 0x0e, 0xae, 0xe4, 0x97,
-	0xd6, 0x8b, 0x3b, 0xa9, 0xab, 0xd0, 0x62, 0xdc, 0x81, 0x38, 0xfe, 0x46, 0x1d, 0x5a, 0xcd, 0xcc,
-	0x9b, 0xb0, 0x8b, 0x90, 0x05, 0x02, 0xe8, 0xa7, 0xea, 0x58, 0xb6, 0x74, 0xd6, 0x93, 0xef, 0xe2,
-	0xc9, 0x0b, 0x43, 0xf4, 0x6e, 0xee, 0x46, 0x69, 0xd8, 0x2b, 0x5d, 0x08, 0xb4, 0xe2, 0xe5, 0x66,
-	0x98, 0x2a, 0x5e, 0xb5, 0xc9, 0x1a, 0x1e, 0x65, 0x6b, 0x37, 0xc1, 0x91, 0x8f, 0x21, 0x21, 0x8a,
-	0x77, 0x56, 0x91, 0x8a, 0x64, 0x18, 0xff, 0xa2, 0xc2, 0xe8, 0x0b, 0x78, 0xac, 0xc6, 0xdc, 0x2b,
-	0x81, 0xe4, 0x9b, 0xea, 0xb5, 0x38, 0x2c, 0x90, 0x10, 0xc8, 0x58, 0x78, 0xd2, 0xcd, 0xbf, 0xa3,
-	0x4a, 0xe1, 0x1d, 0xd1, 0x4f, 0x50, 0x3e, 0x05, 0x09, 0xe4, 0x13, 0x95, 0x76, 0xd2, 0x3f, 0x73,
-	0x4f, 0xae, 0x51, 0xc8, 0x07, 0x06, 0xf3, 0x51, 0x3c, 0xc1, 0x41, 0xb0, 0x0e, 0x77, 0xe0, 0x25,
-	0x2f, 0x68, 0xc6, 0x87, 0x2e, 0x8c, 0xe5, 0xe7, 0xe4, 0x1c, 0x4c, 0x61, 0x8c, 0x70, 0x3c, 0x19,
-	0xa5, 0x21, 0x45, 0x47, 0x53, 0xdb, 0xf9, 0x61, 0x1b, 0xc9, 0xb6, 0xa2, 0x5e, 0x14, 0xb1, 0xf4,
-	0xdb, 0x21, 0xbc, 0x2f, 0x8b, 0x2d, 0xbc, 0xeb, 0x39, 0x40, 0x3e, 0x47, 0x78, 0x2a, 0x4a, 0x7e,
-	0x93, 0x2f, 0xe4, 0x58, 0xb6, 0x69, 0xdf, 0xc2, 0xc1, 0x18, 0xa2, 0x45, 0xe8, 0xec, 0xfb, 0xf7,
-	0xff, 0xf8, 0xb8, 0x42, 0xe9, 0x51, 0x5d, 0xc4, 0x74, 0x17, 0xad, 0xac, 0x10, 0xba, 0x9d, 0x6a,
-	0xfd, 0xce, 0x73, 0x68, 0x8e, 0x7c, 0x86, 0xf0, 0xf8, 0x2a, 0xc8, 0x14, 0xf3, 0x48, 0x2f, 0x66,
-	0x96, 0x9c, 0x0f, 0x95, 0xf1, 0xac, 0x66, 0x3c, 0x45, 0xfe, 0x3f, 0x90, 0x31, 0x6a, 0xdf, 0x51,
-	0x9c, 0x93, 0xea, 0x51, 0xa5, 0x4e, 0x8f, 0x1c, 0xed, 0x25, 0xcd, 0xe5, 0xe4, 0xc6, 0xf5, 0xe1,
-	0xa1, 0xaa, 0x6d, 0xe9, 0x49, 0x8d, 0x7b, 0x8c, 0x0c, 0x56, 0x29, 0x79, 0x17, 0x4f, 0x15, 0x9d,
-	0x73, 0xc1, 0xf0, 0xfd, 0xdc, 0xb6, 0xd1, 0x47, 0xe5, 0x99, 0xaf, 0xa2, 0x67, 0xb4, 0xdc, 0x93,
-	0xe4, 0xc4, 0x56, 0xb9, 0xf3, 0xa0, 0x7d, 0x59, 0x5e, 0xfa, 0x02, 0x22, 0x02, 0x8f, 0xe7, 0x1c,
-	0x5d, 0xc1, 0x9c, 0x3d, 0xfe, 0xcf, 0xf8, 0x5f, 0xbf, 0x00, 0x1c, 0x89, 0x3d, 0xad, 0xc5, 0x9e,
-	0x20, 0xc7, 0x13, 0xb1, 0x42, 0x72, 0xb0, 0xdb, 0x56, 0x5f, 0xa1, 0xef, 0x21, 0x3c, 0x15, 0x45,
-	0xa9, 0x41, 0xd7, 0xbd, 0x10, 0x83, 0x8d, 0x99, 0x87, 0x4f, 0x88, 0x03, 0x5d, 0x7c, 0x41, 0xe6,
-	0xca, 0x5d, 0x90, 0x6f, 0x11, 0x9e, 0xd4, 0xa9, 0x7f, 0x8a, 0x30, 0xdd, 0x2b, 0x21, 0x5f, 0x1b,
-	0x0c, 0xf5, 0x32, 0x3f, 0xad, 0x59, 0x2d, 0x63, 0xae, 0x0c, 0xab, 0xc5, 0x15, 0x86, 0x7a, 0x7d,
-	0x3f, 0x22, 0xbc, 0x3f, 0xa9, 0x9c, 0x52, 0xee, 0xe3, 0xfd, 0xb8, 0x0b, 0xd5, 0xd5, 0x50, 0xd1,
-	0xcf, 0x6b, 0xf4, 0x25, 0x63, 0xbe, 0x24, 0x7a, 0x44, 0xa2, 0xe8, 0xbf, 0x43, 0x78, 0x2a, 0xaa,
-	0x53, 0x06, 0x99, 0xbd, 0x50, 0xc9, 0x0c, 0x95, 0xfc, 0x19, 0x4d, 0xbe, 0x60, 0x9c, 0x29, 0x4d,
-	0xde, 0x06, 0xc5, 0xfd, 0x3d, 0xc2, 0xfb, 0xe2, 0x9c, 0x39, 0x05, 0xef, 0x73, 0x1d, 0x8b, 0x69,
-	0xf5, 0x50, 0xc9, 0x9f, 0xd5, 0xe4, 0x8b, 0xc6, 0xd9, 0x52, 0xe4, 0x22, 0x02, 0x51, 0xe8, 0x3f,
-	0x21, 0x7c, 0x20, 0xad, 0xd0, 0x52, 0x78, 0xda, 0x0b, 0xbf, 0xb5, 0x8c, 0x1b, 0x2a, 0xfe, 0x05,
-	0x8d, 0xbf, 0x6c, 0x98, 0xa5, 0xf0, 0x65, 0x82, 0xa2, 0x0e, 0xf0, 0x35, 0xc2, 0x13, 0xaa, 0x26,
-	0x4c, 0xd9, 0xfb, 0xb8, 0xf1, 0x5c, 0xcd, 0x38, 0x54, 0xec, 0x73, 0x1a, 0xdb, 0x34, 0x4e, 0x97,
-	0xd3, 0xba, 0x64, 0xa1, 0x22, 0xfe, 0x12, 0xe1, 0xf1, 0xc6, 0xe0, 0x08, 0xd9, 0x78, 0x3c, 0x11,
-	0x72, 0x59, 0xf3, 0xce, 0x1b, 0xb3, 0xe5, 0x78, 0x41, 0x3f, 0xca, 0x2f, 0x10, 0x9e, 0x50, 0x89,
-	0xe1, 0x20, 0x05, 0xe7, 0x12, 0xc7, 0xa1, 0x02, 0xcf, 0x6b, 0xe0, 0xa7, 0x28, 0x1d, 0x0c, 0xec,
-	0x7b, 0x81, 0x46, 0x7d, 0x07, 0xef, 0x89, 0xaa, 0x3d, 0xd1, 0x4f, 0xa9, 0x59, 0x21, 0x6a, 0x90,
-	0xec, 0x6b, 0x92, 0x3c, 0xd3, 0xe7, 0xb5, 0xac, 0x73, 0x64, 0xa9, 0x94, 0x72, 0x6e, 0xc7, 0xf9,
-	0xf3, 0x1d, 0xcb, 0x67, 0xee, 0x87, 0x15, 0xb4, 0x80, 0x88, 0xc4, 0x13, 0x39, 0x51, 0xdb, 0x41,
-	0x58, 0xd0, 0x08, 0x73, 0xa4, 0x9c, 0x7d, 0x7c, 0xe6, 0x2e, 0x20, 0xf2, 0x15, 0xc2, 0x53, 0x8d,
-	0xa2, 0xbf, 0x3f, 0xd6, 0xcf, 0xf5, 0x3c, 0x2e, 0x6f, 0x6f, 0x69, 0xe6, 0xd3, 0xf4, 0x11, 0x41,
-	0x35, 0x75, 0xf2, 0x97, 0x56, 0x7f, 0x7d, 0x30, 0x8d, 0xee, 0x3d, 0x98, 0x46, 0xbf, 0x3f, 0x98,
-	0x46, 0x6f, 0x5c, 0x28, 0xff, 0x53, 0xfb, 0x96, 0xbf, 0x04, 0xd6, 0x46, 0xf5, 0x2f, 0xe7, 0xcb,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x5f, 0x40, 0x23, 0x33, 0x18, 0x00, 0x00,
+	// 1868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x9a, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0xc0, 0x55, 0x33, 0xf6, 0x7e, 0xbc, 0xd9, 0x5d, 0xdb, 0x85, 0x13, 0x86, 0x96, 0xb3, 0x5e,
+	0x97, 0x63, 0x67, 0xbd, 0xf6, 0xf6, 0xec, 0x87, 0x09, 0x09, 0x12, 0x48, 0x76, 0xd6, 0x59, 0x11,
+	0x96, 0x60, 0xf5, 0x20, 0x21, 0x38, 0x80, 0x7a, 0x7b, 0x6a, 0x66, 0x3b, 0xdb, 0xd3, 0xd5, 0xa9,
+	0xaa, 0x19, 0xb3, 0x84, 0x05, 0x85, 0x08, 0xc1, 0x01, 0xc1, 0x01, 0x6e, 0xdc, 0x2c, 0x21, 0x38,
+	0x44, 0x80, 0x90, 0x90, 0x22, 0x90, 0x10, 0x07, 0x0e, 0x1c, 0x23, 0xe5, 0x1f, 0x40, 0x16, 0xff,
+	0x00, 0xff, 0x01, 0xaa, 0xea, 0xaf, 0xea, 0x99, 0xd9, 0xd9, 0xd6, 0xce, 0x98, 0xcd, 0xad, 0xeb,
+	0xfb, 0x57, 0xef, 0xbd, 0x7a, 0xef, 0x55, 0xcd, 0xc0, 0xad, 0xe8, 0xb0, 0xd3, 0x70, 0x23, 0xdf,
+	0x0b, 0x7c, 0x1a, 0xca, 0xc6, 0x13, 0xc6, 0x0f, 0xdb, 0x01, 0x7b, 0x92, 0x7d, 0xd8, 0x11, 0x67,
+	0x92, 0xe1, 0xb9, 0xb4, 0x6c, 0x5d, 0xeb, 0x30, 0xd6, 0x09, 0xa8, 0x1a, 0xd3, 0x70, 0xc3, 0x90,
+	0x49, 0x57, 0xfa, 0x2c, 0x14, 0x71, 0x3f, 0xeb, 0xfe, 0xe1, 0x6b, 0xc2, 0xf6, 0x99, 0x6a, 0xed,
+	0xba, 0xde, 0x81, 0x1f, 0x52, 0x7e, 0xd4, 0x48, 0x96, 0x10, 0x8d, 0x2e, 0x95, 0x6e, 0xa3, 0xbf,
+	0xd9, 0xe8, 0xd0, 0x90, 0x72, 0x57, 0xd2, 0x56, 0x32, 0xea, 0x6b, 0x1d, 0x5f, 0x1e, 0xf4, 0xf6,
+	0x6d, 0x8f, 0x75, 0x1b, 0x2e, 0xef, 0xb0, 0x88, 0xb3, 0x77, 0xf4, 0xc7, 0x7a, 0xba, 0xac, 0xc8,
+	0x27, 0xc9, 0x10, 0xfb, 0x9b, 0x6e, 0x10, 0x1d, 0xb8, 0xc3, 0xd3, 0x91, 0x1c, 0xa2, 0xe1, 0x31,
+	0x4e, 0x47, 0x2c, 0x49, 0xfe, 0x51, 0x81, 0x17, 0xbe, 0x99, 0xcc, 0xf4, 0x06, 0xa7, 0xae, 0xa4,
+	0x0e, 0x7d, 0xb7, 0x47, 0x85, 0xc4, 0xd7, 0x60, 0x3e, 0x74, 0xbb, 0x54, 0x44, 0xae, 0x47, 0xeb,
+	0x68, 0x05, 0xad, 0xce, 0x3b, 0x79, 0x05, 0x6e, 0x43, 0x26, 0x8a, 0x7a, 0x65, 0x05, 0xad, 0xd6,
+	0xb6, 0xde, 0xb2, 0x73, 0x7a, 0x3b, 0xa5, 0xd7, 0x1f, 0xdf, 0xcd, 0xe8, 0xed, 0xfe, 0xb6, 0x1d,
+	0x1d, 0x76, 0x6c, 0xb5, 0x01, 0x3b, 0x13, 0x6d, 0xba, 0x01, 0x3b, 0x05, 0x71, 0xb2, 0xb9, 0x31,
+	0x01, 0xf0, 0x43, 0x21, 0xdd, 0xd0, 0xa3, 0x5f, 0xd9, 0xa9, 0x57, 0x15, 0xc6, 0xc3, 0x4a, 0x1d,
+	0x39, 0x46, 0x2d, 0x26, 0xb0, 0x20, 0x28, 0xef, 0x53, 0xbe, 0xc3, 0x8f, 0x9c, 0x5e, 0x58, 0xbf,
+	0xb0, 0x82, 0x56, 0xe7, 0x9c, 0x42, 0x1d, 0xfe, 0x16, 0x2c, 0x7a, 0x7a, 0x7b, 0x5f, 0x8f, 0xb4,
+	0x9e, 0xea, 0x17, 0x35, 0xf4, 0xb6, 0x1d, 0xcb, 0xc8, 0x36, 0x15, 0x95, 0x23, 0x2a, 0x45, 0xd9,
+	0xfd, 0x4d, 0xfb, 0x0d, 0x73, 0xa8, 0x53, 0x9c, 0x89, 0xfc, 0x09, 0x01, 0x4e, 0xc9, 0x77, 0xa9,
+	0x4c, 0xe5, 0x87, 0xe1, 0x82, 0x12, 0x57, 0x22, 0x3a, 0xfd, 0x5d, 0x94, 0x69, 0x65, 0x50, 0xa6,
+	0x8f, 0x01, 0x3a, 0x54, 0xa6, 0x80, 0x55, 0x0d, 0xb8, 0x51, 0x0e, 0x70, 0x37, 0x1b, 0xe7, 0x18,
+	0x73, 0xe0, 0x17, 0x61, 0xa6, 0xed, 0xd3, 0xa0, 0x25, 0xb4, 0x4c, 0xe6, 0x9d, 0xa4, 0x44, 0x9e,
+	0x56, 0xe0, 0x33, 0x29, 0xf2, 0x9e, 0x2f, 0x64, 0x39, 0x9d, 0x37, 0xa1, 0x16, 0xf8, 0x22, 0x03,
+	0x8c, 0xd5, 0xbe, 0x59, 0x0e, 0x70, 0x2f, 0x1f, 0xe8, 0x98, 0xb3, 0x18, 0x88, 0x55, 0x13, 0x11,
+	0x2f, 0x03, 0xa8, 0x95, 0xdf, 0xf4, 0x03, 0x49, 0x79, 0x82, 0x6f, 0xd4, 0x28, 0xa5, 0xc7, 0x6a,
+	0x68, 0x3d, 0x68, 0xab, 0x1e, 0x17, 0x75, 0x8f, 0x42, 0x1d, 0xbe, 0x0d, 0x4b, 0x6d, 0x3f, 0xf4,
+	0xc5, 0x01, 0x6d, 0x3d, 0xa4, 0x6d, 0xc6, 0x69, 0x7d, 0x46, 0xf7, 0x1a, 0xa8, 0xc5, 0x57, 0xe1,
+	0xe2, 0xbb, 0x3d, 0xca, 0x8f, 0xea, 0xb3, 0xba, 0x39, 0x2e, 0x90, 0x9f, 0x22, 0xf8, 0x6c, 0x66,
+	0x91, 0x54, 0xf4, 0xf6, 0xbb, 0xfe, 0x04, 0xca, 0xb5, 0x60, 0xae, 0x4b, 0xbb, 0xcc, 0xff, 0x3e,
+	0x6d, 0xe9, 0x9d, 0xce, 0x39, 0x59, 0x59, 0xed, 0x35, 0x72, 0xb9, 0xdb, 0xa5, 0x92, 0x72, 0x65,
+	0x99, 0x55, 0xb5, 0xd7, 0xbc, 0x86, 0xfc, 0x13, 0xc1, 0xd5, 0x9c, 0x44, 0xf2, 0xa3, 0xb3, 0x63,
+	0xdc, 0x83, 0x2b, 0x9c, 0x0a, 0xe9, 0x72, 0xd9, 0xec, 0x79, 0x1e, 0x15, 0xa2, 0xdd, 0x0b, 0x12,
+	0x9e, 0xe1, 0x06, 0xd5, 0x3b, 0x64, 0x2d, 0xfa, 0xa6, 0x52, 0x49, 0x93, 0x06, 0xd4, 0x93, 0x2c,
+	0xd5, 0xc5, 0x70, 0xc3, 0xa9, 0xdb, 0x78, 0x02, 0x2f, 0x98, 0xf2, 0xec, 0xd2, 0x89, 0xb6, 0x31,
+	0x0c, 0x56, 0x3d, 0x01, 0x8c, 0xec, 0x41, 0x3d, 0x5d, 0xf8, 0x1b, 0x94, 0x77, 0xfd, 0xd0, 0x95,
+	0x67, 0x5f, 0x9b, 0xfc, 0x12, 0xe5, 0x87, 0xa7, 0x29, 0x59, 0xf4, 0x7f, 0xda, 0x05, 0xae, 0xc3,
+	0x6c, 0x97, 0x0a, 0xe1, 0x76, 0x68, 0xa2, 0x82, 0xb4, 0x48, 0x3e, 0x36, 0x3c, 0x50, 0x93, 0xca,
+	0x73, 0x07, 0x52, 0x07, 0x2a, 0x3a, 0x70, 0x05, 0x4d, 0x4e, 0x65, 0x5c, 0xc0, 0x6b, 0x70, 0x99,
+	0xf5, 0x64, 0xd4, 0x93, 0x8f, 0x73, 0x2b, 0x89, 0x0f, 0xe4, 0x50, 0x3d, 0x79, 0x0b, 0x5e, 0xcc,
+	0x76, 0xd4, 0x13, 0x11, 0x0d, 0x5b, 0x67, 0x57, 0xd8, 0x27, 0x86, 0x78, 0xf6, 0x58, 0xe7, 0xec,
+	0xe2, 0xa9, 0xc3, 0x6c, 0xc4, 0x5a, 0x6f, 0xab, 0x41, 0xb1, 0x50, 0xd2, 0x22, 0x7e, 0x00, 0x10,
+	0xb0, 0x4e, 0xea, 0x19, 0x2f, 0x68, 0xcf, 0x78, 0xc3, 0xf0, 0x8c, 0xb6, 0x8a, 0xbf, 0xca, 0x0f,
+	0x3e, 0x66, 0xad, 0xbd, 0xac, 0xa3, 0x63, 0x0c, 0x52, 0x38, 0x1d, 0x4e, 0xa3, 0x44, 0x64, 0xfa,
+	0x5b, 0x39, 0x0d, 0x91, 0xaa, 0x21, 0x96, 0x54, 0x56, 0x26, 0x7f, 0x45, 0xf9, 0x71, 0xda, 0xa1,
+	0x01, 0x9d, 0xc0, 0xa4, 0x55, 0x74, 0x6c, 0xe9, 0x29, 0x8a, 0xc1, 0xa7, 0x64, 0x74, 0xdc, 0x31,
+	0x87, 0x3a, 0xc5, 0x99, 0x94, 0x29, 0xb4, 0x19, 0xf7, 0x68, 0x12, 0x95, 0xe3, 0x02, 0xa9, 0xe7,
+	0xea, 0x4d, 0xd9, 0x45, 0xc4, 0x42, 0x41, 0xc9, 0x53, 0xb5, 0x2d, 0x57, 0x7a, 0x07, 0x69, 0xbb,
+	0xf8, 0xf4, 0x05, 0x27, 0xf2, 0x73, 0xc3, 0xa2, 0x34, 0xec, 0xa3, 0x3e, 0x0d, 0xb5, 0xe0, 0xe5,
+	0x51, 0x94, 0x09, 0x5e, 0x7d, 0xe3, 0x7d, 0x98, 0x61, 0xfb, 0xef, 0x50, 0x4f, 0x3e, 0x87, 0x34,
+	0x29, 0x99, 0x59, 0x45, 0x2a, 0x9c, 0x63, 0x9c, 0xa3, 0xc0, 0xc8, 0x97, 0x61, 0x6e, 0x8f, 0x75,
+	0x1e, 0x85, 0x92, 0x1f, 0xa9, 0xd3, 0xe2, 0xb1, 0x50, 0xd2, 0x50, 0x26, 0x8b, 0xa7, 0x45, 0xf3,
+	0x1c, 0x55, 0x0a, 0xe7, 0x88, 0xfc, 0x06, 0x99, 0x89, 0x49, 0x28, 0x3f, 0x55, 0xc9, 0x28, 0xf9,
+	0xaf, 0x71, 0xe4, 0x9a, 0x85, 0x7c, 0x60, 0x3c, 0x1f, 0x81, 0x05, 0x4e, 0x05, 0xeb, 0x71, 0x8f,
+	0x7e, 0xd5, 0x0f, 0x5b, 0xc9, 0xa6, 0x0b, 0x75, 0x66, 0x1f, 0xc3, 0xc1, 0x14, 0xea, 0x30, 0x87,
+	0xc5, 0x38, 0x0d, 0x29, 0x3a, 0x9a, 0xbd, 0xc9, 0x37, 0xdb, 0x4c, 0xa7, 0x15, 0x4e, 0x71, 0x09,
+	0xf2, 0xb4, 0x9a, 0x6b, 0xe4, 0x61, 0x2f, 0x38, 0x3c, 0xdf, 0xd3, 0xa8, 0x03, 0x89, 0x3a, 0x8d,
+	0x2a, 0xb7, 0x48, 0x4a, 0xca, 0xc5, 0xb0, 0xa0, 0x95, 0x65, 0x89, 0x71, 0x01, 0xaf, 0x40, 0x2d,
+	0x72, 0xb9, 0x1b, 0x04, 0x34, 0xf0, 0x45, 0x57, 0xbb, 0xd5, 0x8b, 0x8e, 0x59, 0xa5, 0xe6, 0x6b,
+	0xc5, 0x37, 0x86, 0x19, 0xed, 0x9b, 0x92, 0x92, 0x19, 0xd7, 0x66, 0x8b, 0x71, 0x6d, 0x64, 0x7c,
+	0x9c, 0x3b, 0x29, 0x3e, 0x8e, 0xcc, 0xb5, 0xe6, 0x4f, 0xca, 0xb5, 0x8a, 0xd9, 0x13, 0x0c, 0x66,
+	0x4f, 0x85, 0x04, 0xb2, 0x56, 0x4c, 0x20, 0xc9, 0xaf, 0x0d, 0x7f, 0x14, 0x2b, 0x49, 0xf4, 0x82,
+	0xb3, 0x04, 0x82, 0x2c, 0x70, 0x57, 0xcd, 0xc0, 0xbd, 0x02, 0xb5, 0x24, 0xaf, 0xd6, 0xa6, 0x19,
+	0x8b, 0xd9, 0xac, 0x52, 0xe3, 0x28, 0xe7, 0x2c, 0x4d, 0xc3, 0xe3, 0x02, 0x69, 0xc3, 0xd5, 0x01,
+	0x2a, 0xed, 0xe3, 0xf1, 0xab, 0x30, 0xcb, 0x35, 0xa1, 0xa8, 0xa3, 0x95, 0xea, 0x6a, 0x6d, 0xeb,
+	0x5a, 0x6e, 0x92, 0xc3, 0xdb, 0x70, 0xd2, 0xce, 0x86, 0xc2, 0x2a, 0xa6, 0xc2, 0xc8, 0x77, 0xf2,
+	0x68, 0xf2, 0xe8, 0x7b, 0x51, 0xe0, 0xfa, 0xe1, 0xd9, 0x43, 0xa1, 0x1a, 0xc1, 0x5a, 0xa9, 0x00,
+	0xf4, 0x37, 0xf9, 0xc0, 0xb8, 0x09, 0x64, 0x0b, 0x24, 0x7b, 0x39, 0x80, 0xf9, 0x16, 0xf5, 0x7c,
+	0xa1, 0xed, 0x3c, 0xde, 0xcd, 0x14, 0x9c, 0xcf, 0x4e, 0x32, 0xa5, 0x93, 0x4f, 0xbe, 0xf5, 0xd1,
+	0x32, 0x5c, 0xca, 0xb3, 0x3c, 0xde, 0xf7, 0x3d, 0x8a, 0x7f, 0x87, 0x60, 0x29, 0xbe, 0x9c, 0xa6,
+	0x2d, 0xf8, 0xfa, 0xb0, 0x2c, 0x0b, 0x17, 0x7b, 0x6b, 0x8a, 0xbe, 0x91, 0xac, 0xfe, 0xf8, 0x93,
+	0xff, 0xfc, 0xaa, 0x42, 0xc8, 0x4b, 0xfa, 0x91, 0xa1, 0xbf, 0x99, 0xbd, 0x4a, 0x88, 0xc6, 0x7b,
+	0x99, 0x4c, 0x8f, 0xbf, 0x88, 0xd6, 0xf0, 0x6f, 0x11, 0xd4, 0x76, 0xa9, 0xcc, 0x30, 0x47, 0xa8,
+	0x3c, 0xbf, 0x3c, 0x4f, 0x95, 0xf1, 0x9e, 0x66, 0xbc, 0x8d, 0x5f, 0x1e, 0xcb, 0x18, 0x7f, 0x1f,
+	0x2b, 0xce, 0x45, 0xe5, 0x81, 0xd2, 0xe1, 0x02, 0xbf, 0x34, 0x4c, 0x6a, 0xdc, 0x99, 0xad, 0xb7,
+	0xa7, 0x87, 0xaa, 0xa6, 0x25, 0xb7, 0x34, 0xee, 0x75, 0x3c, 0x5e, 0xa4, 0xf8, 0x43, 0x04, 0x97,
+	0x55, 0xff, 0x07, 0x41, 0x70, 0x6e, 0xa8, 0x77, 0x34, 0xea, 0x4d, 0x7c, 0x23, 0x45, 0x75, 0x83,
+	0x60, 0x7d, 0x34, 0xee, 0x0f, 0x61, 0xa9, 0x98, 0xd5, 0x15, 0xec, 0x74, 0x54, 0xbe, 0x67, 0x8d,
+	0xb0, 0x90, 0x3c, 0xc9, 0x21, 0x77, 0xf5, 0xda, 0xb7, 0xf0, 0xcd, 0x41, 0x31, 0xad, 0x53, 0xd5,
+	0x5e, 0x58, 0x7d, 0x03, 0x61, 0x01, 0xb5, 0x7c, 0xb0, 0x28, 0x58, 0xdf, 0x50, 0xe2, 0x64, 0x7d,
+	0x6e, 0x54, 0xe6, 0x1e, 0x2f, 0x3b, 0xb4, 0x65, 0x21, 0x39, 0x75, 0xbb, 0x8d, 0x91, 0x8b, 0xbe,
+	0x8f, 0x60, 0x29, 0x4e, 0x6f, 0xc7, 0x9d, 0xce, 0x42, 0xf2, 0x6e, 0xad, 0x9c, 0xdc, 0x21, 0xc9,
+	0x90, 0x13, 0x7b, 0x5e, 0x2b, 0x67, 0xcf, 0x7f, 0x46, 0xb0, 0xa8, 0xdf, 0x0c, 0x32, 0x84, 0xe5,
+	0xe1, 0x15, 0xcc, 0x47, 0x85, 0xa9, 0x9e, 0xbd, 0xcf, 0x6b, 0xd6, 0x86, 0xb5, 0x56, 0x86, 0xb5,
+	0xc1, 0x15, 0x86, 0x72, 0x16, 0x7f, 0x43, 0x70, 0x39, 0x7d, 0x72, 0xc9, 0xb8, 0x6f, 0x8c, 0xe2,
+	0x2e, 0x3c, 0xcb, 0x4c, 0x15, 0xfd, 0x35, 0x8d, 0xbe, 0x65, 0xad, 0x97, 0x44, 0x8f, 0x49, 0x14,
+	0xfd, 0x5f, 0x10, 0x2c, 0xc5, 0x0f, 0x1c, 0xe3, 0xd4, 0x5e, 0x78, 0x02, 0x99, 0x2a, 0xf9, 0xab,
+	0x9a, 0x7c, 0xc3, 0xba, 0x5b, 0x9a, 0xbc, 0x4b, 0x15, 0xf7, 0x47, 0x08, 0x2e, 0x25, 0x97, 0xed,
+	0x0c, 0x7c, 0x84, 0x39, 0x16, 0xef, 0xe3, 0x53, 0x25, 0xff, 0x82, 0x26, 0xdf, 0xb4, 0xee, 0x95,
+	0x22, 0x17, 0x31, 0x88, 0x42, 0xff, 0x3b, 0x82, 0x2b, 0xd9, 0xd3, 0x4e, 0x06, 0x4f, 0x86, 0xe1,
+	0x07, 0xdf, 0x7f, 0xa6, 0x8a, 0xff, 0xba, 0xc6, 0xdf, 0xb6, 0xec, 0x52, 0xf8, 0x32, 0x45, 0x51,
+	0x1b, 0xf8, 0x23, 0x82, 0x05, 0xf5, 0x98, 0x94, 0xb1, 0x8f, 0x70, 0xe5, 0xc6, 0x63, 0xd3, 0x54,
+	0xb1, 0xef, 0x6b, 0x6c, 0xdb, 0xba, 0x53, 0x4e, 0xea, 0x92, 0x45, 0x8a, 0xf8, 0x43, 0x04, 0xb5,
+	0xe6, 0xf8, 0x80, 0xde, 0x7c, 0x3e, 0x01, 0x7d, 0x5b, 0xf3, 0xae, 0x5b, 0xab, 0xe5, 0x78, 0xa9,
+	0x3e, 0x94, 0xc7, 0xb0, 0x68, 0xca, 0x77, 0x64, 0xac, 0x34, 0xee, 0x37, 0xd6, 0xf2, 0x49, 0xcd,
+	0x89, 0x17, 0x5e, 0xd7, 0x10, 0xaf, 0x10, 0x32, 0x1e, 0x22, 0x95, 0x96, 0xba, 0xa2, 0x0f, 0x19,
+	0xe8, 0xc4, 0x10, 0x5b, 0x1a, 0xe2, 0x1e, 0x79, 0x65, 0x3c, 0x44, 0xc1, 0xd2, 0x7e, 0x04, 0x4b,
+	0x85, 0x78, 0x30, 0x31, 0x84, 0xad, 0x21, 0x56, 0xc9, 0xcd, 0xf1, 0x10, 0x99, 0x73, 0xff, 0x09,
+	0x82, 0x2b, 0x83, 0xce, 0x7d, 0x62, 0x88, 0x4d, 0x0d, 0x71, 0x97, 0xdc, 0x3e, 0x0d, 0x22, 0x77,
+	0xd3, 0x1f, 0x20, 0xb8, 0x3c, 0xe0, 0xee, 0x26, 0xc6, 0xd8, 0xd0, 0x18, 0x6b, 0xe4, 0xd6, 0x29,
+	0x56, 0x91, 0x7b, 0xae, 0xf7, 0x11, 0x5c, 0x2a, 0x06, 0x8b, 0x89, 0x21, 0x1a, 0x1a, 0xe2, 0x0e,
+	0x79, 0xf9, 0x74, 0x59, 0xc4, 0x8e, 0xff, 0xf7, 0x08, 0x16, 0xd4, 0x6b, 0xcb, 0x38, 0xe7, 0x63,
+	0xbc, 0xc6, 0x4c, 0xf5, 0x30, 0x97, 0x3c, 0x47, 0x81, 0x1f, 0x6a, 0xa5, 0xfd, 0x00, 0x66, 0xe3,
+	0x27, 0x54, 0x31, 0xca, 0xe1, 0xe4, 0xaf, 0xbb, 0x16, 0xce, 0x5b, 0xd3, 0x17, 0x29, 0xf2, 0x25,
+	0xbd, 0xd6, 0x7d, 0xbc, 0x55, 0xca, 0x71, 0xbc, 0x97, 0x3c, 0x4a, 0x1d, 0x37, 0x02, 0xd6, 0xf9,
+	0x59, 0x05, 0x6d, 0x20, 0x2c, 0x61, 0xc1, 0x58, 0xea, 0x2c, 0x08, 0x89, 0x81, 0xe0, 0x72, 0xbe,
+	0x2b, 0x60, 0x9d, 0x0d, 0x84, 0x7f, 0x81, 0xe0, 0x52, 0x72, 0xed, 0x1c, 0x17, 0x97, 0x8b, 0x57,
+	0x5f, 0xeb, 0xc6, 0x98, 0x1e, 0x89, 0xa1, 0x24, 0x89, 0x02, 0x2e, 0x17, 0xaf, 0xb2, 0x9b, 0x28,
+	0xfe, 0x03, 0x82, 0xa5, 0x66, 0x31, 0x39, 0xbb, 0x3e, 0x2a, 0x4f, 0x78, 0x5e, 0xa9, 0x59, 0x49,
+	0x03, 0xcf, 0x8e, 0xfa, 0xc3, 0xdd, 0x7f, 0x3d, 0x5b, 0x46, 0x1f, 0x3f, 0x5b, 0x46, 0xff, 0x7e,
+	0xb6, 0x8c, 0xbe, 0xfd, 0x7a, 0xf9, 0x9f, 0xd9, 0x07, 0xfe, 0x0e, 0xb0, 0x3f, 0xa3, 0x7f, 0x35,
+	0xdf, 0xfe, 0xdf, 0x00, 0x70, 0x3c, 0x31, 0x78, 0x2f, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1448,6 +1872,9 @@ type WorkflowServiceClient interface {
 	CreateWorkflow(ctx context.Context, in *WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	GetWorkflow(ctx context.Context, in *WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	ListWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
+	// ListAllWorkflows lists both live and archived workflows, deduplicated by UID and sorted by most recently started.
+	// The continue token of the response can be used to fetch the next page across both sources.
+	ListAllWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
 	WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error)
 	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (WorkflowService_WatchEventsClient, error)
 	DeleteWorkflow(ctx context.Context, in *WorkflowDeleteRequest, opts ...grpc.CallOption) (*WorkflowDeleteResponse, error)
@@ -1458,10 +1885,18 @@ type WorkflowServiceClient interface {
 	TerminateWorkflow(ctx context.Context, in *WorkflowTerminateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	StopWorkflow(ctx context.Context, in *WorkflowStopRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	SetWorkflow(ctx context.Context, in *WorkflowSetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	StopWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	TerminateWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	RetryWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	ResubmitWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	SuspendWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
+	ResumeWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error)
 	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
 	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
 	WorkflowLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_WorkflowLogsClient, error)
+	// ExplainWorkflow returns the decision log of the workflow: why the controller did not start or run its nodes.
+	ExplainWorkflow(ctx context.Context, in *WorkflowExplainRequest, opts ...grpc.CallOption) (*WorkflowExplainResponse, error)
 	SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
 
@@ -1500,6 +1935,15 @@ func (c *workflowServiceClient) ListWorkflows(ctx context.Context, in *WorkflowL
 	return out, nil
 }
 
+func (c *workflowServiceClient) ListAllWorkflows(ctx context.Context, in *WorkflowListRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	out := new(v1alpha1.WorkflowList)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ListAllWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) WatchWorkflows(ctx context.Context, in *WatchWorkflowsRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[0], "/workflow.WorkflowService/WatchWorkflows", opts...)
 	if err != nil {
@@ -1636,17 +2080,71 @@ func (c *workflowServiceClient) SetWorkflow(ctx context.Context, in *WorkflowSet
 	return out, nil
 }
 
-func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
-	out := new(v1alpha1.Workflow)
-	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/LintWorkflow", in, out, opts...)
+func (c *workflowServiceClient) StopWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/StopWorkflows", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Deprecated: Do not use.
-func (c *workflowServiceClient) PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error) {
+func (c *workflowServiceClient) TerminateWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/TerminateWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) RetryWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/RetryWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResubmitWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResubmitWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SuspendWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SuspendWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResumeWorkflows(ctx context.Context, in *WorkflowBulkRequest, opts ...grpc.CallOption) (*WorkflowBulkResponse, error) {
+	out := new(WorkflowBulkResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ResumeWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/LintWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *workflowServiceClient) PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_WorkflowService_serviceDesc.Streams[2], "/workflow.WorkflowService/PodLogs", opts...)
 	if err != nil {
 		return nil, err
@@ -1710,6 +2208,15 @@ func (x *workflowServiceWorkflowLogsClient) Recv() (*LogEntry, error) {
 	return m, nil
 }
 
+func (c *workflowServiceClient) ExplainWorkflow(ctx context.Context, in *WorkflowExplainRequest, opts ...grpc.CallOption) (*WorkflowExplainResponse, error) {
+	out := new(WorkflowExplainResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/ExplainWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	out := new(v1alpha1.Workflow)
 	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/SubmitWorkflow", in, out, opts...)
@@ -1724,6 +2231,9 @@ type WorkflowServiceServer interface {
 	CreateWorkflow(context.Context, *WorkflowCreateRequest) (*v1alpha1.Workflow, error)
 	GetWorkflow(context.Context, *WorkflowGetRequest) (*v1alpha1.Workflow, error)
 	ListWorkflows(context.Context, *WorkflowListRequest) (*v1alpha1.WorkflowList, error)
+	// ListAllWorkflows lists both live and archived workflows, deduplicated by UID and sorted by most recently started.
+	// The continue token of the response can be used to fetch the next page across both sources.
+	ListAllWorkflows(context.Context, *WorkflowListRequest) (*v1alpha1.WorkflowList, error)
 	WatchWorkflows(*WatchWorkflowsRequest, WorkflowService_WatchWorkflowsServer) error
 	WatchEvents(*WatchEventsRequest, WorkflowService_WatchEventsServer) error
 	DeleteWorkflow(context.Context, *WorkflowDeleteRequest) (*WorkflowDeleteResponse, error)
@@ -1734,10 +2244,18 @@ type WorkflowServiceServer interface {
 	TerminateWorkflow(context.Context, *WorkflowTerminateRequest) (*v1alpha1.Workflow, error)
 	StopWorkflow(context.Context, *WorkflowStopRequest) (*v1alpha1.Workflow, error)
 	SetWorkflow(context.Context, *WorkflowSetRequest) (*v1alpha1.Workflow, error)
+	StopWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	TerminateWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	RetryWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	ResubmitWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	SuspendWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
+	ResumeWorkflows(context.Context, *WorkflowBulkRequest) (*WorkflowBulkResponse, error)
 	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
 	// DEPRECATED: Cannot work via HTTP if podName is an empty string. Use WorkflowLogs.
 	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
 	WorkflowLogs(*WorkflowLogRequest, WorkflowService_WorkflowLogsServer) error
+	// ExplainWorkflow returns the decision log of the workflow: why the controller did not start or run its nodes.
+	ExplainWorkflow(context.Context, *WorkflowExplainRequest) (*WorkflowExplainResponse, error)
 	SubmitWorkflow(context.Context, *WorkflowSubmitRequest) (*v1alpha1.Workflow, error)
 }
 
@@ -1754,6 +2272,9 @@ func (*UnimplementedWorkflowServiceServer) GetWorkflow(ctx context.Context, req
 func (*UnimplementedWorkflowServiceServer) ListWorkflows(ctx context.Context, req *WorkflowListRequest) (*v1alpha1.WorkflowList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflows not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) ListAllWorkflows(ctx context.Context, req *WorkflowListRequest) (*v1alpha1.WorkflowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllWorkflows not implemented")
+}
 func (*UnimplementedWorkflowServiceServer) WatchWorkflows(req *WatchWorkflowsRequest, srv WorkflowService_WatchWorkflowsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchWorkflows not implemented")
 }
@@ -1784,6 +2305,24 @@ func (*UnimplementedWorkflowServiceServer) StopWorkflow(ctx context.Context, req
 func (*UnimplementedWorkflowServiceServer) SetWorkflow(ctx context.Context, req *WorkflowSetRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflow not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) StopWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) TerminateWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) RetryWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ResubmitWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) SuspendWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflows not implemented")
+}
+func (*UnimplementedWorkflowServiceServer) ResumeWorkflows(ctx context.Context, req *WorkflowBulkRequest) (*WorkflowBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflows not implemented")
+}
 func (*UnimplementedWorkflowServiceServer) LintWorkflow(ctx context.Context, req *WorkflowLintRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflow not implemented")
 }
@@ -1793,6 +2332,9 @@ func (*UnimplementedWorkflowServiceServer) PodLogs(req *WorkflowLogRequest, srv
 func (*UnimplementedWorkflowServiceServer) WorkflowLogs(req *WorkflowLogRequest, srv WorkflowService_WorkflowLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WorkflowLogs not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) ExplainWorkflow(ctx context.Context, req *WorkflowExplainRequest) (*WorkflowExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainWorkflow not implemented")
+}
 func (*UnimplementedWorkflowServiceServer) SubmitWorkflow(ctx context.Context, req *WorkflowSubmitRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
 }
@@ -1855,6 +2397,24 @@ func _WorkflowService_ListWorkflows_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_ListAllWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ListAllWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ListAllWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ListAllWorkflows(ctx, req.(*WorkflowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_WatchWorkflows_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WatchWorkflowsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2041,6 +2601,114 @@ func _WorkflowService_SetWorkflow_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_StopWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).StopWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/StopWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).StopWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_TerminateWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).TerminateWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/TerminateWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).TerminateWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_RetryWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RetryWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/RetryWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RetryWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResubmitWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResubmitWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ResubmitWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResubmitWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SuspendWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SuspendWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/SuspendWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SuspendWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResumeWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResumeWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ResumeWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResumeWorkflows(ctx, req.(*WorkflowBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_LintWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkflowLintRequest)
 	if err := dec(in); err != nil {
@@ -2101,6 +2769,24 @@ func (x *workflowServiceWorkflowLogsServer) Send(m *LogEntry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WorkflowService_ExplainWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ExplainWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/ExplainWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ExplainWorkflow(ctx, req.(*WorkflowExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_SubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkflowSubmitRequest)
 	if err := dec(in); err != nil {
@@ -2135,6 +2821,10 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflows",
 			Handler:    _WorkflowService_ListWorkflows_Handler,
 		},
+		{
+			MethodName: "ListAllWorkflows",
+			Handler:    _WorkflowService_ListAllWorkflows_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflow",
 			Handler:    _WorkflowService_DeleteWorkflow_Handler,
@@ -2167,10 +2857,38 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetWorkflow",
 			Handler:    _WorkflowService_SetWorkflow_Handler,
 		},
+		{
+			MethodName: "StopWorkflows",
+			Handler:    _WorkflowService_StopWorkflows_Handler,
+		},
+		{
+			MethodName: "TerminateWorkflows",
+			Handler:    _WorkflowService_TerminateWorkflows_Handler,
+		},
+		{
+			MethodName: "RetryWorkflows",
+			Handler:    _WorkflowService_RetryWorkflows_Handler,
+		},
+		{
+			MethodName: "ResubmitWorkflows",
+			Handler:    _WorkflowService_ResubmitWorkflows_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflows",
+			Handler:    _WorkflowService_SuspendWorkflows_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflows",
+			Handler:    _WorkflowService_ResumeWorkflows_Handler,
+		},
 		{
 			MethodName: "LintWorkflow",
 			Handler:    _WorkflowService_LintWorkflow_Handler,
 		},
+		{
+			MethodName: "ExplainWorkflow",
+			Handler:    _WorkflowService_ExplainWorkflow_Handler,
+		},
 		{
 			MethodName: "SubmitWorkflow",
 			Handler:    _WorkflowService_SubmitWorkflow_Handler,
@@ -2360,6 +3078,13 @@ func (m *WorkflowListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.FinishedBefore) > 0 {
 		i -= len(m.FinishedBefore)
 		copy(dAtA[i:], m.FinishedBefore)
@@ -3247,44 +3972,366 @@ func (m *WorkflowSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WorkflowBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WorkflowCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WorkflowBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.InstanceID)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ServerDryRun {
-		n += 2
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.CreateOptions != nil {
-		l = m.CreateOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Parallelism != 0 {
+		i = encodeVarintWorkflow(dAtA, i, uint64(m.Parallelism))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Older) > 0 {
+		i -= len(m.Older)
+		copy(dAtA[i:], m.Older)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Older)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBulkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBulkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedName) > 0 {
+		i -= len(m.CreatedName)
+		copy(dAtA[i:], m.CreatedName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.CreatedName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBulkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExplainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExplainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExplainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExplainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExplainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExplainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.InstanceID)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ServerDryRun {
+		n += 2
+	}
+	if m.CreateOptions != nil {
+		l = m.CreateOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -3347,6 +4394,10 @@ func (m *WorkflowListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3750,26 +4801,177 @@ func (m *WorkflowSubmitRequest) Size() (n int) {
 	return n
 }
 
-func sovWorkflow(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWorkflow(x uint64) (n int) {
-	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+func (m *WorkflowBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	l = len(m.Older)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovWorkflow(uint64(m.Parallelism))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowBulkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.CreatedName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowBulkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExplainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExplainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflow(x uint64) (n int) {
+	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WorkflowCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -4371,6 +5573,38 @@ func (m *WorkflowListRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.FinishedBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -6197,19 +7431,929 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowWatchEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &v1alpha1.Workflow{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowLintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowLintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitOptions == nil {
+				m.SubmitOptions = &v1alpha1.SubmitOpts{}
+			}
+			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Older", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Older = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6237,13 +8381,13 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6253,28 +8397,12 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Object == nil {
-				m.Object = &v1alpha1.Workflow{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Memoized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -6297,7 +8425,7 @@ func (m *WorkflowWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowBulkResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6320,15 +8448,15 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowBulkResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowBulkResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6356,13 +8484,13 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6372,82 +8500,59 @@ func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListOptions == nil {
-				m.ListOptions = &v1.ListOptions{}
-			}
-			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWorkflow
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflow
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6475,11 +8580,11 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.CreatedName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6507,7 +8612,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6531,7 +8636,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowBulkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6554,17 +8659,17 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowLintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowBulkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowLintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowBulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6574,29 +8679,31 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkflow
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWorkflow
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, &WorkflowBulkResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkflow
@@ -6606,28 +8713,12 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWorkflow
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Workflow == nil {
-				m.Workflow = &v1alpha1.Workflow{}
-			}
-			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -6650,7 +8741,7 @@ func (m *WorkflowLintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkflowExplainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6673,15 +8764,15 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowSubmitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowExplainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowExplainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6709,11 +8800,11 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6741,11 +8832,11 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceKind = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6773,11 +8864,62 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExplainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExplainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExplainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6804,10 +8946,8 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitOptions == nil {
-				m.SubmitOptions = &v1alpha1.SubmitOpts{}
-			}
-			if err := m.SubmitOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Decisions = append(m.Decisions, &v1alpha1.Decision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,6 +5,7 @@ package workflowarchive
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	proto "github.com/gogo/protobuf/proto"
@@ -34,7 +35,9 @@ type ListArchivedWorkflowsRequest struct {
 	NamePrefix  string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
 	Namespace   string          `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	// Filter type used for name filtering. Exact | Contains | Prefix. Default to Exact
-	NameFilter           string   `protobuf:"bytes,4,opt,name=nameFilter,proto3" json:"nameFilter,omitempty"`
+	NameFilter string `protobuf:"bytes,4,opt,name=nameFilter,proto3" json:"nameFilter,omitempty"`
+	// Search query, e.g. "param.message=hello node.phase=Failed". See the workflow search docs for the syntax.
+	Query                string   `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -101,6 +104,13 @@ func (m *ListArchivedWorkflowsRequest) GetNameFilter() string {
 	return ""
 }
 
+func (m *ListArchivedWorkflowsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -528,6 +538,243 @@ func (m *ResubmitArchivedWorkflowRequest) GetParameters() []string {
 	return nil
 }
 
+type GetArchivedWorkflowStatsRequest struct {
+	// Use the field selectors `spec.startedAt>` and `spec.startedAt<` to set the time window.
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	Namespace   string          `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// How to group workflows. namespace | workflowTemplate | cronWorkflow | label. Default to namespace
+	GroupBy string `protobuf:"bytes,3,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	// The label key to group workflows by, required when grouping by label
+	LabelKey             string   `protobuf:"bytes,4,opt,name=labelKey,proto3" json:"labelKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetArchivedWorkflowStatsRequest) Reset()         { *m = GetArchivedWorkflowStatsRequest{} }
+func (m *GetArchivedWorkflowStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArchivedWorkflowStatsRequest) ProtoMessage()    {}
+func (*GetArchivedWorkflowStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetArchivedWorkflowStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArchivedWorkflowStatsRequest.Merge(m, src)
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetArchivedWorkflowStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArchivedWorkflowStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArchivedWorkflowStatsRequest proto.InternalMessageInfo
+
+func (m *GetArchivedWorkflowStatsRequest) GetListOptions() *v1.ListOptions {
+	if m != nil {
+		return m.ListOptions
+	}
+	return nil
+}
+
+func (m *GetArchivedWorkflowStatsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetArchivedWorkflowStatsRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+func (m *GetArchivedWorkflowStatsRequest) GetLabelKey() string {
+	if m != nil {
+		return m.LabelKey
+	}
+	return ""
+}
+
+type ArchivedWorkflowStats struct {
+	// The namespace, workflow template, cron workflow or label value, empty for workflows without one
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Total     int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded int64  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int64  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errored   int64  `protobuf:"varint,5,opt,name=errored,proto3" json:"errored,omitempty"`
+	// The fraction of workflows that succeeded, between 0 and 1
+	SuccessRate float64 `protobuf:"fixed64,6,opt,name=successRate,proto3" json:"successRate,omitempty"`
+	// The median duration in seconds
+	P50Duration float64 `protobuf:"fixed64,7,opt,name=p50Duration,proto3" json:"p50Duration,omitempty"`
+	// The 95th percentile duration in seconds
+	P95Duration float64 `protobuf:"fixed64,8,opt,name=p95Duration,proto3" json:"p95Duration,omitempty"`
+	// The total resources duration of the workflows, in seconds per resource
+	ResourcesDuration    map[string]int64 `protobuf:"bytes,9,rep,name=resourcesDuration,proto3" json:"resourcesDuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ArchivedWorkflowStats) Reset()         { *m = ArchivedWorkflowStats{} }
+func (m *ArchivedWorkflowStats) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowStats) ProtoMessage()    {}
+func (*ArchivedWorkflowStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{9}
+}
+func (m *ArchivedWorkflowStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedWorkflowStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowStats.Merge(m, src)
+}
+func (m *ArchivedWorkflowStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedWorkflowStats proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowStats) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ArchivedWorkflowStats) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetSucceeded() int64 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetErrored() int64 {
+	if m != nil {
+		return m.Errored
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetSuccessRate() float64 {
+	if m != nil {
+		return m.SuccessRate
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetP50Duration() float64 {
+	if m != nil {
+		return m.P50Duration
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetP95Duration() float64 {
+	if m != nil {
+		return m.P95Duration
+	}
+	return 0
+}
+
+func (m *ArchivedWorkflowStats) GetResourcesDuration() map[string]int64 {
+	if m != nil {
+		return m.ResourcesDuration
+	}
+	return nil
+}
+
+type ArchivedWorkflowStatsResponse struct {
+	Items                []*ArchivedWorkflowStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ArchivedWorkflowStatsResponse) Reset()         { *m = ArchivedWorkflowStatsResponse{} }
+func (m *ArchivedWorkflowStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchivedWorkflowStatsResponse) ProtoMessage()    {}
+func (*ArchivedWorkflowStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{10}
+}
+func (m *ArchivedWorkflowStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedWorkflowStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedWorkflowStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedWorkflowStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedWorkflowStatsResponse.Merge(m, src)
+}
+func (m *ArchivedWorkflowStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedWorkflowStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedWorkflowStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedWorkflowStatsResponse proto.InternalMessageInfo
+
+func (m *ArchivedWorkflowStatsResponse) GetItems() []*ArchivedWorkflowStats {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
@@ -537,6 +784,10 @@ func init() {
 	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
 	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
 	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*GetArchivedWorkflowStatsRequest)(nil), "workflowarchive.GetArchivedWorkflowStatsRequest")
+	proto.RegisterType((*ArchivedWorkflowStats)(nil), "workflowarchive.ArchivedWorkflowStats")
+	proto.RegisterMapType((map[string]int64)(nil), "workflowarchive.ArchivedWorkflowStats.ResourcesDurationEntry")
+	proto.RegisterType((*ArchivedWorkflowStatsResponse)(nil), "workflowarchive.ArchivedWorkflowStatsResponse")
 }
 
 func init() {
@@ -544,58 +795,74 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0x35, 0xe9, 0xee, 0x6a, 0x3b, 0x3d, 0x00, 0x83, 0x16, 0x22, 0x2b, 0x9b, 0x06, 0x0b,
-	0x76, 0xfb, 0x42, 0xc6, 0x4d, 0x5b, 0x04, 0xea, 0x09, 0x50, 0x55, 0x24, 0xfa, 0x86, 0x1c, 0x09,
-	0x24, 0x2e, 0x30, 0xb1, 0x9f, 0x26, 0x43, 0x6c, 0x8f, 0x99, 0x19, 0xbb, 0x14, 0xc4, 0x05, 0x3e,
-	0x02, 0x47, 0x4e, 0x48, 0x7c, 0x08, 0xc4, 0x1d, 0x89, 0x13, 0xe2, 0xe5, 0xc6, 0x01, 0xa1, 0x8a,
-	0x0f, 0x82, 0x6c, 0xc7, 0x71, 0xeb, 0x38, 0x2f, 0xd2, 0xa6, 0xb7, 0x99, 0x67, 0x66, 0x9e, 0xe7,
-	0xff, 0xf7, 0x3c, 0xfe, 0x69, 0xf0, 0x7e, 0x38, 0xec, 0x5b, 0x2c, 0xe4, 0x8e, 0xc7, 0x21, 0xd0,
-	0xd6, 0xa5, 0x90, 0xc3, 0x0b, 0x4f, 0x5c, 0x32, 0xe9, 0x0c, 0x78, 0x0c, 0xe3, 0x79, 0x7b, 0x14,
-	0xa0, 0xa1, 0x14, 0x5a, 0x90, 0xe7, 0x4a, 0xfb, 0x8c, 0x46, 0x5f, 0x88, 0xbe, 0x07, 0x49, 0x26,
-	0x8b, 0x05, 0x81, 0xd0, 0x4c, 0x73, 0x11, 0xa8, 0x6c, 0xbb, 0xb1, 0x3f, 0x7c, 0x4b, 0x51, 0x2e,
-	0x92, 0x55, 0x9f, 0x39, 0x03, 0x1e, 0x80, 0xbc, 0xb2, 0x46, 0x85, 0x95, 0xe5, 0x83, 0x66, 0x56,
-	0xdc, 0xb1, 0xfa, 0x10, 0x80, 0x64, 0x1a, 0xdc, 0xd1, 0xa9, 0xd3, 0x3e, 0xd7, 0x83, 0xa8, 0x47,
-	0x1d, 0xe1, 0x5b, 0x4c, 0xf6, 0x45, 0x28, 0xc5, 0x67, 0xe9, 0xa0, 0x9d, 0x57, 0x57, 0x45, 0x92,
-	0x3c, 0x64, 0xc5, 0x1d, 0xe6, 0x85, 0x03, 0x36, 0x91, 0xce, 0xfc, 0x03, 0xe1, 0xc6, 0x09, 0x57,
-	0xfa, 0x9d, 0x4c, 0xb2, 0xfb, 0x51, 0x9e, 0xc4, 0x86, 0xcf, 0x23, 0x50, 0x9a, 0x74, 0xf1, 0x9a,
-	0xc7, 0x95, 0x3e, 0x0f, 0x53, 0xe9, 0x75, 0xd4, 0x42, 0x1b, 0x6b, 0xbb, 0x1d, 0x9a, 0x69, 0xa7,
-	0x37, 0xb5, 0xd3, 0x70, 0xd8, 0x4f, 0x02, 0x8a, 0x26, 0xda, 0x69, 0xdc, 0xa1, 0x27, 0xc5, 0x41,
-	0xfb, 0x66, 0x16, 0xd2, 0xc4, 0x38, 0x60, 0x3e, 0x7c, 0x20, 0xe1, 0x82, 0x7f, 0x51, 0xaf, 0xb5,
-	0xd0, 0xc6, 0xaa, 0x7d, 0x23, 0x42, 0x1a, 0x78, 0x35, 0x99, 0xa9, 0x90, 0x39, 0x50, 0x5f, 0x49,
-	0x97, 0x8b, 0x40, 0x7e, 0xfa, 0x88, 0x7b, 0x1a, 0x64, 0xfd, 0x5e, 0x71, 0x3a, 0x8b, 0x98, 0x9f,
-	0x62, 0xe3, 0x3d, 0x98, 0x70, 0x94, 0x1b, 0x7a, 0x1e, 0xaf, 0x44, 0xdc, 0x4d, 0x8d, 0xac, 0xda,
-	0xc9, 0xf0, 0x76, 0xb5, 0x5a, 0xb9, 0x1a, 0xc1, 0xf7, 0x92, 0xc9, 0x48, 0x46, 0x3a, 0x36, 0xcf,
-	0xf1, 0xe3, 0x43, 0xf0, 0x40, 0xc3, 0x92, 0x8a, 0x98, 0xaf, 0xe0, 0xf5, 0x72, 0xaa, 0xac, 0x80,
-	0x6b, 0x83, 0x0a, 0x45, 0xa0, 0xc0, 0x3c, 0xc4, 0xaf, 0x56, 0x5d, 0xd4, 0x09, 0xeb, 0x81, 0x77,
-	0x0c, 0x57, 0xe3, 0x0b, 0xbb, 0x55, 0x08, 0x95, 0x0b, 0x7d, 0x8f, 0xf0, 0x93, 0xa9, 0x69, 0x3e,
-	0x64, 0x5e, 0x04, 0x77, 0x7b, 0xf3, 0xb3, 0x3f, 0xc3, 0x3f, 0x08, 0x37, 0x6c, 0xd0, 0xf2, 0x6a,
-	0xf1, 0xef, 0x9a, 0x5f, 0x4f, 0xad, 0xb8, 0x9e, 0x39, 0xed, 0xf3, 0x3a, 0x7e, 0x41, 0x82, 0xd2,
-	0x4c, 0xea, 0x6e, 0xe4, 0x38, 0xa0, 0xd4, 0x45, 0xe4, 0xa5, 0x5d, 0xf4, 0xd0, 0x9e, 0x5c, 0x48,
-	0x76, 0x07, 0xc2, 0x85, 0x23, 0x0e, 0x9e, 0xdb, 0x05, 0x0f, 0x1c, 0x2d, 0x64, 0xfd, 0x7e, 0x9a,
-	0x73, 0x72, 0x21, 0x69, 0xcd, 0x90, 0x49, 0xe6, 0x83, 0x06, 0xa9, 0xea, 0x0f, 0x5a, 0x2b, 0x49,
-	0x6b, 0x16, 0x11, 0xf3, 0x07, 0x84, 0xd7, 0x6d, 0x50, 0x51, 0xcf, 0xe7, 0xfa, 0x2e, 0x3d, 0x1a,
-	0xf8, 0xa1, 0x0f, 0xbe, 0xe0, 0x5f, 0x82, 0x3b, 0xb2, 0x36, 0x9e, 0x97, 0x34, 0xde, 0x2f, 0x6b,
-	0xdc, 0xfd, 0x76, 0x0d, 0xbf, 0x5c, 0xd6, 0xd6, 0x05, 0x19, 0x73, 0x07, 0xc8, 0xcf, 0x08, 0x3f,
-	0xaa, 0xc4, 0x05, 0x69, 0xd3, 0x12, 0xfd, 0xe8, 0x2c, 0xac, 0x18, 0x67, 0xb4, 0xe0, 0x18, 0xcd,
-	0x39, 0x96, 0x0e, 0x3e, 0x19, 0x73, 0x8c, 0xc6, 0x7b, 0x45, 0x67, 0xe5, 0x51, 0x9a, 0xa3, 0x8c,
-	0x8e, 0x5b, 0x97, 0x2b, 0x6d, 0x9a, 0xdf, 0xfc, 0xf5, 0xdf, 0x77, 0xb5, 0x06, 0x31, 0x52, 0xd8,
-	0xc6, 0x1d, 0x6b, 0xa4, 0xc2, 0x2d, 0xb0, 0x48, 0x7e, 0x42, 0xf8, 0xc5, 0x0a, 0x30, 0x90, 0xed,
-	0x09, 0xe9, 0xd3, 0xf1, 0x61, 0xbc, 0xbf, 0x3c, 0xe1, 0xe6, 0x46, 0x2a, 0xda, 0x24, 0xad, 0xe9,
-	0xa2, 0xad, 0xaf, 0x22, 0xee, 0x7e, 0x4d, 0x7e, 0x44, 0xf8, 0xa5, 0x6a, 0xe2, 0x10, 0x3a, 0xa1,
-	0x7e, 0x26, 0x9a, 0x8c, 0x9d, 0x89, 0xfd, 0xf3, 0xc8, 0x33, 0x92, 0xb9, 0x35, 0x5f, 0xe6, 0x9f,
-	0x08, 0x3f, 0x9e, 0x09, 0x29, 0xf2, 0xc6, 0x42, 0x6d, 0x52, 0x86, 0x9a, 0x71, 0xfc, 0xec, 0x5f,
-	0x7d, 0x9c, 0xd3, 0x6c, 0xa7, 0x7e, 0x9e, 0x92, 0xd7, 0xa6, 0xfb, 0x69, 0x7b, 0xc9, 0xee, 0xf6,
-	0x30, 0x91, 0xfc, 0x37, 0xc2, 0xeb, 0x73, 0x90, 0x49, 0xde, 0x5c, 0xdc, 0xd6, 0x2d, 0xc8, 0x1a,
-	0xa7, 0x4b, 0x32, 0x96, 0x65, 0x35, 0xad, 0xd4, 0xda, 0x26, 0x79, 0x3a, 0xd7, 0x5a, 0x9c, 0x09,
-	0xff, 0x05, 0xe1, 0x47, 0x95, 0xc4, 0xad, 0xf8, 0xa1, 0x67, 0x91, 0x79, 0xa9, 0xff, 0x45, 0x27,
-	0x75, 0xb1, 0x6d, 0x3c, 0x99, 0xd7, 0x70, 0x96, 0x4c, 0x24, 0x1d, 0xa0, 0x2d, 0xf2, 0x1b, 0xc2,
-	0xf5, 0x69, 0x60, 0x25, 0x3b, 0x15, 0x56, 0x66, 0x32, 0x78, 0xa9, 0x6e, 0xf6, 0x53, 0x37, 0xd4,
-	0xd8, 0x5c, 0xc0, 0x4d, 0xa6, 0xea, 0x00, 0x6d, 0xbd, 0x7b, 0xf6, 0xeb, 0x75, 0x13, 0xfd, 0x7e,
-	0xdd, 0x44, 0xff, 0x5e, 0x37, 0xd1, 0xc7, 0x6f, 0x2f, 0xfe, 0xea, 0xab, 0x7e, 0xb3, 0xf6, 0x1e,
-	0xa4, 0xef, 0xbd, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x00, 0xca, 0x0c, 0xdb, 0x0a,
-	0x00, 0x00,
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5d, 0x6b, 0x24, 0x45,
+	0x17, 0xa6, 0x32, 0x9b, 0x6c, 0x52, 0x79, 0xe1, 0xd5, 0xd2, 0xc4, 0xa6, 0xc9, 0xc7, 0xd8, 0x68,
+	0x36, 0x9b, 0x75, 0xba, 0x33, 0xd9, 0x04, 0xd7, 0xa0, 0xa0, 0x4b, 0x5c, 0xc1, 0xcd, 0xee, 0x4a,
+	0x07, 0x14, 0x04, 0xd1, 0xca, 0xf4, 0xc9, 0xa4, 0x9c, 0x9e, 0xa9, 0xde, 0xaa, 0xea, 0x59, 0x47,
+	0xf1, 0xc6, 0xbf, 0xe0, 0xa5, 0x57, 0x82, 0x78, 0xb5, 0x3f, 0x40, 0xbc, 0x17, 0xc4, 0x0b, 0x11,
+	0xbd, 0xf3, 0x42, 0x24, 0x08, 0xfe, 0x0d, 0xa9, 0xea, 0xee, 0xe9, 0xc9, 0x74, 0xcf, 0x07, 0x38,
+	0x8b, 0x77, 0x7d, 0x4e, 0x9f, 0x3a, 0xe7, 0x79, 0x4e, 0x3f, 0x55, 0xa7, 0x1a, 0xef, 0x47, 0xad,
+	0xa6, 0x47, 0x23, 0xd6, 0x08, 0x19, 0x74, 0x94, 0xf7, 0x88, 0x8b, 0xd6, 0x59, 0xc8, 0x1f, 0x51,
+	0xd1, 0x38, 0x67, 0x5d, 0xe8, 0xdb, 0xb5, 0xd4, 0xe1, 0x46, 0x82, 0x2b, 0x4e, 0xfe, 0x3f, 0x14,
+	0x67, 0xaf, 0x35, 0x39, 0x6f, 0x86, 0xa0, 0x33, 0x79, 0xb4, 0xd3, 0xe1, 0x8a, 0x2a, 0xc6, 0x3b,
+	0x32, 0x09, 0xb7, 0xf7, 0x5b, 0xb7, 0xa4, 0xcb, 0xb8, 0x7e, 0xdb, 0xa6, 0x8d, 0x73, 0xd6, 0x01,
+	0xd1, 0xf3, 0xd2, 0xc2, 0xd2, 0x6b, 0x83, 0xa2, 0x5e, 0xb7, 0xee, 0x35, 0xa1, 0x03, 0x82, 0x2a,
+	0x08, 0xd2, 0x55, 0xf7, 0x9a, 0x4c, 0x9d, 0xc7, 0xa7, 0x6e, 0x83, 0xb7, 0x3d, 0x2a, 0x9a, 0x3c,
+	0x12, 0xfc, 0x63, 0xf3, 0x50, 0xcb, 0xaa, 0xcb, 0x3c, 0x49, 0xe6, 0xf2, 0xba, 0x75, 0x1a, 0x46,
+	0xe7, 0xb4, 0x90, 0xce, 0xf9, 0x1b, 0xe1, 0xb5, 0x63, 0x26, 0xd5, 0x1b, 0x09, 0xe4, 0xe0, 0xbd,
+	0x2c, 0x89, 0x0f, 0x0f, 0x63, 0x90, 0x8a, 0x9c, 0xe0, 0xe5, 0x90, 0x49, 0xf5, 0x20, 0x32, 0xd0,
+	0x2d, 0x54, 0x45, 0xdb, 0xcb, 0x7b, 0x75, 0x37, 0xc1, 0xee, 0x0e, 0x62, 0x77, 0xa3, 0x56, 0x53,
+	0x3b, 0xa4, 0xab, 0xb1, 0xbb, 0xdd, 0xba, 0x7b, 0x9c, 0x2f, 0xf4, 0x07, 0xb3, 0x90, 0x0d, 0x8c,
+	0x3b, 0xb4, 0x0d, 0xef, 0x08, 0x38, 0x63, 0x9f, 0x58, 0x73, 0x55, 0xb4, 0xbd, 0xe4, 0x0f, 0x78,
+	0xc8, 0x1a, 0x5e, 0xd2, 0x96, 0x8c, 0x68, 0x03, 0xac, 0x8a, 0x79, 0x9d, 0x3b, 0xb2, 0xd5, 0x77,
+	0x58, 0xa8, 0x40, 0x58, 0x57, 0xf2, 0xd5, 0x89, 0x87, 0x3c, 0x8b, 0xe7, 0x1f, 0xc6, 0x20, 0x7a,
+	0xd6, 0xbc, 0x79, 0x95, 0x18, 0xce, 0x47, 0xd8, 0x7e, 0x0b, 0x0a, 0x3c, 0x33, 0x9a, 0x4f, 0xe1,
+	0x4a, 0xcc, 0x02, 0x43, 0x6f, 0xc9, 0xd7, 0x8f, 0x97, 0x31, 0xcc, 0x0d, 0x63, 0x20, 0xf8, 0x8a,
+	0x36, 0x52, 0x70, 0xe6, 0xd9, 0x79, 0x80, 0xd7, 0x8f, 0x20, 0x04, 0x05, 0x33, 0x2a, 0xe2, 0x3c,
+	0x8f, 0x37, 0x87, 0x53, 0x25, 0x05, 0x02, 0x1f, 0x64, 0xc4, 0x3b, 0x12, 0x9c, 0x23, 0xfc, 0x42,
+	0xd9, 0xe7, 0x3b, 0xa6, 0xa7, 0x10, 0xde, 0x85, 0x5e, 0xff, 0x33, 0x5e, 0x2a, 0x84, 0x86, 0x0b,
+	0x7d, 0x85, 0xf0, 0xd6, 0xc8, 0x34, 0xef, 0xd2, 0x30, 0x86, 0x27, 0xab, 0x87, 0xf1, 0x6d, 0xf8,
+	0x03, 0xe1, 0x35, 0x1f, 0x94, 0xe8, 0x4d, 0xdf, 0xd7, 0xec, 0xf3, 0xcc, 0xe5, 0x9f, 0x67, 0x82,
+	0xa8, 0x5e, 0xc2, 0x4f, 0x0b, 0x90, 0x8a, 0x0a, 0x75, 0x12, 0x37, 0x1a, 0x20, 0xe5, 0x59, 0x1c,
+	0x1a, 0x6d, 0x2d, 0xfa, 0xc5, 0x17, 0x3a, 0xba, 0xc3, 0x03, 0xb8, 0xc3, 0x20, 0x0c, 0x4e, 0x20,
+	0x84, 0x86, 0xe2, 0x22, 0x95, 0x5b, 0xf1, 0x85, 0x16, 0x6c, 0x44, 0x05, 0x6d, 0x83, 0x02, 0x21,
+	0xad, 0x85, 0x6a, 0x45, 0x0b, 0x36, 0xf7, 0x38, 0x5f, 0x23, 0xbc, 0xe9, 0x83, 0x8c, 0x4f, 0xdb,
+	0x4c, 0x3d, 0x49, 0x8e, 0x36, 0x5e, 0x6c, 0x43, 0x9b, 0xb3, 0x4f, 0x21, 0x48, 0xa9, 0xf5, 0xed,
+	0x21, 0x8c, 0xf3, 0x05, 0x8c, 0x3f, 0x21, 0xbc, 0x59, 0xb2, 0x7f, 0x4e, 0x14, 0x55, 0xff, 0xa1,
+	0x36, 0x88, 0x85, 0xaf, 0x36, 0x05, 0x8f, 0xa3, 0xdb, 0xbd, 0x94, 0x6e, 0x66, 0x6a, 0xb2, 0x61,
+	0xba, 0x0b, 0xd2, 0x33, 0xa2, 0x6f, 0x3b, 0x8f, 0x2b, 0x78, 0xa5, 0x94, 0x89, 0x3e, 0x3b, 0x4c,
+	0x82, 0xb4, 0xd1, 0x89, 0xa1, 0xbd, 0x8a, 0x2b, 0x1a, 0x9a, 0xfa, 0x15, 0x3f, 0x31, 0x34, 0x32,
+	0xa9, 0x25, 0x01, 0x01, 0x04, 0xa6, 0x7a, 0xc5, 0xcf, 0x1d, 0x64, 0x15, 0x2f, 0x9c, 0x51, 0x16,
+	0xa6, 0xad, 0xae, 0xf8, 0xa9, 0xa5, 0x11, 0x83, 0x10, 0x5c, 0x40, 0x60, 0x04, 0x53, 0xf1, 0x33,
+	0x93, 0x54, 0xf1, 0xb2, 0x4c, 0x24, 0xe6, 0x53, 0x05, 0xd6, 0x42, 0x15, 0x6d, 0x23, 0x7f, 0xd0,
+	0xa5, 0x23, 0xa2, 0x83, 0xdd, 0xa3, 0x58, 0x98, 0x41, 0x62, 0x5d, 0x4d, 0x22, 0x06, 0x5c, 0x26,
+	0xe2, 0x95, 0x83, 0x7e, 0xc4, 0x62, 0x1a, 0x91, 0xbb, 0x48, 0xcb, 0x08, 0x9d, 0xc7, 0xa2, 0x01,
+	0xb2, 0x1f, 0xb7, 0x54, 0xad, 0x6c, 0x2f, 0xef, 0xbd, 0xe6, 0x0e, 0x4d, 0x30, 0xb7, 0xb4, 0x49,
+	0xae, 0x3f, 0xbc, 0xfe, 0xcd, 0x8e, 0x12, 0x3d, 0xbf, 0x98, 0xd7, 0x3e, 0xc2, 0xab, 0xe5, 0xc1,
+	0x5a, 0xcf, 0x2d, 0xe8, 0x65, 0x7a, 0x6e, 0x41, 0x4f, 0x37, 0xb9, 0xab, 0x8f, 0x9a, 0xac, 0xc9,
+	0xc6, 0x38, 0x9c, 0xbb, 0x85, 0x9c, 0x0f, 0xf0, 0xfa, 0x08, 0xdd, 0x25, 0xa7, 0x20, 0x79, 0x15,
+	0xcf, 0x33, 0x05, 0x6d, 0x2d, 0x39, 0xcd, 0x63, 0x6b, 0x3a, 0x1e, 0x7e, 0xb2, 0x68, 0xef, 0xf1,
+	0xff, 0xf0, 0x73, 0x85, 0x00, 0x10, 0x5d, 0xd6, 0x00, 0xf2, 0x3d, 0xc2, 0x2b, 0xa5, 0xf3, 0x91,
+	0xd4, 0x0a, 0x45, 0xc6, 0xcd, 0x51, 0xfb, 0xbe, 0x9b, 0x0f, 0x6e, 0x37, 0x1b, 0xdc, 0xe6, 0xe1,
+	0xc3, 0xfe, 0xe0, 0x76, 0xbb, 0x37, 0xf3, 0x8d, 0x91, 0x79, 0xdd, 0x6c, 0x76, 0xbb, 0xfd, 0x53,
+	0x99, 0x49, 0xe5, 0x38, 0x5f, 0xfc, 0xf6, 0xd7, 0x97, 0x73, 0x6b, 0xc4, 0x36, 0xb7, 0x8b, 0x6e,
+	0xdd, 0x4b, 0x51, 0x04, 0xf9, 0x3d, 0x80, 0x7c, 0x87, 0xf0, 0x33, 0x25, 0x7b, 0x96, 0xdc, 0x28,
+	0x40, 0x1f, 0x3d, 0x19, 0xed, 0xb7, 0x67, 0x07, 0xdc, 0xd9, 0x36, 0xa0, 0x1d, 0x52, 0x1d, 0x0d,
+	0xda, 0xfb, 0x2c, 0x66, 0xc1, 0xe7, 0xe4, 0x1b, 0x84, 0x57, 0xcb, 0x87, 0x29, 0x71, 0x0b, 0xe8,
+	0xc7, 0x4e, 0x5d, 0x7b, 0x77, 0xa2, 0x1a, 0x86, 0x87, 0x6a, 0x0a, 0x73, 0x67, 0x32, 0xcc, 0x5f,
+	0x11, 0x5e, 0x1f, 0x3b, 0x7f, 0xc9, 0xc1, 0x54, 0x32, 0x19, 0x9e, 0xd7, 0xf6, 0xdd, 0x7f, 0xdf,
+	0xf5, 0x7e, 0x4e, 0xa7, 0x66, 0xf8, 0x5c, 0x23, 0x2f, 0x8e, 0xe6, 0x53, 0x33, 0x67, 0x63, 0xad,
+	0xa5, 0x21, 0xff, 0x8e, 0xf0, 0xe6, 0x84, 0xdb, 0x00, 0x79, 0x79, 0x7a, 0x5a, 0x97, 0xee, 0x0f,
+	0xf6, 0xbd, 0x19, 0x11, 0x4b, 0xb2, 0x3a, 0x9e, 0xa1, 0x76, 0x9d, 0x5c, 0x9b, 0x48, 0xad, 0x9b,
+	0x00, 0xff, 0x16, 0x61, 0x6b, 0xd4, 0x1c, 0x23, 0xbb, 0xd3, 0x6c, 0x8c, 0xc1, 0x91, 0x67, 0xbb,
+	0x53, 0x1e, 0x35, 0x43, 0xd2, 0x1a, 0xb7, 0x03, 0x6a, 0xd2, 0x60, 0xf9, 0x01, 0xe1, 0x95, 0xd2,
+	0x5b, 0x4f, 0xc9, 0xc9, 0x33, 0xee, 0x76, 0x34, 0xd3, 0x0d, 0x5c, 0x37, 0xf0, 0x6f, 0xd8, 0x5b,
+	0x93, 0x76, 0x86, 0x27, 0x34, 0xa4, 0x43, 0xb4, 0x43, 0x7e, 0x46, 0xd8, 0x1a, 0x75, 0xb9, 0x29,
+	0x69, 0xf8, 0x84, 0x7b, 0xd0, 0x4c, 0xd9, 0xec, 0x1b, 0x36, 0xae, 0x7d, 0x7d, 0x0a, 0x36, 0x09,
+	0xaa, 0x43, 0xb4, 0x73, 0xfb, 0xfe, 0x8f, 0x17, 0x1b, 0xe8, 0x97, 0x8b, 0x0d, 0xf4, 0xe7, 0xc5,
+	0x06, 0x7a, 0xff, 0xf5, 0xe9, 0xff, 0xc7, 0xca, 0xff, 0x26, 0x4f, 0x17, 0xcc, 0x9f, 0xd8, 0xcd,
+	0x7f, 0x06, 0x00, 0x26, 0xda, 0xaf, 0x6a, 0x75, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -615,6 +882,7 @@ type ArchivedWorkflowServiceClient interface {
 	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
 	ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
+	GetArchivedWorkflowStats(ctx context.Context, in *GetArchivedWorkflowStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowStatsResponse, error)
 	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
@@ -672,6 +940,15 @@ func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelValues(ctx cont
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) GetArchivedWorkflowStats(ctx context.Context, in *GetArchivedWorkflowStatsRequest, opts ...grpc.CallOption) (*ArchivedWorkflowStatsResponse, error) {
+	out := new(ArchivedWorkflowStatsResponse)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	out := new(v1alpha1.Workflow)
 	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow", in, out, opts...)
@@ -697,6 +974,7 @@ type ArchivedWorkflowServiceServer interface {
 	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
 	ListArchivedWorkflowLabelKeys(context.Context, *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
+	GetArchivedWorkflowStats(context.Context, *GetArchivedWorkflowStatsRequest) (*ArchivedWorkflowStatsResponse, error)
 	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 }
@@ -720,6 +998,9 @@ func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelKeys
 func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelValues(ctx context.Context, req *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelValues not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflowStats(ctx context.Context, req *GetArchivedWorkflowStatsRequest) (*ArchivedWorkflowStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedWorkflowStats not implemented")
+}
 func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
 }
@@ -821,6 +1102,24 @@ func _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_GetArchivedWorkflowStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedWorkflowStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/GetArchivedWorkflowStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).GetArchivedWorkflowStats(ctx, req.(*GetArchivedWorkflowStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetryArchivedWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -881,6 +1180,10 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListArchivedWorkflowLabelValues",
 			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler,
 		},
+		{
+			MethodName: "GetArchivedWorkflowStats",
+			Handler:    _ArchivedWorkflowService_GetArchivedWorkflowStats_Handler,
+		},
 		{
 			MethodName: "RetryArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
@@ -918,6 +1221,13 @@ func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.NameFilter) > 0 {
 		i -= len(m.NameFilter)
 		copy(dAtA[i:], m.NameFilter)
@@ -1291,60 +1601,254 @@ func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowArchive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetArchivedWorkflowStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ListArchivedWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetArchivedWorkflowStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetArchivedWorkflowStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.NamePrefix)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if len(m.LabelKey) > 0 {
+		i -= len(m.LabelKey)
+		copy(dAtA[i:], m.LabelKey)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.LabelKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NameFilter)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ListOptions != nil {
+		{
+			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ArchivedWorkflowStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if len(m.ResourcesDuration) > 0 {
+		for k := range m.ResourcesDuration {
+			v := m.ResourcesDuration[k]
+			baseI := i
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.Name)
+	if m.P95Duration != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P95Duration))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.P50Duration != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P50Duration))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.SuccessRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SuccessRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Errored != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Errored))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedWorkflowStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedWorkflowStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedWorkflowStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NameFilter)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovWorkflowArchive(uint64(l))
 	}
@@ -1492,6 +1996,97 @@ func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
 	return n
 }
 
+func (m *GetArchivedWorkflowStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.LabelKey)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Total))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Failed))
+	}
+	if m.Errored != 0 {
+		n += 1 + sovWorkflowArchive(uint64(m.Errored))
+	}
+	if m.SuccessRate != 0 {
+		n += 9
+	}
+	if m.P50Duration != 0 {
+		n += 9
+	}
+	if m.P95Duration != 0 {
+		n += 9
+	}
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflowArchive(uint64(len(k))) + 1 + sovWorkflowArchive(uint64(v))
+			n += mapEntrySize + 1 + sovWorkflowArchive(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedWorkflowStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1659,6 +2254,38 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NameFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -2626,6 +3253,579 @@ func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetArchivedWorkflowStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetArchivedWorkflowStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetArchivedWorkflowStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListOptions == nil {
+				m.ListOptions = &v1.ListOptions{}
+			}
+			if err := m.ListOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedWorkflowStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedWorkflowStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedWorkflowStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errored", wireType)
+			}
+			m.Errored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errored |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SuccessRate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50Duration", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P50Duration = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95Duration", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P95Duration = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflowArchive
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedWorkflowStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedWorkflowStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedWorkflowStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ArchivedWorkflowStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -792,10 +792,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *Checkpoint) Reset()      { *m = Checkpoint{} }
+func (*Checkpoint) ProtoMessage() {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{27}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
 func (m *ClientCertAuth) Reset()      { *m = ClientCertAuth{} }
 func (*ClientCertAuth) ProtoMessage() {}
 func (*ClientCertAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *ClientCertAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +851,7 @@ var xxx_messageInfo_ClientCertAuth proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +879,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +907,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Column) Reset()      { *m = Column{} }
 func (*Column) ProtoMessage() {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +935,7 @@ var xxx_messageInfo_Column proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +963,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,10 +988,38 @@ func (m *ContainerNode) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 
+func (m *ContainerResourceUsage) Reset()      { *m = ContainerResourceUsage{} }
+func (*ContainerResourceUsage) ProtoMessage() {}
+func (*ContainerResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{34}
+}
+func (m *ContainerResourceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerResourceUsage.Merge(m, src)
+}
+func (m *ContainerResourceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerResourceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerResourceUsage proto.InternalMessageInfo
+
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1047,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1075,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1103,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1131,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1159,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1187,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1215,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1243,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1271,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{44}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1299,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1327,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1355,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,10 +1380,66 @@ func (m *DataSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataSource proto.InternalMessageInfo
 
+func (m *Decision) Reset()      { *m = Decision{} }
+func (*Decision) ProtoMessage() {}
+func (*Decision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{48}
+}
+func (m *Decision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Decision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Decision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decision.Merge(m, src)
+}
+func (m *Decision) XXX_Size() int {
+	return m.Size()
+}
+func (m *Decision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decision proto.InternalMessageInfo
+
+func (m *ErrorMessageRetryRule) Reset()      { *m = ErrorMessageRetryRule{} }
+func (*ErrorMessageRetryRule) ProtoMessage() {}
+func (*ErrorMessageRetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{49}
+}
+func (m *ErrorMessageRetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorMessageRetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ErrorMessageRetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorMessageRetryRule.Merge(m, src)
+}
+func (m *ErrorMessageRetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorMessageRetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorMessageRetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorMessageRetryRule proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1467,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,10 +1492,38 @@ func (m *ExecutorConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 
+func (m *ExitCodeRetryRule) Reset()      { *m = ExitCodeRetryRule{} }
+func (*ExitCodeRetryRule) ProtoMessage() {}
+func (*ExitCodeRetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{52}
+}
+func (m *ExitCodeRetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitCodeRetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExitCodeRetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitCodeRetryRule.Merge(m, src)
+}
+func (m *ExitCodeRetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitCodeRetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitCodeRetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitCodeRetryRule proto.InternalMessageInfo
+
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1551,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1579,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1607,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1635,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1663,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1691,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1719,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1747,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1775,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1803,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1831,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
 func (*HTTPAuth) ProtoMessage() {}
 func (*HTTPAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1859,7 @@ var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
 func (m *HTTPBodySource) Reset()      { *m = HTTPBodySource{} }
 func (*HTTPBodySource) ProtoMessage() {}
 func (*HTTPBodySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *HTTPBodySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1887,7 @@ var xxx_messageInfo_HTTPBodySource proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1915,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1943,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +1971,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +1999,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2027,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2055,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2083,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2111,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2139,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2167,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2195,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *ManifestFrom) Reset()      { *m = ManifestFrom{} }
 func (*ManifestFrom) ProtoMessage() {}
 func (*ManifestFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *ManifestFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2223,7 @@ var xxx_messageInfo_ManifestFrom proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2251,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2279,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2307,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2335,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2363,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2391,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2419,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2447,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeFlag) Reset()      { *m = NodeFlag{} }
 func (*NodeFlag) ProtoMessage() {}
 func (*NodeFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *NodeFlag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2475,7 @@ var xxx_messageInfo_NodeFlag proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2503,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2531,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2559,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2587,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OAuth2Auth) Reset()      { *m = OAuth2Auth{} }
 func (*OAuth2Auth) ProtoMessage() {}
 func (*OAuth2Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *OAuth2Auth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2615,7 @@ var xxx_messageInfo_OAuth2Auth proto.InternalMessageInfo
 func (m *OAuth2EndpointParam) Reset()      { *m = OAuth2EndpointParam{} }
 func (*OAuth2EndpointParam) ProtoMessage() {}
 func (*OAuth2EndpointParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *OAuth2EndpointParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2643,7 @@ var xxx_messageInfo_OAuth2EndpointParam proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2671,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2699,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2727,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2755,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +2783,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2811,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2839,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2724,10 +2864,66 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParameterItemsSchema) Reset()      { *m = ParameterItemsSchema{} }
+func (*ParameterItemsSchema) ProtoMessage() {}
+func (*ParameterItemsSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{101}
+}
+func (m *ParameterItemsSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterItemsSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterItemsSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterItemsSchema.Merge(m, src)
+}
+func (m *ParameterItemsSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterItemsSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterItemsSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterItemsSchema proto.InternalMessageInfo
+
+func (m *ParameterSchema) Reset()      { *m = ParameterSchema{} }
+func (*ParameterSchema) ProtoMessage() {}
+func (*ParameterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{102}
+}
+func (m *ParameterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchema.Merge(m, src)
+}
+func (m *ParameterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchema proto.InternalMessageInfo
+
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +2951,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2780,10 +2976,66 @@ func (m *PodGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodGC proto.InternalMessageInfo
 
+func (m *ProgressDetail) Reset()      { *m = ProgressDetail{} }
+func (*ProgressDetail) ProtoMessage() {}
+func (*ProgressDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{105}
+}
+func (m *ProgressDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProgressDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProgressDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgressDetail.Merge(m, src)
+}
+func (m *ProgressDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProgressDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgressDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgressDetail proto.InternalMessageInfo
+
+func (m *ProgressStage) Reset()      { *m = ProgressStage{} }
+func (*ProgressStage) ProtoMessage() {}
+func (*ProgressStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{106}
+}
+func (m *ProgressStage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProgressStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProgressStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgressStage.Merge(m, src)
+}
+func (m *ProgressStage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProgressStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgressStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgressStage proto.InternalMessageInfo
+
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3063,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3091,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3119,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3147,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2920,10 +3172,38 @@ func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 
+func (m *RetryRule) Reset()      { *m = RetryRule{} }
+func (*RetryRule) ProtoMessage() {}
+func (*RetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{112}
+}
+func (m *RetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryRule.Merge(m, src)
+}
+func (m *RetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryRule proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3231,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3259,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3287,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3315,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3060,10 +3340,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *SLO) Reset()      { *m = SLO{} }
+func (*SLO) ProtoMessage() {}
+func (*SLO) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{118}
+}
+func (m *SLO) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SLO) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SLO) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLO.Merge(m, src)
+}
+func (m *SLO) XXX_Size() int {
+	return m.Size()
+}
+func (m *SLO) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLO.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLO proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3399,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3427,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3455,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3483,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3511,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
 func (*StopStrategy) ProtoMessage() {}
 func (*StopStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3539,7 @@ var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3567,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3595,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3623,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3651,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
 func (*SyncDatabaseRef) ProtoMessage() {}
 func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +3679,7 @@ var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3707,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3735,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3763,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3791,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +3819,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +3847,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +3875,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3903,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +3931,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +3959,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +3987,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +4015,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +4043,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +4071,7 @@ var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +4099,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3819,7 +4127,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +4155,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowLevelArtifactGC) Reset()      { *m = WorkflowLevelArtifactGC{} }
 func (*WorkflowLevelArtifactGC) ProtoMessage() {}
 func (*WorkflowLevelArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *WorkflowLevelArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4183,7 @@ var xxx_messageInfo_WorkflowLevelArtifactGC proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +4211,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3928,10 +4236,66 @@ func (m *WorkflowMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 
+func (m *WorkflowRetryStatus) Reset()      { *m = WorkflowRetryStatus{} }
+func (*WorkflowRetryStatus) ProtoMessage() {}
+func (*WorkflowRetryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{150}
+}
+func (m *WorkflowRetryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowRetryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowRetryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowRetryStatus.Merge(m, src)
+}
+func (m *WorkflowRetryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowRetryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowRetryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowRetryStatus proto.InternalMessageInfo
+
+func (m *WorkflowRetryStrategy) Reset()      { *m = WorkflowRetryStrategy{} }
+func (*WorkflowRetryStrategy) ProtoMessage() {}
+func (*WorkflowRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{151}
+}
+func (m *WorkflowRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowRetryStrategy.Merge(m, src)
+}
+func (m *WorkflowRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowRetryStrategy proto.InternalMessageInfo
+
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +4323,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{153}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4351,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{154}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4379,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{155}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4407,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{156}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4435,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{157}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4463,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{158}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4491,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{159}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4155,7 +4519,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{147}
+	return fileDescriptor_724696e352c3df5f, []int{160}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +4547,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{148}
+	return fileDescriptor_724696e352c3df5f, []int{161}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4211,7 +4575,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{149}
+	return fileDescriptor_724696e352c3df5f, []int{162}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4239,7 +4603,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{150}
+	return fileDescriptor_724696e352c3df5f, []int{163}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4267,7 +4631,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{151}
+	return fileDescriptor_724696e352c3df5f, []int{164}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,12 +4692,14 @@ func init() {
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*Checkpoint)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Checkpoint")
 	proto.RegisterType((*ClientCertAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClientCertAuth")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Column)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Column")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Condition")
 	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerResourceUsage)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerResourceUsage")
 	proto.RegisterType((*ContainerSetRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetRetryStrategy")
 	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContinueOn")
@@ -4348,8 +4714,11 @@ func init() {
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*Decision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Decision")
+	proto.RegisterType((*ErrorMessageRetryRule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ErrorMessageRetryRule")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
+	proto.RegisterType((*ExitCodeRetryRule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExitCodeRetryRule")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifactRepository")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
@@ -4376,6 +4745,7 @@ func init() {
 	proto.RegisterType((*Link)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Link")
 	proto.RegisterType((*ManifestFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ManifestFrom")
 	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MemoizationStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MemoizationStatus.KeyComponentsEntry")
 	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Memoize")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Metadata.AnnotationsEntry")
@@ -4401,18 +4771,24 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParameterItemsSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterItemsSchema")
+	proto.RegisterType((*ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
+	proto.RegisterType((*ProgressDetail)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ProgressDetail")
+	proto.RegisterType((*ProgressStage)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ProgressStage")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
+	proto.RegisterType((*RetryRule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryRule")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*SLO)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SLO")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
@@ -4449,6 +4825,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.LabelsEntry")
 	proto.RegisterMapType((map[string]LabelValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.LabelsFromEntry")
+	proto.RegisterType((*WorkflowRetryStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowRetryStatus")
+	proto.RegisterType((*WorkflowRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowRetryStrategy")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
@@ -4478,711 +4856,785 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 11256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-	0x75, 0x18, 0x67, 0x81, 0xc5, 0xc7, 0x5b, 0x00, 0x87, 0xeb, 0xfb, 0x5a, 0x82, 0xe4, 0x81, 0x1e,
-	0x8a, 0x0c, 0x69, 0x51, 0x38, 0xf1, 0x28, 0x25, 0x8c, 0x94, 0x48, 0xc2, 0xc7, 0x01, 0x07, 0x02,
-	0x38, 0x80, 0xbd, 0x38, 0x9e, 0x49, 0xd1, 0x92, 0x06, 0xbb, 0x8d, 0xdd, 0x21, 0x76, 0x67, 0x96,
-	0x33, 0xb3, 0xb8, 0x03, 0x3f, 0x24, 0x85, 0xfa, 0xa2, 0x62, 0xd9, 0x8a, 0x65, 0x4a, 0x96, 0xe4,
-	0x24, 0xa5, 0x28, 0x52, 0xa2, 0x92, 0x5d, 0x49, 0xd9, 0xbf, 0x12, 0xfb, 0x5f, 0x7e, 0xb8, 0x94,
-	0x72, 0x2a, 0x91, 0x2b, 0x4a, 0x59, 0x3f, 0x62, 0x30, 0x82, 0x13, 0x55, 0x2a, 0x29, 0xfd, 0xb0,
-	0x2a, 0x4e, 0xe2, 0xcb, 0x47, 0xb9, 0xfa, 0x73, 0xba, 0x67, 0x67, 0x71, 0x00, 0xae, 0x01, 0xaa,
-	0xec, 0x5f, 0xc0, 0xbe, 0x7e, 0xfd, 0x5e, 0x77, 0x4f, 0xf7, 0xeb, 0xd7, 0xef, 0xbd, 0x7e, 0x0d,
-	0x6b, 0x75, 0x3f, 0x69, 0x74, 0x36, 0xa6, 0xaa, 0x61, 0xeb, 0x92, 0x17, 0xd5, 0xc3, 0x76, 0x14,
-	0xbe, 0xc8, 0xfe, 0x79, 0xd7, 0xcd, 0x30, 0xda, 0xda, 0x6c, 0x86, 0x37, 0xe3, 0x4b, 0xdb, 0x4f,
-	0x5e, 0x6a, 0x6f, 0xd5, 0x2f, 0x79, 0x6d, 0x3f, 0xbe, 0x24, 0xa1, 0x97, 0xb6, 0x9f, 0xf0, 0x9a,
-	0xed, 0x86, 0xf7, 0xc4, 0xa5, 0x3a, 0x09, 0x48, 0xe4, 0x25, 0xa4, 0x36, 0xd5, 0x8e, 0xc2, 0x24,
-	0x44, 0x1f, 0x4a, 0x29, 0x4e, 0x49, 0x8a, 0xec, 0x9f, 0x8f, 0x2a, 0x8a, 0x53, 0xdb, 0x4f, 0x4e,
-	0xb5, 0xb7, 0xea, 0x53, 0x94, 0xe2, 0x94, 0x84, 0x4e, 0x49, 0x8a, 0x13, 0xef, 0xd2, 0xda, 0x54,
-	0x0f, 0xeb, 0xe1, 0x25, 0x46, 0x78, 0xa3, 0xb3, 0xc9, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0xce, 0x70,
-	0xc2, 0xdd, 0x7a, 0x2a, 0x9e, 0xf2, 0x43, 0xda, 0xbe, 0x4b, 0xd5, 0x30, 0x22, 0x97, 0xb6, 0xbb,
-	0x1a, 0x35, 0xf1, 0x0e, 0x0d, 0xa7, 0x1d, 0x36, 0xfd, 0xea, 0x4e, 0x1e, 0xd6, 0x7b, 0x52, 0xac,
-	0x96, 0x57, 0x6d, 0xf8, 0x01, 0x89, 0x76, 0xd2, 0xae, 0xb7, 0x48, 0xe2, 0xe5, 0xd5, 0xba, 0xd4,
-	0xab, 0x56, 0xd4, 0x09, 0x12, 0xbf, 0x45, 0xba, 0x2a, 0xfc, 0xf5, 0x3b, 0x55, 0x88, 0xab, 0x0d,
-	0xd2, 0xf2, 0xba, 0xea, 0x3d, 0xd9, 0xab, 0x5e, 0x27, 0xf1, 0x9b, 0x97, 0xfc, 0x20, 0x89, 0x93,
-	0x28, 0x5b, 0xc9, 0xbd, 0x02, 0x03, 0xd3, 0xad, 0xb0, 0x13, 0x24, 0xe8, 0xfd, 0x50, 0xdc, 0xf6,
-	0x9a, 0x1d, 0x52, 0x76, 0x1e, 0x74, 0x1e, 0x1d, 0x9e, 0x79, 0xf8, 0x7b, 0xbb, 0x93, 0xf7, 0xec,
-	0xed, 0x4e, 0x16, 0x9f, 0xa5, 0xc0, 0xdb, 0xbb, 0x93, 0x67, 0x49, 0x50, 0x0d, 0x6b, 0x7e, 0x50,
-	0xbf, 0xf4, 0x62, 0x1c, 0x06, 0x53, 0xd7, 0x3a, 0xad, 0x0d, 0x12, 0x61, 0x5e, 0xc7, 0xfd, 0xf7,
-	0x05, 0x38, 0x35, 0x1d, 0x55, 0x1b, 0xfe, 0x36, 0xa9, 0x24, 0x94, 0x7e, 0x7d, 0x07, 0x35, 0xa0,
-	0x2f, 0xf1, 0x22, 0x46, 0xae, 0x74, 0x79, 0x65, 0xea, 0x6e, 0xbf, 0xfb, 0xd4, 0xba, 0x17, 0x49,
-	0xda, 0x33, 0x83, 0x7b, 0xbb, 0x93, 0x7d, 0xeb, 0x5e, 0x84, 0x29, 0x0b, 0xd4, 0x84, 0xfe, 0x20,
-	0x0c, 0x48, 0xb9, 0xc0, 0x58, 0x5d, 0xbb, 0x7b, 0x56, 0xd7, 0xc2, 0x40, 0xf5, 0x63, 0x66, 0x68,
-	0x6f, 0x77, 0xb2, 0x9f, 0x42, 0x30, 0xe3, 0x42, 0xfb, 0xf5, 0xb2, 0xdf, 0x2e, 0xf7, 0xd9, 0xea,
-	0xd7, 0xf3, 0x7e, 0xdb, 0xec, 0xd7, 0xf3, 0x7e, 0x1b, 0x53, 0x16, 0xee, 0xe7, 0x0b, 0x30, 0x3c,
-	0x1d, 0xd5, 0x3b, 0x2d, 0x12, 0x24, 0x31, 0xfa, 0x04, 0x40, 0xdb, 0x8b, 0xbc, 0x16, 0x49, 0x48,
-	0x14, 0x97, 0x9d, 0x07, 0xfb, 0x1e, 0x2d, 0x5d, 0x5e, 0xba, 0x7b, 0xf6, 0x6b, 0x92, 0xe6, 0x0c,
-	0x12, 0x9f, 0x1c, 0x14, 0x28, 0xc6, 0x1a, 0x4b, 0xf4, 0x0a, 0x0c, 0x7b, 0x51, 0xe2, 0x6f, 0x7a,
-	0xd5, 0x24, 0x2e, 0x17, 0x18, 0xff, 0xa7, 0xef, 0x9e, 0xff, 0xb4, 0x20, 0x39, 0x73, 0x5a, 0xb0,
-	0x1f, 0x96, 0x90, 0x18, 0xa7, 0xfc, 0xdc, 0xdf, 0xed, 0x87, 0xd2, 0x74, 0x94, 0x2c, 0xcc, 0x56,
-	0x12, 0x2f, 0xe9, 0xc4, 0xe8, 0x0f, 0x1c, 0x38, 0x13, 0xf3, 0x61, 0xf3, 0x49, 0xbc, 0x16, 0x85,
-	0x55, 0x12, 0xc7, 0xa4, 0x26, 0xc6, 0x65, 0xd3, 0x4a, 0xbb, 0x24, 0xb3, 0xa9, 0x4a, 0x37, 0xa3,
-	0x2b, 0x41, 0x12, 0xed, 0xcc, 0x3c, 0x21, 0xda, 0x7c, 0x26, 0x07, 0xe3, 0xf5, 0xb7, 0x26, 0x91,
-	0xec, 0x0a, 0xa5, 0xc4, 0x3f, 0x31, 0xce, 0x6b, 0x35, 0xfa, 0x9a, 0x03, 0x23, 0xed, 0xb0, 0x16,
-	0x63, 0x52, 0x0d, 0x3b, 0x6d, 0x52, 0x13, 0xc3, 0xfb, 0x51, 0xbb, 0xdd, 0x58, 0xd3, 0x38, 0xf0,
-	0xf6, 0x9f, 0x15, 0xed, 0x1f, 0xd1, 0x8b, 0xb0, 0xd1, 0x14, 0xf4, 0x14, 0x8c, 0x04, 0x61, 0x52,
-	0x69, 0x93, 0xaa, 0xbf, 0xe9, 0x93, 0x1a, 0x9b, 0xf8, 0x43, 0x69, 0xcd, 0x6b, 0x5a, 0x19, 0x36,
-	0x30, 0x27, 0xe6, 0xa1, 0xdc, 0x6b, 0xe4, 0xd0, 0x38, 0xf4, 0x6d, 0x91, 0x1d, 0x2e, 0x6c, 0x30,
-	0xfd, 0x17, 0x9d, 0x95, 0x02, 0x88, 0x2e, 0xe3, 0x21, 0x21, 0x59, 0xde, 0x57, 0x78, 0xca, 0x99,
-	0xf8, 0x20, 0x9c, 0xee, 0x6a, 0xfa, 0x61, 0x08, 0xb8, 0xdf, 0x1f, 0x80, 0x21, 0xf9, 0x29, 0xd0,
-	0x83, 0xd0, 0x1f, 0x78, 0x2d, 0x29, 0xe7, 0x46, 0x44, 0x3f, 0xfa, 0xaf, 0x79, 0x2d, 0xba, 0xc2,
-	0xbd, 0x16, 0xa1, 0x18, 0x6d, 0x2f, 0x69, 0x30, 0x3a, 0x1a, 0xc6, 0x9a, 0x97, 0x34, 0x30, 0x2b,
-	0x41, 0xf7, 0x43, 0x7f, 0x2b, 0xac, 0x11, 0x36, 0x16, 0x45, 0x2e, 0x21, 0x56, 0xc2, 0x1a, 0xc1,
-	0x0c, 0x4a, 0xeb, 0x6f, 0x46, 0x61, 0xab, 0xdc, 0x6f, 0xd6, 0x9f, 0x8f, 0xc2, 0x16, 0x66, 0x25,
-	0xe8, 0xab, 0x0e, 0x8c, 0xcb, 0xb9, 0xbd, 0x1c, 0x56, 0xbd, 0xc4, 0x0f, 0x83, 0x72, 0x91, 0x49,
-	0x14, 0x6c, 0x6f, 0x49, 0x49, 0xca, 0x33, 0x65, 0xd1, 0x84, 0xf1, 0x6c, 0x09, 0xee, 0x6a, 0x05,
-	0xba, 0x0c, 0x50, 0x6f, 0x86, 0x1b, 0x5e, 0x93, 0x0e, 0x48, 0x79, 0x80, 0x75, 0x41, 0x49, 0x86,
-	0x05, 0x55, 0x82, 0x35, 0x2c, 0x74, 0x0b, 0x06, 0x3d, 0x2e, 0xfd, 0xcb, 0x83, 0xac, 0x13, 0xcf,
-	0xd8, 0xe8, 0x84, 0xb1, 0x9d, 0xcc, 0x94, 0xf6, 0x76, 0x27, 0x07, 0x05, 0x10, 0x4b, 0x76, 0xe8,
-	0x71, 0x18, 0x0a, 0xdb, 0xb4, 0xdd, 0x5e, 0xb3, 0x3c, 0xc4, 0x26, 0xe6, 0xb8, 0x68, 0xeb, 0xd0,
-	0xaa, 0x80, 0x63, 0x85, 0x81, 0x1e, 0x83, 0xc1, 0xb8, 0xb3, 0x41, 0xbf, 0x63, 0x79, 0x98, 0x75,
-	0xec, 0x94, 0x40, 0x1e, 0xac, 0x70, 0x30, 0x96, 0xe5, 0xe8, 0xbd, 0x50, 0x8a, 0x48, 0xb5, 0x13,
-	0xc5, 0x84, 0x7e, 0xd8, 0x32, 0x30, 0xda, 0x67, 0x04, 0x7a, 0x09, 0xa7, 0x45, 0x58, 0xc7, 0x43,
-	0x1f, 0x80, 0x31, 0xfa, 0x81, 0xaf, 0xdc, 0x6a, 0x47, 0x24, 0x8e, 0xe9, 0x57, 0x2d, 0x31, 0x46,
-	0xe7, 0x45, 0xcd, 0xb1, 0x79, 0xa3, 0x14, 0x67, 0xb0, 0xd1, 0xab, 0x00, 0x9e, 0x92, 0x19, 0xe5,
-	0x11, 0x36, 0x98, 0xcb, 0xf6, 0x66, 0xc4, 0xc2, 0xec, 0xcc, 0x18, 0xfd, 0x8e, 0xe9, 0x6f, 0xac,
-	0xf1, 0xa3, 0xe3, 0x53, 0x23, 0x4d, 0x92, 0x90, 0x5a, 0x79, 0x94, 0x75, 0x58, 0x8d, 0xcf, 0x1c,
-	0x07, 0x63, 0x59, 0xee, 0xfe, 0x46, 0x01, 0x34, 0x2a, 0x68, 0x06, 0x86, 0x84, 0x5c, 0x13, 0x4b,
-	0x72, 0xe6, 0x11, 0xf9, 0x1d, 0xe4, 0x17, 0xbc, 0xbd, 0x9b, 0x2b, 0x0f, 0x55, 0x3d, 0xf4, 0x1a,
-	0x94, 0xda, 0x61, 0x6d, 0x85, 0x24, 0x5e, 0xcd, 0x4b, 0x3c, 0xb1, 0x9b, 0x5b, 0xd8, 0x61, 0x24,
-	0xc5, 0x99, 0x53, 0xf4, 0xd3, 0xad, 0xa5, 0x2c, 0xb0, 0xce, 0x0f, 0x3d, 0x0d, 0x28, 0x26, 0xd1,
-	0xb6, 0x5f, 0x25, 0xd3, 0xd5, 0x2a, 0x55, 0x89, 0xd8, 0x02, 0xe8, 0x63, 0x9d, 0x99, 0x10, 0x9d,
-	0x41, 0x95, 0x2e, 0x0c, 0x9c, 0x53, 0xcb, 0xfd, 0x41, 0x01, 0xc6, 0xb4, 0xbe, 0xb6, 0x49, 0x15,
-	0x7d, 0xc7, 0x81, 0x53, 0x6a, 0x3b, 0x9b, 0xd9, 0xb9, 0x46, 0x67, 0x15, 0xdf, 0xac, 0x88, 0xcd,
-	0xef, 0x4b, 0x79, 0xa9, 0x9f, 0x82, 0x0f, 0x97, 0xf5, 0x17, 0x44, 0x1f, 0x4e, 0x65, 0x4a, 0x71,
-	0xb6, 0x59, 0x13, 0x5f, 0x71, 0xe0, 0x6c, 0x1e, 0x89, 0x1c, 0x99, 0xdb, 0xd0, 0x65, 0xae, 0x55,
-	0xe1, 0x45, 0xb9, 0xd2, 0xce, 0xe8, 0x72, 0xfc, 0xff, 0x17, 0x60, 0x5c, 0x9f, 0x42, 0x4c, 0x13,
-	0xf8, 0x57, 0x0e, 0x9c, 0x93, 0x3d, 0xc0, 0x24, 0xee, 0x34, 0x33, 0xc3, 0xdb, 0xb2, 0x3a, 0xbc,
-	0x7c, 0x27, 0x9d, 0xce, 0xe3, 0xc7, 0x87, 0xf9, 0x01, 0x31, 0xcc, 0xe7, 0x72, 0x71, 0x70, 0x7e,
-	0x53, 0x27, 0xbe, 0xe5, 0xc0, 0x44, 0x6f, 0xa2, 0x39, 0x03, 0xdf, 0x36, 0x07, 0xfe, 0x79, 0x7b,
-	0x9d, 0xe4, 0xec, 0xd9, 0xf0, 0xb3, 0xce, 0xea, 0x1f, 0xe0, 0xb7, 0x86, 0xa0, 0x6b, 0x0f, 0x41,
-	0x4f, 0x40, 0x49, 0x88, 0xe3, 0xe5, 0xb0, 0x1e, 0xb3, 0x46, 0x0e, 0xf1, 0xb5, 0x36, 0x9d, 0x82,
-	0xb1, 0x8e, 0x83, 0x6a, 0x50, 0x88, 0x9f, 0x14, 0x4d, 0xb7, 0x20, 0xde, 0x2a, 0x4f, 0x2a, 0x2d,
-	0x72, 0x60, 0x6f, 0x77, 0xb2, 0x50, 0x79, 0x12, 0x17, 0xe2, 0x27, 0xa9, 0xa6, 0x5e, 0xf7, 0x13,
-	0x7b, 0x9a, 0xfa, 0x82, 0x9f, 0x28, 0x3e, 0x4c, 0x53, 0x5f, 0xf0, 0x13, 0x4c, 0x59, 0xd0, 0x13,
-	0x48, 0x23, 0x49, 0xda, 0x6c, 0xc7, 0xb7, 0x72, 0x02, 0xb9, 0xba, 0xbe, 0xbe, 0xa6, 0x78, 0x31,
-	0xfd, 0x82, 0x42, 0x30, 0xe3, 0x82, 0xde, 0x70, 0xe8, 0x88, 0xf3, 0xc2, 0x30, 0xda, 0x11, 0x8a,
-	0xc3, 0x75, 0x7b, 0x53, 0x20, 0x8c, 0x76, 0x14, 0x73, 0xf1, 0x21, 0x55, 0x01, 0xd6, 0x59, 0xb3,
-	0x8e, 0xd7, 0x36, 0x63, 0xa6, 0x27, 0xd8, 0xe9, 0xf8, 0xdc, 0x7c, 0x25, 0xd3, 0xf1, 0xb9, 0xf9,
-	0x0a, 0x66, 0x5c, 0xe8, 0x07, 0x8d, 0xbc, 0x9b, 0x42, 0xc7, 0xb0, 0xf0, 0x41, 0xb1, 0x77, 0xd3,
-	0xfc, 0xa0, 0xd8, 0xbb, 0x89, 0x29, 0x0b, 0xca, 0x29, 0x8c, 0x63, 0xa6, 0x52, 0x58, 0xe1, 0xb4,
-	0x5a, 0xa9, 0x98, 0x9c, 0x56, 0x2b, 0x15, 0x4c, 0x59, 0xb0, 0x49, 0x5a, 0x8d, 0x99, 0x3e, 0x62,
-	0x67, 0x92, 0xce, 0x66, 0x38, 0x2d, 0xcc, 0x56, 0x30, 0x65, 0x41, 0x45, 0x86, 0xf7, 0x72, 0x27,
-	0xe2, 0xca, 0x4c, 0xe9, 0xf2, 0xaa, 0x85, 0xf9, 0x42, 0xc9, 0x29, 0x6e, 0xc3, 0x7b, 0xbb, 0x93,
-	0x45, 0x06, 0xc2, 0x9c, 0x91, 0xfb, 0xfb, 0x7d, 0xa9, 0xb8, 0x90, 0xf2, 0x1c, 0xfd, 0x2a, 0xdb,
-	0x08, 0x85, 0x2c, 0x10, 0xaa, 0xaf, 0x73, 0x6c, 0xaa, 0xef, 0x19, 0xbe, 0xe3, 0x19, 0xec, 0x70,
-	0x96, 0x3f, 0xfa, 0x92, 0xd3, 0x7d, 0xb6, 0xf5, 0xec, 0xef, 0x65, 0xe9, 0xc6, 0xcc, 0xf7, 0x8a,
-	0x7d, 0x8f, 0xbc, 0x13, 0x6f, 0x38, 0xa9, 0x12, 0x11, 0xf7, 0xda, 0x07, 0x3e, 0x66, 0xee, 0x03,
-	0x16, 0x0f, 0xe4, 0xba, 0xdc, 0xff, 0xbc, 0x03, 0xa3, 0x12, 0x4e, 0xd5, 0xe3, 0x18, 0xdd, 0x82,
-	0x21, 0xd9, 0x52, 0xf1, 0xf5, 0x6c, 0xda, 0x02, 0x94, 0x12, 0xaf, 0x1a, 0xa3, 0xb8, 0xb9, 0xdf,
-	0x19, 0x00, 0x94, 0xee, 0x55, 0xed, 0x30, 0xf6, 0x99, 0x24, 0x3a, 0xc2, 0x2e, 0x14, 0x68, 0xbb,
-	0xd0, 0xb3, 0x36, 0x77, 0xa1, 0xb4, 0x59, 0xc6, 0x7e, 0xf4, 0xa5, 0x8c, 0xdc, 0xe6, 0x1b, 0xd3,
-	0x47, 0x8f, 0x45, 0x6e, 0x6b, 0x4d, 0xd8, 0x5f, 0x82, 0x6f, 0x0b, 0x09, 0xce, 0xb7, 0xae, 0x5f,
-	0xb0, 0x2b, 0xc1, 0xb5, 0x56, 0x64, 0x65, 0x79, 0xc4, 0x25, 0x2c, 0xdf, 0xbb, 0x6e, 0x58, 0x95,
-	0xb0, 0x1a, 0x57, 0x53, 0xd6, 0x46, 0x5c, 0xd6, 0x0e, 0xd8, 0xe2, 0xa9, 0xc9, 0xda, 0x2c, 0x4f,
-	0x25, 0x75, 0x5f, 0x96, 0x52, 0x97, 0xef, 0x5a, 0xcf, 0x59, 0x96, 0xba, 0x1a, 0xdf, 0x6e, 0xf9,
-	0xfb, 0x12, 0x9c, 0xeb, 0xc6, 0xc3, 0x64, 0x13, 0x5d, 0x82, 0xe1, 0x6a, 0x18, 0x6c, 0xfa, 0xf5,
-	0x15, 0xaf, 0x2d, 0xce, 0x6b, 0x4a, 0x16, 0xcd, 0xca, 0x02, 0x9c, 0xe2, 0xa0, 0x07, 0xb8, 0xe0,
-	0xe1, 0x16, 0x91, 0x92, 0x40, 0xed, 0x5b, 0x22, 0x3b, 0x4c, 0x0a, 0xbd, 0x6f, 0xe8, 0xab, 0xdf,
-	0x98, 0xbc, 0xe7, 0x93, 0xff, 0xf1, 0xc1, 0x7b, 0xdc, 0x3f, 0xec, 0x83, 0xfb, 0x72, 0x79, 0x0a,
-	0x6d, 0xfd, 0xb7, 0x0c, 0x6d, 0x5d, 0x2b, 0x17, 0x52, 0xe4, 0x86, 0x4d, 0x45, 0x56, 0x23, 0x9f,
-	0xa7, 0x97, 0x6b, 0xc5, 0x38, 0xbf, 0x51, 0x74, 0xa0, 0x02, 0xaf, 0x45, 0xe2, 0xb6, 0x57, 0x25,
-	0xa2, 0xf7, 0x6a, 0xa0, 0xae, 0xc9, 0x02, 0x9c, 0xe2, 0xf0, 0x23, 0xf4, 0xa6, 0xd7, 0x69, 0x26,
-	0xc2, 0x50, 0xa6, 0x1d, 0xa1, 0x19, 0x18, 0xcb, 0x72, 0xf4, 0xf7, 0x1d, 0x40, 0xdd, 0x5c, 0xc5,
-	0x42, 0x5c, 0x3f, 0x8e, 0x71, 0x98, 0x39, 0xbf, 0xa7, 0x1d, 0xc2, 0xb5, 0x9e, 0xe6, 0xb4, 0x43,
-	0xfb, 0xa6, 0x1f, 0x4f, 0xf7, 0x21, 0x7e, 0x38, 0x38, 0x80, 0x0d, 0x8d, 0x99, 0x5a, 0xaa, 0x55,
-	0x12, 0xc7, 0xdc, 0x1c, 0xa7, 0x9b, 0x5a, 0x18, 0x18, 0xcb, 0x72, 0x34, 0x09, 0x45, 0x12, 0x45,
-	0x61, 0x24, 0xce, 0xda, 0x6c, 0x1a, 0x5f, 0xa1, 0x00, 0xcc, 0xe1, 0xee, 0x8f, 0x0b, 0x50, 0xee,
-	0x75, 0x3a, 0x41, 0xbf, 0xa3, 0x9d, 0xab, 0xc5, 0xc9, 0x49, 0x1c, 0xfc, 0xc2, 0xe3, 0x3b, 0x13,
-	0x65, 0x0f, 0x80, 0x3d, 0x4e, 0xd8, 0xa2, 0x14, 0x67, 0x1b, 0x38, 0xf1, 0xa6, 0x76, 0xc2, 0xd6,
-	0x49, 0xe4, 0x6c, 0xf0, 0x9b, 0xe6, 0x06, 0xbf, 0x66, 0xbb, 0x53, 0xfa, 0x36, 0xff, 0xc7, 0x45,
-	0x38, 0x23, 0x4b, 0x2b, 0x84, 0x6e, 0x95, 0xcf, 0x74, 0x48, 0xb4, 0x83, 0xfe, 0xc8, 0x81, 0xb3,
-	0x5e, 0xd6, 0x74, 0xe3, 0x93, 0x63, 0x18, 0x68, 0x8d, 0xeb, 0xd4, 0x74, 0x0e, 0x47, 0x3e, 0xd0,
-	0x97, 0xc5, 0x40, 0x9f, 0xcd, 0x43, 0xe9, 0x61, 0x77, 0xcf, 0xed, 0x00, 0x7a, 0x0a, 0x46, 0x24,
-	0x9c, 0x99, 0x7b, 0xf8, 0x12, 0x57, 0xc6, 0xed, 0x69, 0xad, 0x0c, 0x1b, 0x98, 0xb4, 0x66, 0x42,
-	0x5a, 0xed, 0xa6, 0x97, 0x10, 0xcd, 0x50, 0xa4, 0x6a, 0xae, 0x6b, 0x65, 0xd8, 0xc0, 0x44, 0x8f,
-	0xc0, 0x40, 0x10, 0xd6, 0xc8, 0x62, 0x4d, 0x18, 0x88, 0xc7, 0x44, 0x9d, 0x81, 0x6b, 0x0c, 0x8a,
-	0x45, 0x29, 0x7a, 0x38, 0xb5, 0xc6, 0x15, 0xd9, 0x12, 0x2a, 0xe5, 0x59, 0xe2, 0xd0, 0x3f, 0x72,
-	0x60, 0x98, 0xd6, 0x58, 0xdf, 0x69, 0x13, 0xba, 0xb7, 0xd1, 0x2f, 0x52, 0x3b, 0x9e, 0x2f, 0x72,
-	0x4d, 0xb2, 0x31, 0x4d, 0x1d, 0xc3, 0x0a, 0xfe, 0xfa, 0x5b, 0x93, 0x43, 0xf2, 0x07, 0x4e, 0x5b,
-	0x35, 0xb1, 0x00, 0xf7, 0xf6, 0xfc, 0x9a, 0x87, 0x72, 0x05, 0xfc, 0x2d, 0x18, 0x33, 0x1b, 0x71,
-	0x28, 0x3f, 0xc0, 0xbf, 0xd0, 0x96, 0x1d, 0xef, 0x97, 0x90, 0x67, 0x6f, 0x9b, 0x36, 0xab, 0x26,
-	0xc3, 0x9c, 0x98, 0x7a, 0xe6, 0x64, 0x98, 0x13, 0x93, 0x61, 0xce, 0xfd, 0x03, 0x27, 0x5d, 0x9a,
-	0x9a, 0x9a, 0x47, 0x37, 0xe6, 0x4e, 0xd4, 0x14, 0x82, 0x58, 0x6d, 0xcc, 0xd7, 0xf1, 0x32, 0xa6,
-	0x70, 0xf4, 0xa6, 0x26, 0x1d, 0x69, 0xb5, 0x8e, 0x70, 0x6b, 0x58, 0x32, 0xd1, 0x1b, 0x84, 0xbb,
-	0xe5, 0x9f, 0x28, 0xc0, 0xd9, 0x26, 0xb8, 0x5f, 0x2a, 0xc0, 0x03, 0xfb, 0x2a, 0xad, 0xb9, 0x0d,
-	0x77, 0xde, 0xf6, 0x86, 0xd3, 0x6d, 0x2d, 0x22, 0xed, 0xf0, 0x3a, 0x5e, 0x16, 0xdf, 0x4b, 0x6d,
-	0x6b, 0x98, 0x83, 0xb1, 0x2c, 0xa7, 0xaa, 0xc3, 0x16, 0xd9, 0x99, 0x0f, 0xa3, 0x96, 0x97, 0x08,
-	0xe9, 0xa0, 0x54, 0x87, 0x25, 0x59, 0x80, 0x53, 0x1c, 0xf7, 0x8f, 0x1c, 0xc8, 0x36, 0x00, 0x79,
-	0x30, 0xd6, 0x89, 0x49, 0x44, 0xb7, 0xd4, 0x0a, 0xa9, 0x46, 0x44, 0x4e, 0xcf, 0x87, 0xa7, 0xb8,
-	0xb7, 0x9f, 0xf6, 0x70, 0xaa, 0x1a, 0x46, 0x64, 0x6a, 0xfb, 0x89, 0x29, 0x8e, 0xb1, 0x44, 0x76,
-	0x2a, 0xa4, 0x49, 0x28, 0x8d, 0x19, 0xb4, 0xb7, 0x3b, 0x39, 0x76, 0xdd, 0x20, 0x80, 0x33, 0x04,
-	0x29, 0x8b, 0xb6, 0x17, 0xc7, 0x37, 0xc3, 0xa8, 0x26, 0x58, 0x14, 0x0e, 0xcd, 0x62, 0xcd, 0x20,
-	0x80, 0x33, 0x04, 0xdd, 0x1f, 0xd0, 0xe3, 0xa3, 0xae, 0xb5, 0xa2, 0x6f, 0x50, 0xdd, 0x87, 0x42,
-	0x66, 0x9a, 0xe1, 0xc6, 0x6c, 0x18, 0x24, 0x9e, 0x1f, 0x10, 0x19, 0x2c, 0xb0, 0x6e, 0x49, 0x47,
-	0x36, 0x68, 0xa7, 0x36, 0xfc, 0xee, 0x32, 0x9c, 0xd3, 0x16, 0xaa, 0xe3, 0x6c, 0x34, 0xc3, 0x8d,
-	0xac, 0x17, 0x90, 0x22, 0x61, 0x56, 0xe2, 0xfe, 0xd4, 0x81, 0x0b, 0x3d, 0x94, 0x71, 0xf4, 0x15,
-	0x07, 0x46, 0x37, 0x7e, 0x26, 0xfa, 0x66, 0x36, 0x03, 0x7d, 0x00, 0xc6, 0x28, 0x80, 0xee, 0x44,
-	0x62, 0x6e, 0x16, 0x4c, 0x0f, 0xd5, 0x8c, 0x51, 0x8a, 0x33, 0xd8, 0xee, 0xaf, 0x15, 0x20, 0x87,
-	0x0b, 0x7a, 0x1c, 0x86, 0x48, 0x50, 0x6b, 0x87, 0x7e, 0x90, 0x08, 0x61, 0xa4, 0xa4, 0xde, 0x15,
-	0x01, 0xc7, 0x0a, 0x43, 0x9c, 0x3f, 0xc4, 0xc0, 0x14, 0xba, 0xce, 0x1f, 0xa2, 0xe5, 0x29, 0x0e,
-	0xaa, 0xc3, 0xb8, 0xc7, 0xfd, 0x2b, 0x6c, 0xee, 0xb1, 0x69, 0xda, 0x77, 0x98, 0x69, 0x7a, 0x96,
-	0xb9, 0x3f, 0x33, 0x24, 0x70, 0x17, 0x51, 0xf4, 0x5e, 0x28, 0x75, 0x62, 0x52, 0x99, 0x5b, 0x9a,
-	0x8d, 0x48, 0x8d, 0x9f, 0x8a, 0x35, 0xbf, 0xdf, 0xf5, 0xb4, 0x08, 0xeb, 0x78, 0xee, 0x9f, 0x38,
-	0x30, 0x38, 0xe3, 0x55, 0xb7, 0xc2, 0xcd, 0x4d, 0x3a, 0x14, 0xb5, 0x4e, 0x94, 0x1a, 0xb6, 0xb4,
-	0xa1, 0x98, 0x13, 0x70, 0xac, 0x30, 0xd0, 0x3a, 0x0c, 0xf0, 0x05, 0x2f, 0x96, 0xdd, 0xbb, 0xb5,
-	0xfe, 0xa8, 0x38, 0x1e, 0x36, 0x1d, 0x3a, 0x89, 0xdf, 0x9c, 0xe2, 0x71, 0x3c, 0x53, 0x8b, 0x41,
-	0xb2, 0x1a, 0x55, 0x92, 0xc8, 0x0f, 0xea, 0x33, 0x40, 0xb7, 0x8b, 0x79, 0x46, 0x03, 0x0b, 0x5a,
-	0xb4, 0x1b, 0x2d, 0xef, 0x96, 0x64, 0x27, 0xc4, 0x8f, 0xea, 0xc6, 0x4a, 0x5a, 0x84, 0x75, 0x3c,
-	0xba, 0x9b, 0x54, 0xbd, 0xb6, 0xd0, 0x4b, 0xd4, 0x6e, 0x32, 0xeb, 0xb5, 0x31, 0x85, 0xbb, 0x7f,
-	0xe8, 0xc0, 0xf0, 0x8c, 0x17, 0xfb, 0xd5, 0xbf, 0x44, 0xb2, 0xe9, 0x23, 0x50, 0x9c, 0xf5, 0xaa,
-	0x0d, 0x82, 0xae, 0x67, 0xcf, 0xc4, 0xa5, 0xcb, 0x8f, 0xe6, 0xb1, 0x51, 0xe7, 0x63, 0x9d, 0xd3,
-	0x68, 0xaf, 0x93, 0xb3, 0xfb, 0x96, 0x03, 0x63, 0xb3, 0x4d, 0x9f, 0x04, 0xc9, 0x2c, 0x89, 0x12,
-	0x36, 0x70, 0x75, 0x18, 0xaf, 0x2a, 0xc8, 0x51, 0x86, 0x8e, 0x4d, 0xe6, 0xd9, 0x0c, 0x09, 0xdc,
-	0x45, 0x14, 0xd5, 0xe0, 0x14, 0x87, 0xa5, 0x8b, 0xe6, 0x50, 0xe3, 0xc7, 0x8c, 0xa7, 0xb3, 0x26,
-	0x05, 0x9c, 0x25, 0xe9, 0xfe, 0xc4, 0x81, 0x0b, 0xb3, 0xcd, 0x4e, 0x9c, 0x90, 0xe8, 0x86, 0x10,
-	0x56, 0x52, 0xfb, 0x45, 0x1f, 0x83, 0xa1, 0x96, 0x74, 0xe8, 0x3a, 0x77, 0x98, 0xdf, 0x4c, 0xdc,
-	0x51, 0x6c, 0xda, 0x98, 0xd5, 0x8d, 0x17, 0x49, 0x35, 0x59, 0x21, 0x89, 0x97, 0x46, 0x1f, 0xa4,
-	0x30, 0xac, 0xa8, 0xa2, 0x36, 0xf4, 0xc7, 0x6d, 0x52, 0xb5, 0x17, 0xfc, 0x25, 0xfb, 0x50, 0x69,
-	0x93, 0x6a, 0x2a, 0xf6, 0x99, 0x2b, 0x92, 0x71, 0x72, 0xff, 0x8f, 0x03, 0xf7, 0xf5, 0xe8, 0xef,
-	0xb2, 0x1f, 0x27, 0xe8, 0x85, 0xae, 0x3e, 0x4f, 0x1d, 0xac, 0xcf, 0xb4, 0x36, 0xeb, 0xb1, 0x92,
-	0x17, 0x12, 0xa2, 0xf5, 0xf7, 0xe3, 0x50, 0xf4, 0x13, 0xd2, 0x92, 0x56, 0x6a, 0x0b, 0xf6, 0xa4,
-	0x1e, 0x7d, 0x99, 0x19, 0x95, 0x21, 0x80, 0x8b, 0x94, 0x1f, 0xe6, 0x6c, 0xdd, 0x2d, 0x18, 0x98,
-	0x0d, 0x9b, 0x9d, 0x56, 0x70, 0xb0, 0x40, 0x9a, 0x64, 0xa7, 0x4d, 0xb2, 0x5b, 0x28, 0x3b, 0x1d,
-	0xb0, 0x12, 0x69, 0x57, 0xea, 0xcb, 0xb7, 0x2b, 0xb9, 0xff, 0xda, 0x01, 0xba, 0xaa, 0x6a, 0xbe,
-	0x70, 0x34, 0x72, 0x72, 0x9c, 0xe1, 0x03, 0x3a, 0xb9, 0xdb, 0xbb, 0x93, 0xa3, 0x0a, 0x51, 0xa3,
-	0xff, 0x11, 0x18, 0x88, 0xd9, 0x89, 0x5d, 0xb4, 0x61, 0x5e, 0xaa, 0xd7, 0xfc, 0x1c, 0x7f, 0x7b,
-	0x77, 0xf2, 0x40, 0x51, 0x9d, 0x53, 0x8a, 0xb6, 0xf0, 0x89, 0x0a, 0xaa, 0x54, 0x1f, 0x6c, 0x91,
-	0x38, 0xf6, 0xea, 0xf2, 0x00, 0xa8, 0xf4, 0xc1, 0x15, 0x0e, 0xc6, 0xb2, 0xdc, 0xfd, 0xb2, 0x03,
-	0xa3, 0x6a, 0x6f, 0xa3, 0xda, 0x3d, 0xba, 0xa6, 0xef, 0x82, 0x7c, 0xa6, 0x3c, 0xd0, 0x43, 0xe2,
-	0x88, 0x7d, 0x7e, 0xff, 0x4d, 0xf2, 0x3d, 0x30, 0x52, 0x23, 0x6d, 0x12, 0xd4, 0x48, 0x50, 0xa5,
-	0xa7, 0x73, 0x3a, 0x43, 0x86, 0x67, 0xc6, 0xe9, 0x71, 0x74, 0x4e, 0x83, 0x63, 0x03, 0xcb, 0xfd,
-	0xa6, 0x03, 0xf7, 0x2a, 0x72, 0x15, 0x92, 0x60, 0x92, 0x44, 0x3b, 0x2a, 0x8a, 0xf3, 0x70, 0x9b,
-	0xd9, 0x0d, 0xaa, 0x1e, 0x27, 0x11, 0x67, 0x7e, 0xb4, 0xdd, 0xac, 0xc4, 0x95, 0x69, 0x46, 0x04,
-	0x4b, 0x6a, 0xee, 0xaf, 0xf4, 0xc1, 0x59, 0xbd, 0x91, 0x4a, 0xc0, 0x7c, 0xca, 0x01, 0x50, 0x23,
-	0x40, 0xf7, 0xeb, 0x3e, 0x3b, 0xae, 0x2d, 0xe3, 0x4b, 0xa5, 0x22, 0x48, 0x81, 0x63, 0xac, 0xb1,
-	0x45, 0xcf, 0xc1, 0xc8, 0x36, 0x5d, 0x14, 0x64, 0x85, 0x6a, 0x13, 0x71, 0xb9, 0x8f, 0x35, 0x63,
-	0x32, 0xef, 0x63, 0x3e, 0x9b, 0xe2, 0xa5, 0xd6, 0x02, 0x0d, 0x18, 0x63, 0x83, 0x14, 0x3d, 0x08,
-	0x8d, 0x46, 0xfa, 0x27, 0x11, 0x26, 0xf3, 0x0f, 0x5b, 0xec, 0x63, 0xf6, 0xab, 0xcf, 0x9c, 0xde,
-	0xdb, 0x9d, 0x1c, 0x35, 0x40, 0xd8, 0x6c, 0x84, 0xfb, 0x1c, 0xb0, 0xb1, 0xf0, 0x83, 0x0e, 0x59,
-	0x0d, 0xd0, 0x43, 0xd2, 0x84, 0xc7, 0xdd, 0x2e, 0x4a, 0x72, 0xe8, 0x66, 0x3c, 0x7a, 0xd4, 0xdd,
-	0xf4, 0xfc, 0x26, 0x8b, 0x6e, 0xa4, 0x58, 0xea, 0xa8, 0x3b, 0xcf, 0xa0, 0x58, 0x94, 0xba, 0x53,
-	0x30, 0x38, 0x4b, 0xfb, 0x4e, 0x22, 0x4a, 0x57, 0x0f, 0x4a, 0x1e, 0x35, 0x82, 0x92, 0x65, 0xf0,
-	0xf1, 0x3a, 0x9c, 0x9b, 0x8d, 0x88, 0x97, 0x90, 0xca, 0x93, 0x33, 0x9d, 0xea, 0x16, 0x49, 0x78,
-	0xe4, 0x57, 0x8c, 0xde, 0x0f, 0xa3, 0x21, 0xdb, 0x32, 0x96, 0xc3, 0xea, 0x96, 0x1f, 0xd4, 0x85,
-	0x45, 0xf6, 0x9c, 0xa0, 0x32, 0xba, 0xaa, 0x17, 0x62, 0x13, 0xd7, 0xfd, 0xcf, 0x05, 0x18, 0x99,
-	0x8d, 0xc2, 0x40, 0x8a, 0xc5, 0x13, 0xd8, 0xca, 0x12, 0x63, 0x2b, 0xb3, 0xe0, 0x0d, 0xd5, 0xdb,
-	0xdf, 0x6b, 0x3b, 0x43, 0xaf, 0x2a, 0x11, 0xd9, 0x67, 0xeb, 0x84, 0x62, 0xf0, 0x65, 0xb4, 0xd3,
-	0x8f, 0x6d, 0x0a, 0x50, 0xf7, 0xbf, 0x38, 0x30, 0xae, 0xa3, 0x9f, 0xc0, 0x0e, 0x1a, 0x9b, 0x3b,
-	0xe8, 0x35, 0xbb, 0xfd, 0xed, 0xb1, 0x6d, 0xbe, 0x35, 0x68, 0xf6, 0x93, 0xb9, 0xc2, 0xbf, 0xea,
-	0xc0, 0xc8, 0x4d, 0x0d, 0x20, 0x3a, 0x6b, 0x5b, 0x89, 0x79, 0x87, 0x14, 0x33, 0x3a, 0xf4, 0x76,
-	0xe6, 0x37, 0x36, 0x5a, 0x42, 0xe5, 0x7e, 0x5c, 0x6d, 0x90, 0x5a, 0xa7, 0x29, 0xb7, 0x6f, 0x35,
-	0xa4, 0x15, 0x01, 0xc7, 0x0a, 0x03, 0xbd, 0x00, 0xa7, 0xab, 0x61, 0x50, 0xed, 0x44, 0x11, 0x09,
-	0xaa, 0x3b, 0x6b, 0xec, 0x0a, 0x85, 0xd8, 0x10, 0xa7, 0x44, 0xb5, 0xd3, 0xb3, 0x59, 0x84, 0xdb,
-	0x79, 0x40, 0xdc, 0x4d, 0x88, 0xfb, 0x12, 0x62, 0xba, 0x65, 0x89, 0xf3, 0x98, 0xe6, 0x4b, 0x60,
-	0x60, 0x2c, 0xcb, 0xd1, 0x75, 0xb8, 0x10, 0x27, 0x5e, 0x94, 0xf8, 0x41, 0x7d, 0x8e, 0x78, 0xb5,
-	0xa6, 0x1f, 0xd0, 0xa3, 0x44, 0x18, 0xd4, 0xb8, 0xa7, 0xb1, 0x6f, 0xe6, 0xbe, 0xbd, 0xdd, 0xc9,
-	0x0b, 0x95, 0x7c, 0x14, 0xdc, 0xab, 0x2e, 0xfa, 0x08, 0x4c, 0x08, 0x6f, 0xc5, 0x66, 0xa7, 0xf9,
-	0x74, 0xb8, 0x11, 0x5f, 0xf5, 0x63, 0x7a, 0xcc, 0x5f, 0xf6, 0x5b, 0x7e, 0xc2, 0xfc, 0x89, 0xc5,
-	0x99, 0x8b, 0x7b, 0xbb, 0x93, 0x13, 0x95, 0x9e, 0x58, 0x78, 0x1f, 0x0a, 0x08, 0xc3, 0x79, 0x2e,
-	0xfc, 0xba, 0x68, 0x0f, 0x32, 0xda, 0x13, 0x7b, 0xbb, 0x93, 0xe7, 0xe7, 0x73, 0x31, 0x70, 0x8f,
-	0x9a, 0xf4, 0x0b, 0x26, 0x7e, 0x8b, 0xbc, 0x1c, 0x06, 0x84, 0xc5, 0xb1, 0x68, 0x5f, 0x70, 0x5d,
-	0xc0, 0xb1, 0xc2, 0x40, 0x2f, 0xa6, 0x33, 0x91, 0x2e, 0x17, 0x11, 0x8f, 0x72, 0x78, 0x09, 0xc7,
-	0x8e, 0x26, 0x37, 0x34, 0x4a, 0x2c, 0xd0, 0xd2, 0xa0, 0x8d, 0x3e, 0xed, 0xc0, 0x48, 0x9c, 0x84,
-	0xea, 0xda, 0x83, 0x08, 0x48, 0xb1, 0x30, 0xed, 0x2b, 0x1a, 0x55, 0xae, 0xf8, 0xe8, 0x10, 0x6c,
-	0x70, 0x45, 0xef, 0x84, 0x61, 0x39, 0x81, 0xe3, 0x72, 0x89, 0xe9, 0x4a, 0xec, 0x18, 0x27, 0xe7,
-	0x77, 0x8c, 0xd3, 0x72, 0xaa, 0xca, 0xde, 0x6c, 0x90, 0x80, 0x85, 0xe4, 0x6a, 0xaa, 0xec, 0x8d,
-	0x06, 0x09, 0x30, 0x2b, 0x71, 0x7f, 0xdc, 0x07, 0xa8, 0x5b, 0xf0, 0xa1, 0x25, 0x18, 0xf0, 0xaa,
-	0x89, 0xbf, 0x2d, 0xc3, 0x11, 0x1f, 0xca, 0x53, 0x0a, 0xf8, 0x00, 0x62, 0xb2, 0x49, 0xe8, 0xbc,
-	0x27, 0xa9, 0xb4, 0x9c, 0x66, 0x55, 0xb1, 0x20, 0x81, 0x42, 0x38, 0xdd, 0xf4, 0xe2, 0x44, 0xb6,
-	0xb0, 0x46, 0x3f, 0xa4, 0xd8, 0x2e, 0x7e, 0xfe, 0x60, 0x9f, 0x8a, 0xd6, 0x98, 0x39, 0x47, 0xd7,
-	0xe3, 0x72, 0x96, 0x10, 0xee, 0xa6, 0x8d, 0x3e, 0xc1, 0xb4, 0x2b, 0xae, 0xfa, 0x4a, 0xb5, 0x66,
-	0xc9, 0x8a, 0xe6, 0xc1, 0x69, 0x1a, 0x9a, 0x95, 0x60, 0x83, 0x35, 0x96, 0xe8, 0x12, 0x0c, 0xb3,
-	0x75, 0x43, 0x6a, 0x84, 0xaf, 0xfe, 0xbe, 0x54, 0x09, 0xae, 0xc8, 0x02, 0x9c, 0xe2, 0x68, 0x5a,
-	0x06, 0x5f, 0xf0, 0x3d, 0xb4, 0x0c, 0xf4, 0x14, 0x14, 0xdb, 0x0d, 0x2f, 0x96, 0x21, 0xee, 0xae,
-	0x94, 0xda, 0x6b, 0x14, 0xc8, 0x44, 0x93, 0xf6, 0x2d, 0x19, 0x10, 0xf3, 0x0a, 0xee, 0xbf, 0x01,
-	0x18, 0x9c, 0x9b, 0x5e, 0x58, 0xf7, 0xe2, 0xad, 0x03, 0x9c, 0x81, 0xe8, 0x32, 0x14, 0xca, 0x6a,
-	0x56, 0x90, 0x4a, 0x25, 0x16, 0x2b, 0x0c, 0x14, 0xc0, 0x80, 0x1f, 0x50, 0xc9, 0x53, 0x1e, 0xb3,
-	0xe5, 0x86, 0x50, 0xe7, 0x39, 0x66, 0x27, 0x5a, 0x64, 0xd4, 0xb1, 0xe0, 0x82, 0x5e, 0x85, 0x61,
-	0x4f, 0xde, 0x30, 0x12, 0xfb, 0xff, 0x92, 0x0d, 0xfb, 0xba, 0x20, 0xa9, 0x47, 0x38, 0x09, 0x10,
-	0x4e, 0x19, 0xa2, 0x4f, 0x3a, 0x50, 0x92, 0x5d, 0xc7, 0x64, 0x53, 0xb8, 0xbe, 0x57, 0xec, 0xf5,
-	0x19, 0x93, 0x4d, 0x1e, 0xfe, 0xa2, 0x01, 0xb0, 0xce, 0xb2, 0xeb, 0xcc, 0x54, 0x3c, 0xc8, 0x99,
-	0x09, 0xdd, 0x84, 0xe1, 0x9b, 0x7e, 0xd2, 0x60, 0x3b, 0xbc, 0x70, 0xb9, 0xcd, 0xdf, 0x7d, 0xab,
-	0x29, 0xb9, 0x74, 0xc4, 0x6e, 0x48, 0x06, 0x38, 0xe5, 0x45, 0x97, 0x03, 0xfd, 0xc1, 0x6e, 0x68,
-	0xb1, 0xbd, 0x61, 0xd8, 0xac, 0xc0, 0x0a, 0x70, 0x8a, 0x43, 0x87, 0x78, 0x84, 0xfe, 0xaa, 0x90,
-	0x97, 0x3a, 0x54, 0xb4, 0x88, 0x90, 0x46, 0x0b, 0xf3, 0x4a, 0x52, 0xe4, 0x83, 0x75, 0x43, 0xe3,
-	0x81, 0x0d, 0x8e, 0x4a, 0x74, 0x0e, 0xf7, 0x12, 0x9d, 0xe8, 0x55, 0x7e, 0x86, 0xe3, 0x87, 0x09,
-	0xb1, 0x1b, 0x2c, 0xdb, 0x39, 0xdf, 0x70, 0x9a, 0xfc, 0xd6, 0x43, 0xfa, 0x1b, 0x6b, 0xfc, 0xa8,
-	0xc4, 0x08, 0x83, 0x2b, 0xb7, 0xfc, 0x44, 0xdc, 0xd5, 0x50, 0x12, 0x63, 0x95, 0x41, 0xb1, 0x28,
-	0xe5, 0xa1, 0x1d, 0x74, 0x12, 0xc4, 0x62, 0x17, 0xd0, 0x42, 0x3b, 0x18, 0x18, 0xcb, 0x72, 0xf4,
-	0x0f, 0x1c, 0x28, 0x36, 0xc2, 0x70, 0x2b, 0x2e, 0x8f, 0xb2, 0xc9, 0x61, 0x41, 0xa7, 0x16, 0x12,
-	0x67, 0xea, 0x2a, 0x25, 0x6b, 0xde, 0x3e, 0x2b, 0x32, 0xd8, 0xed, 0xdd, 0xc9, 0xb1, 0x65, 0x7f,
-	0x93, 0x54, 0x77, 0xaa, 0x4d, 0xc2, 0x20, 0xaf, 0xbf, 0xa5, 0x41, 0xae, 0x6c, 0x93, 0x20, 0xc1,
-	0xbc, 0x55, 0x13, 0x9f, 0x77, 0x00, 0x52, 0x42, 0x39, 0x3e, 0x54, 0x62, 0x46, 0x1d, 0x58, 0x38,
-	0x50, 0x1b, 0x4d, 0xd3, 0x9d, 0xb2, 0xff, 0xce, 0x81, 0x12, 0xed, 0x9c, 0x14, 0x81, 0x8f, 0xc0,
-	0x40, 0xe2, 0x45, 0x75, 0x22, 0xfd, 0x08, 0xea, 0x73, 0xac, 0x33, 0x28, 0x16, 0xa5, 0x28, 0x80,
-	0x62, 0xe2, 0xc5, 0x5b, 0x52, 0x8d, 0x5f, 0xb4, 0x36, 0xc4, 0xa9, 0x06, 0x4f, 0x7f, 0xc5, 0x98,
-	0xb3, 0x41, 0x8f, 0xc2, 0x10, 0xdd, 0x3a, 0xe6, 0xbd, 0x58, 0x86, 0xf6, 0x8c, 0x50, 0x21, 0x3e,
-	0x2f, 0x60, 0x58, 0x95, 0xba, 0xbf, 0x56, 0x80, 0xfe, 0x39, 0x7e, 0xa0, 0x1b, 0x88, 0xc3, 0x4e,
-	0x54, 0x25, 0x42, 0xb1, 0xb7, 0x30, 0xa7, 0x29, 0xdd, 0x0a, 0xa3, 0xa9, 0x1d, 0xa9, 0xd8, 0x6f,
-	0x2c, 0x78, 0xa1, 0x37, 0x1d, 0x18, 0x4b, 0x22, 0x2f, 0x88, 0x37, 0x99, 0xc7, 0xc6, 0x0f, 0x03,
-	0x31, 0x44, 0x16, 0x66, 0xe1, 0xba, 0x41, 0xb7, 0x92, 0x90, 0x76, 0xea, 0x38, 0x32, 0xcb, 0x70,
-	0xa6, 0x0d, 0xee, 0xaf, 0x3b, 0x00, 0x69, 0xeb, 0xd1, 0x1b, 0x0e, 0x8c, 0x7a, 0x7a, 0x48, 0xa9,
-	0x18, 0xa3, 0x55, 0x7b, 0xee, 0x5d, 0x46, 0x96, 0xdb, 0x32, 0x0c, 0x10, 0x36, 0x19, 0xbb, 0xef,
-	0x85, 0x22, 0x5b, 0x1d, 0xec, 0xd0, 0x23, 0x6c, 0xdf, 0x59, 0x63, 0x97, 0xb4, 0x89, 0x63, 0x85,
-	0xe1, 0xbe, 0x00, 0x63, 0x57, 0x6e, 0x91, 0x6a, 0x27, 0x09, 0x23, 0x6e, 0xf9, 0xef, 0x71, 0x85,
-	0xc8, 0x39, 0xd2, 0x15, 0xa2, 0xef, 0x3a, 0x50, 0xd2, 0xe2, 0x0b, 0xe9, 0x4e, 0x5d, 0x9f, 0xad,
-	0x70, 0x03, 0x87, 0x18, 0xaa, 0x25, 0x2b, 0x11, 0x8c, 0x9c, 0x64, 0xba, 0x8d, 0x28, 0x10, 0x4e,
-	0x19, 0xde, 0x21, 0xfe, 0xcf, 0xfd, 0x7d, 0x07, 0xce, 0xe5, 0x06, 0x43, 0xbe, 0xcd, 0xcd, 0x36,
-	0x7c, 0xf0, 0x85, 0x03, 0xf8, 0xe0, 0x7f, 0xdb, 0x81, 0x94, 0x12, 0x15, 0x45, 0x1b, 0x69, 0xcb,
-	0x35, 0x51, 0x24, 0x38, 0x89, 0x52, 0xf4, 0x2a, 0x5c, 0x30, 0xbf, 0xe0, 0x11, 0xfd, 0x2d, 0xfc,
-	0x70, 0x9a, 0x4f, 0x09, 0xf7, 0x62, 0xe1, 0x7e, 0xcd, 0x81, 0xe2, 0x82, 0xd7, 0xa9, 0x93, 0x03,
-	0x99, 0xcb, 0xa8, 0x1c, 0x8b, 0x88, 0xd7, 0x4c, 0xe4, 0xd1, 0x41, 0xc8, 0x31, 0x2c, 0x60, 0x58,
-	0x95, 0xa2, 0x69, 0x18, 0x0e, 0xdb, 0xc4, 0x70, 0x21, 0x3e, 0x24, 0x47, 0x6f, 0x55, 0x16, 0xd0,
-	0x6d, 0x87, 0x71, 0x57, 0x10, 0x9c, 0xd6, 0x72, 0xbf, 0x3e, 0x00, 0x25, 0xed, 0xda, 0x0c, 0xd5,
-	0x05, 0x22, 0xd2, 0x0e, 0xb3, 0xfa, 0x32, 0x9d, 0x30, 0x98, 0x95, 0xd0, 0x35, 0x18, 0x91, 0x6d,
-	0x3f, 0xe6, 0x62, 0xcb, 0x58, 0x83, 0x58, 0xc0, 0xb1, 0xc2, 0x40, 0x93, 0x50, 0xac, 0x91, 0x76,
-	0xd2, 0x60, 0xcd, 0xeb, 0xe7, 0xb1, 0x83, 0x73, 0x14, 0x80, 0x39, 0x9c, 0x22, 0x6c, 0x92, 0xa4,
-	0xda, 0x60, 0x96, 0x61, 0x11, 0x5c, 0x38, 0x4f, 0x01, 0x98, 0xc3, 0x73, 0xbc, 0x98, 0xc5, 0xe3,
-	0xf7, 0x62, 0x0e, 0x58, 0xf6, 0x62, 0xa2, 0x36, 0x9c, 0x89, 0xe3, 0xc6, 0x5a, 0xe4, 0x6f, 0x7b,
-	0x09, 0x49, 0x67, 0xdf, 0xe0, 0x61, 0xf8, 0x5c, 0x60, 0x17, 0xd9, 0x2b, 0x57, 0xb3, 0x54, 0x70,
-	0x1e, 0x69, 0x54, 0x81, 0x73, 0x7e, 0x10, 0x93, 0x6a, 0x27, 0x22, 0x8b, 0xf5, 0x20, 0x8c, 0xc8,
-	0xd5, 0x30, 0xa6, 0xe4, 0xc4, 0x35, 0x5c, 0x15, 0x6e, 0xbb, 0x98, 0x87, 0x84, 0xf3, 0xeb, 0xa2,
-	0x05, 0x38, 0x5d, 0xf3, 0x63, 0x6f, 0xa3, 0x49, 0x2a, 0x9d, 0x8d, 0x56, 0xc8, 0x8f, 0xe6, 0xc3,
-	0x8c, 0xe0, 0xbd, 0xd2, 0x8e, 0x34, 0x97, 0x45, 0xc0, 0xdd, 0x75, 0xd0, 0x53, 0x30, 0x12, 0xfb,
-	0x41, 0xbd, 0x49, 0x66, 0x22, 0x2f, 0xa8, 0x36, 0xc4, 0xfd, 0x5d, 0x65, 0x6f, 0xaf, 0x68, 0x65,
-	0xd8, 0xc0, 0x64, 0x6b, 0x9e, 0xd7, 0xc9, 0x68, 0x83, 0x02, 0x5b, 0x94, 0xa2, 0x69, 0x38, 0x25,
-	0xfb, 0x50, 0xd9, 0xf2, 0xdb, 0xeb, 0xcb, 0x15, 0xa6, 0x15, 0x0e, 0xa5, 0xc1, 0x44, 0x8b, 0x66,
-	0x31, 0xce, 0xe2, 0xbb, 0x3f, 0x74, 0x60, 0x44, 0x8f, 0x96, 0xa7, 0xca, 0x3a, 0x34, 0xe6, 0xe6,
-	0x2b, 0x7c, 0x3b, 0xb1, 0xa7, 0x34, 0x5c, 0x55, 0x34, 0xd3, 0xf3, 0x76, 0x0a, 0xc3, 0x1a, 0xcf,
-	0x03, 0xdc, 0x7d, 0x7f, 0x08, 0x8a, 0x9b, 0x21, 0xd5, 0x69, 0xfa, 0x4c, 0x5b, 0xff, 0x3c, 0x05,
-	0x62, 0x5e, 0xe6, 0xfe, 0x0f, 0x07, 0xce, 0xe7, 0x5f, 0x04, 0xf8, 0x59, 0xe8, 0xe4, 0x65, 0x00,
-	0xda, 0x15, 0x63, 0x5f, 0xd0, 0xb2, 0x5f, 0xc8, 0x12, 0xac, 0x61, 0x1d, 0xac, 0xdb, 0xff, 0xb6,
-	0x00, 0x1a, 0x4f, 0xf4, 0x05, 0x07, 0x46, 0x29, 0xdb, 0xa5, 0x68, 0xc3, 0xe8, 0xed, 0xaa, 0x9d,
-	0xde, 0x2a, 0xb2, 0xa9, 0x4b, 0xc3, 0x00, 0x63, 0x93, 0x39, 0x7a, 0x27, 0x0c, 0x7b, 0xb5, 0x5a,
-	0x44, 0xe2, 0x58, 0x39, 0x07, 0x99, 0xc1, 0x6b, 0x5a, 0x02, 0x71, 0x5a, 0x4e, 0xe5, 0x70, 0xa3,
-	0xb6, 0x19, 0x53, 0xd1, 0x26, 0x64, 0xbf, 0x92, 0xc3, 0x94, 0x09, 0x85, 0x63, 0x85, 0x81, 0x9e,
-	0x85, 0xf3, 0x35, 0x2f, 0xf1, 0xb8, 0x0a, 0x48, 0xa2, 0xb5, 0x28, 0x4c, 0x48, 0x95, 0xed, 0x1b,
-	0x3c, 0x96, 0xe4, 0xa2, 0xa8, 0x7b, 0x7e, 0x2e, 0x17, 0x0b, 0xf7, 0xa8, 0xed, 0xfe, 0x72, 0x3f,
-	0x98, 0x7d, 0x42, 0x35, 0x38, 0xb5, 0x15, 0x6d, 0xcc, 0xb2, 0x98, 0x8d, 0xa3, 0xc4, 0x4e, 0xb0,
-	0x98, 0x86, 0x25, 0x93, 0x02, 0xce, 0x92, 0x14, 0x5c, 0x96, 0xc8, 0x4e, 0xe2, 0x6d, 0x1c, 0x39,
-	0x72, 0x62, 0xc9, 0xa4, 0x80, 0xb3, 0x24, 0xd1, 0x7b, 0xa1, 0xb4, 0x15, 0x6d, 0xc8, 0xdd, 0x23,
-	0x1b, 0xa5, 0xb3, 0x94, 0x16, 0x61, 0x1d, 0x8f, 0x7e, 0x9a, 0xad, 0x68, 0x83, 0x6e, 0xd8, 0x32,
-	0xc7, 0x84, 0xfa, 0x34, 0x4b, 0x02, 0x8e, 0x15, 0x06, 0x6a, 0x03, 0xda, 0x92, 0xa3, 0xa7, 0x22,
-	0x54, 0xc4, 0x26, 0x77, 0xf0, 0x00, 0x17, 0x76, 0x73, 0x60, 0xa9, 0x8b, 0x0e, 0xce, 0xa1, 0x8d,
-	0x9e, 0x83, 0x0b, 0x5b, 0xd1, 0x86, 0xd0, 0x63, 0xd6, 0x22, 0x3f, 0xa8, 0xfa, 0x6d, 0x23, 0x9f,
-	0xc4, 0xa4, 0x68, 0xee, 0x85, 0xa5, 0x7c, 0x34, 0xdc, 0xab, 0xbe, 0xfb, 0x3b, 0xfd, 0xc0, 0x6e,
-	0xc2, 0x52, 0x31, 0xdd, 0x22, 0x49, 0x23, 0xac, 0x65, 0x55, 0xb3, 0x15, 0x06, 0xc5, 0xa2, 0x54,
-	0xc6, 0xc7, 0x16, 0x7a, 0xc4, 0xc7, 0xde, 0x84, 0xc1, 0x06, 0xf1, 0x6a, 0x24, 0x92, 0xc6, 0xcd,
-	0x65, 0x3b, 0x77, 0x77, 0xaf, 0x32, 0xa2, 0xa9, 0x85, 0x80, 0xff, 0x8e, 0xb1, 0xe4, 0x86, 0xde,
-	0x07, 0x63, 0x54, 0xc7, 0x0a, 0x3b, 0x89, 0xf4, 0x4f, 0x70, 0xe3, 0x26, 0xdb, 0xec, 0xd7, 0x8d,
-	0x12, 0x9c, 0xc1, 0x44, 0x73, 0x30, 0x2e, 0x7c, 0x09, 0xca, 0x68, 0x2a, 0x06, 0x56, 0x25, 0xfa,
-	0xa8, 0x64, 0xca, 0x71, 0x57, 0x0d, 0x16, 0xdf, 0x18, 0xd6, 0xb8, 0x3b, 0x59, 0x8f, 0x6f, 0x0c,
-	0x6b, 0x3b, 0x98, 0x95, 0xa0, 0x97, 0x61, 0x88, 0xfe, 0x9d, 0x8f, 0xc2, 0x96, 0x30, 0x1b, 0xad,
-	0xd9, 0x19, 0x1d, 0xca, 0x43, 0x1c, 0x62, 0x99, 0xee, 0x39, 0x23, 0xb8, 0x60, 0xc5, 0x8f, 0x1e,
-	0xa5, 0xf4, 0xed, 0xf2, 0x59, 0x12, 0xf9, 0x9b, 0x3b, 0x4c, 0x9f, 0x19, 0x4a, 0x8f, 0x52, 0x8b,
-	0x5d, 0x18, 0x38, 0xa7, 0x96, 0xfb, 0x85, 0x02, 0x8c, 0xe8, 0x17, 0xaa, 0xef, 0x14, 0x34, 0x1d,
-	0xa7, 0x93, 0x82, 0x1f, 0x9c, 0xaf, 0x5a, 0xe8, 0xf6, 0x9d, 0x26, 0x44, 0x03, 0xfa, 0xbd, 0x8e,
-	0x50, 0x64, 0xad, 0xd8, 0xe7, 0x58, 0x8f, 0x3b, 0x49, 0x83, 0xdf, 0xbc, 0x63, 0xe1, 0xcc, 0x8c,
-	0x83, 0xfb, 0x99, 0x3e, 0x18, 0x92, 0x85, 0xe8, 0xd3, 0x0e, 0x40, 0x1a, 0x37, 0x26, 0x44, 0xe9,
-	0x9a, 0x8d, 0xa0, 0x22, 0x3d, 0xe4, 0x4d, 0x33, 0xf3, 0x2b, 0x38, 0xd6, 0xf8, 0xa2, 0x04, 0x06,
-	0x42, 0xda, 0xb8, 0xcb, 0xf6, 0x92, 0x02, 0xac, 0x52, 0xc6, 0x97, 0x19, 0xf7, 0xd4, 0xa2, 0xc7,
-	0x60, 0x58, 0xf0, 0xa2, 0x87, 0xd3, 0x0d, 0x19, 0xce, 0x68, 0xcf, 0xfa, 0xad, 0x22, 0x24, 0xd3,
-	0xb3, 0xa6, 0x02, 0xe1, 0x94, 0xa1, 0xfb, 0x04, 0x8c, 0x99, 0x8b, 0x81, 0x1e, 0x56, 0x36, 0x76,
-	0x12, 0xc2, 0x4d, 0x21, 0x23, 0xfc, 0xb0, 0x32, 0x43, 0x01, 0x98, 0xc3, 0xdd, 0x1f, 0x38, 0x00,
-	0xa9, 0x78, 0x39, 0x80, 0xf7, 0xe1, 0x21, 0xdd, 0x8e, 0xd7, 0xeb, 0x44, 0xf8, 0x09, 0x18, 0x66,
-	0xff, 0xb0, 0x85, 0xde, 0x67, 0x2b, 0xf8, 0x20, 0x6d, 0xa7, 0x58, 0xea, 0x4c, 0xd7, 0x78, 0x56,
-	0x32, 0xc2, 0x29, 0x4f, 0x37, 0x84, 0xf1, 0x2c, 0x36, 0xfa, 0x30, 0x8c, 0xc4, 0x72, 0x5b, 0x4d,
-	0xaf, 0x07, 0x1e, 0x70, 0xfb, 0xe5, 0xae, 0x3f, 0xad, 0x3a, 0x36, 0x88, 0xb9, 0xab, 0x30, 0x60,
-	0x75, 0x08, 0xdd, 0x6f, 0x3b, 0x30, 0xcc, 0xbc, 0xaf, 0xf5, 0xc8, 0x6b, 0xa5, 0x55, 0xfa, 0xf6,
-	0x19, 0xf5, 0x18, 0x06, 0xb9, 0xf9, 0x40, 0x46, 0x2d, 0x59, 0x90, 0x32, 0x3c, 0x97, 0x5f, 0x2a,
-	0x65, 0xb8, 0x9d, 0x22, 0xc6, 0x92, 0x93, 0xfb, 0xd9, 0x02, 0x0c, 0x2c, 0x06, 0xed, 0xce, 0x5f,
-	0xf9, 0x7c, 0x72, 0x2b, 0xd0, 0xbf, 0x98, 0x90, 0x96, 0x99, 0xf6, 0x70, 0x64, 0xe6, 0x61, 0x3d,
-	0xe5, 0x61, 0xd9, 0x4c, 0x79, 0x88, 0xbd, 0x9b, 0x32, 0xa8, 0x4f, 0x98, 0xaf, 0xd3, 0x2b, 0x92,
-	0x8f, 0xc3, 0xf0, 0xb2, 0xb7, 0x41, 0x9a, 0x4b, 0x64, 0x87, 0x5d, 0x68, 0xe4, 0x01, 0x26, 0x4e,
-	0x6a, 0x73, 0x30, 0x82, 0x41, 0xe6, 0x60, 0x8c, 0x61, 0xab, 0xc5, 0x40, 0x4f, 0x24, 0x24, 0xcd,
-	0x19, 0xe5, 0x98, 0x27, 0x12, 0x2d, 0x5f, 0x94, 0x86, 0xe5, 0x4e, 0x41, 0x29, 0xa5, 0x72, 0x00,
-	0xae, 0x3f, 0x2d, 0xc0, 0xa8, 0x61, 0x85, 0x37, 0x7c, 0x93, 0xce, 0x1d, 0x7d, 0x93, 0x86, 0xaf,
-	0xb0, 0xf0, 0x76, 0xfb, 0x0a, 0xfb, 0x4e, 0xde, 0x57, 0x68, 0x7e, 0xa4, 0xfe, 0x03, 0x7d, 0xa4,
-	0x37, 0x1d, 0xe8, 0x5f, 0xf6, 0x83, 0xad, 0x83, 0x09, 0x9a, 0xb8, 0x1a, 0xb6, 0xbb, 0x04, 0x4d,
-	0x85, 0x02, 0x31, 0x2f, 0x93, 0xaa, 0x4b, 0x5f, 0x0f, 0xd5, 0x25, 0x75, 0x9e, 0xf4, 0xef, 0xe7,
-	0x3c, 0x71, 0x3f, 0xed, 0xc0, 0xc8, 0x8a, 0x17, 0xf8, 0x9b, 0x24, 0x4e, 0xd8, 0x04, 0x4c, 0x8e,
-	0xf5, 0x06, 0xdc, 0x48, 0x8f, 0x5c, 0x0e, 0xaf, 0x3b, 0x70, 0x7a, 0x85, 0xb4, 0x42, 0xff, 0x65,
-	0x2f, 0x0d, 0xae, 0xa5, 0x7d, 0x6c, 0xf8, 0x89, 0x88, 0x25, 0x54, 0x7d, 0xbc, 0xea, 0x27, 0x98,
-	0xc2, 0xef, 0x60, 0x8b, 0x66, 0x77, 0x4b, 0xe8, 0x49, 0x4e, 0xbb, 0x95, 0x99, 0x86, 0xcd, 0xca,
-	0x02, 0x9c, 0xe2, 0xb8, 0xbf, 0xeb, 0xc0, 0x20, 0x6f, 0x84, 0x8a, 0x47, 0x76, 0x7a, 0xd0, 0x6e,
-	0x40, 0x91, 0xd5, 0x13, 0xd3, 0x7f, 0xc1, 0x82, 0x9e, 0x44, 0xc9, 0xf1, 0xc5, 0xca, 0xfe, 0xc5,
-	0x9c, 0x01, 0x3b, 0xdf, 0x78, 0xb7, 0xa6, 0x55, 0x5c, 0x71, 0x7a, 0xbe, 0x61, 0x50, 0x2c, 0x4a,
-	0xdd, 0xaf, 0xf7, 0xc1, 0x90, 0x4a, 0x61, 0xc6, 0x12, 0x4c, 0x04, 0x41, 0x98, 0x78, 0x3c, 0x5e,
-	0x83, 0x0b, 0xf5, 0x0f, 0xdb, 0x4b, 0xa1, 0x36, 0x35, 0x9d, 0x52, 0xe7, 0x3e, 0x48, 0x75, 0x5a,
-	0xd5, 0x4a, 0xb0, 0xde, 0x08, 0xf4, 0x71, 0x18, 0x68, 0x52, 0x31, 0x25, 0x65, 0xfc, 0xb3, 0x16,
-	0x9b, 0xc3, 0xe4, 0x9f, 0x68, 0x89, 0x1a, 0x21, 0x0e, 0xc4, 0x82, 0xeb, 0xc4, 0x07, 0x60, 0x3c,
-	0xdb, 0xea, 0x3b, 0x5d, 0x1a, 0x1d, 0xd6, 0xaf, 0x9c, 0xfe, 0x4d, 0x21, 0x66, 0x0f, 0x5f, 0xd5,
-	0x7d, 0x06, 0x4a, 0x2b, 0x24, 0x89, 0xfc, 0x2a, 0x23, 0x70, 0xa7, 0xc9, 0x75, 0x20, 0x45, 0xe3,
-	0x73, 0x6c, 0xb2, 0x52, 0x9a, 0x31, 0x7a, 0x15, 0xa0, 0x1d, 0x85, 0xf4, 0xa0, 0x4b, 0x3a, 0xf2,
-	0x63, 0x5b, 0x50, 0x9c, 0xd7, 0x14, 0x4d, 0xee, 0x36, 0x4f, 0x7f, 0x63, 0x8d, 0x9f, 0xfb, 0x86,
-	0x03, 0xc5, 0x95, 0x4e, 0x42, 0x6e, 0x1d, 0x40, 0xb4, 0x1d, 0x3a, 0x8d, 0xc2, 0xe3, 0x30, 0x44,
-	0x3f, 0xf0, 0x86, 0x17, 0x4b, 0x83, 0x5b, 0x1a, 0x76, 0x2e, 0xe0, 0x58, 0x61, 0xb8, 0x1f, 0x86,
-	0x11, 0xd6, 0x92, 0xab, 0x61, 0x93, 0x6e, 0xd7, 0x74, 0x24, 0x5b, 0xf4, 0x77, 0xd6, 0x0f, 0xc2,
-	0x90, 0x30, 0x2f, 0xa3, 0x2b, 0xac, 0x11, 0x36, 0x6b, 0xea, 0x02, 0x9a, 0x9a, 0x3f, 0x57, 0x19,
-	0x14, 0x8b, 0x52, 0xf7, 0x53, 0x05, 0x28, 0xb1, 0x8a, 0x42, 0x3a, 0xed, 0xc0, 0x60, 0x83, 0xf3,
-	0x11, 0x43, 0x6e, 0x21, 0x6e, 0x4d, 0x6f, 0xbd, 0x76, 0x46, 0xe4, 0x00, 0x2c, 0xf9, 0x51, 0xd6,
-	0x37, 0x3d, 0x3f, 0xa1, 0xac, 0x0b, 0xc7, 0xcb, 0xfa, 0x06, 0x67, 0x83, 0x25, 0x3f, 0xf7, 0x17,
-	0x81, 0x5d, 0xec, 0x9e, 0x6f, 0x7a, 0x75, 0x3e, 0x72, 0xe1, 0x16, 0xa9, 0x09, 0x11, 0xad, 0x8d,
-	0x1c, 0x85, 0x62, 0x51, 0xca, 0x2f, 0xcb, 0x26, 0x91, 0xaf, 0x22, 0xbe, 0xb5, 0xcb, 0xb2, 0x0c,
-	0x2c, 0xe3, 0xfb, 0x6b, 0xee, 0x97, 0x0b, 0x00, 0x2c, 0x3f, 0x1e, 0xbf, 0x8f, 0xfd, 0x6e, 0x19,
-	0x9c, 0x65, 0xfa, 0x4e, 0x55, 0x70, 0x16, 0xbb, 0x71, 0xae, 0x07, 0x65, 0xe9, 0x17, 0x31, 0x0a,
-	0xfb, 0x5f, 0xc4, 0x40, 0x6d, 0x18, 0x0c, 0x3b, 0x09, 0xd5, 0x81, 0x85, 0x12, 0x61, 0x21, 0x74,
-	0x60, 0x95, 0x13, 0xe4, 0xb7, 0x17, 0xc4, 0x0f, 0x2c, 0xd9, 0xa0, 0xa7, 0x60, 0xa8, 0x1d, 0x85,
-	0x75, 0xaa, 0x13, 0x88, 0x7d, 0xf9, 0x7e, 0x39, 0x9b, 0xd7, 0x04, 0xfc, 0xb6, 0xf6, 0x3f, 0x56,
-	0xd8, 0xee, 0x3f, 0x3c, 0xcd, 0xc7, 0x45, 0xcc, 0xbd, 0x09, 0x28, 0xf8, 0xd2, 0xe2, 0x05, 0x82,
-	0x44, 0x61, 0x71, 0x0e, 0x17, 0xfc, 0x9a, 0x5a, 0x85, 0x85, 0x9e, 0xab, 0xf0, 0xbd, 0x50, 0xaa,
-	0xf9, 0x71, 0xbb, 0xe9, 0xed, 0x5c, 0xcb, 0x31, 0x37, 0xce, 0xa5, 0x45, 0x58, 0xc7, 0x43, 0x8f,
-	0x8b, 0x6b, 0x37, 0xfd, 0x86, 0x89, 0x49, 0x5e, 0xbb, 0x49, 0xef, 0xfb, 0xf3, 0x1b, 0x37, 0xd9,
-	0xbc, 0x08, 0xc5, 0x03, 0xe7, 0x45, 0xc8, 0x6a, 0x78, 0x03, 0x27, 0xaf, 0xe1, 0xbd, 0x1f, 0x46,
-	0xe5, 0x4f, 0xa6, 0x75, 0x95, 0xcf, 0xb2, 0xd6, 0x2b, 0xf3, 0xfa, 0xba, 0x5e, 0x88, 0x4d, 0xdc,
-	0x74, 0xd2, 0x0e, 0x1e, 0x74, 0xd2, 0x5e, 0x06, 0xd8, 0x08, 0x3b, 0x41, 0xcd, 0x8b, 0x76, 0x16,
-	0xe7, 0x44, 0x90, 0xae, 0x52, 0x28, 0x67, 0x54, 0x09, 0xd6, 0xb0, 0xf4, 0x89, 0x3e, 0x7c, 0x87,
-	0x89, 0xfe, 0x61, 0x18, 0x66, 0x01, 0xcd, 0xa4, 0x36, 0x9d, 0x88, 0xa8, 0xaa, 0xc3, 0x44, 0x89,
-	0xa6, 0x71, 0x96, 0x92, 0x08, 0x4e, 0xe9, 0xa1, 0x8f, 0x00, 0x6c, 0xfa, 0x81, 0x1f, 0x37, 0x18,
-	0xf5, 0xd2, 0xa1, 0xa9, 0xab, 0x7e, 0xce, 0x2b, 0x2a, 0x58, 0xa3, 0x88, 0x5e, 0x80, 0xd3, 0x24,
-	0x4e, 0xfc, 0x96, 0x97, 0x90, 0x9a, 0xba, 0xc7, 0x5a, 0x66, 0x36, 0x52, 0x15, 0x52, 0x7e, 0x25,
-	0x8b, 0x70, 0x3b, 0x0f, 0x88, 0xbb, 0x09, 0x19, 0x2b, 0x72, 0xe2, 0x30, 0x2b, 0x12, 0xfd, 0x6f,
-	0x07, 0x4e, 0x47, 0x84, 0x87, 0xda, 0xc4, 0xaa, 0x61, 0xe7, 0x98, 0x38, 0xae, 0xda, 0x48, 0x3d,
-	0xaf, 0x72, 0xcc, 0xe0, 0x2c, 0x17, 0xae, 0xe7, 0x10, 0xd9, 0xfb, 0xae, 0xf2, 0xdb, 0x79, 0xc0,
-	0xd7, 0xdf, 0x9a, 0x9c, 0xec, 0x7e, 0x02, 0x41, 0x11, 0xa7, 0x2b, 0xef, 0xef, 0xbe, 0x35, 0x39,
-	0x2e, 0x7f, 0xa7, 0x83, 0xd6, 0xd5, 0x49, 0xba, 0xad, 0xb6, 0xc3, 0xda, 0xe2, 0x9a, 0x08, 0x7f,
-	0x53, 0xdb, 0xea, 0x1a, 0x05, 0x62, 0x5e, 0x86, 0x1e, 0xa5, 0x3b, 0x37, 0x69, 0x85, 0x81, 0x4a,
-	0x22, 0x3c, 0xc2, 0x77, 0x6d, 0x0e, 0xc3, 0xaa, 0x94, 0x1e, 0x39, 0x02, 0xb1, 0xa5, 0x94, 0xef,
-	0xb3, 0x75, 0xe4, 0x90, 0x9b, 0x14, 0xe7, 0x2a, 0x7f, 0x61, 0xc5, 0x09, 0x35, 0x61, 0xc0, 0x67,
-	0x06, 0x10, 0x11, 0x61, 0x6b, 0xc1, 0xea, 0xc2, 0x0d, 0x2a, 0x32, 0xbe, 0x96, 0x89, 0x7e, 0xc1,
-	0x43, 0xdf, 0x6b, 0x4e, 0x9d, 0xcc, 0x5e, 0xf3, 0x28, 0x0c, 0x55, 0x1b, 0x7e, 0xb3, 0x16, 0x91,
-	0xa0, 0x3c, 0xce, 0x2c, 0x01, 0x6c, 0x24, 0x66, 0x05, 0x0c, 0xab, 0x52, 0xf4, 0x37, 0x60, 0x34,
-	0xec, 0x24, 0x4c, 0xb4, 0xd0, 0x71, 0x8a, 0xcb, 0xa7, 0x19, 0x3a, 0x8b, 0x97, 0x5a, 0xd5, 0x0b,
-	0xb0, 0x89, 0x47, 0x45, 0x7c, 0x23, 0x8c, 0x59, 0x3a, 0x24, 0x26, 0xe2, 0xcf, 0x9b, 0x22, 0xfe,
-	0xaa, 0x56, 0x86, 0x0d, 0x4c, 0xf4, 0x55, 0x07, 0x4e, 0xb7, 0xb2, 0xe7, 0xbd, 0xf2, 0x05, 0x36,
-	0x32, 0x15, 0x1b, 0xe7, 0x82, 0x0c, 0x69, 0x1e, 0xe9, 0xde, 0x05, 0xc6, 0xdd, 0x8d, 0x60, 0x89,
-	0xc9, 0xe2, 0x9d, 0xa0, 0xda, 0x88, 0xc2, 0xc0, 0x6c, 0xde, 0xbd, 0xb6, 0xee, 0xdb, 0xb1, 0xb5,
-	0x9d, 0xc7, 0x62, 0xe6, 0xde, 0xbd, 0xdd, 0xc9, 0x73, 0xb9, 0x45, 0x38, 0xbf, 0x51, 0xe8, 0x43,
-	0x30, 0x9e, 0x78, 0xf1, 0x16, 0xd7, 0x97, 0x68, 0x4d, 0x52, 0x2b, 0xdf, 0xcf, 0x83, 0x1c, 0xf6,
-	0x76, 0x27, 0xc7, 0xd7, 0x33, 0x65, 0xb8, 0x0b, 0x7b, 0x62, 0x0e, 0xce, 0xe7, 0x4b, 0x98, 0x3b,
-	0x1d, 0x71, 0xfa, 0xf4, 0x23, 0xce, 0x3c, 0xdc, 0xdb, 0xb3, 0x5b, 0x74, 0xaf, 0x92, 0xfa, 0xaa,
-	0x63, 0xee, 0x55, 0x5d, 0xfa, 0xe5, 0x18, 0x8c, 0xe8, 0xaf, 0x6e, 0xb8, 0xff, 0xaf, 0x0f, 0x20,
-	0xb5, 0xe0, 0x23, 0x0f, 0xc6, 0xb8, 0xb7, 0x60, 0x71, 0xee, 0xc8, 0xb9, 0x06, 0x66, 0x0d, 0x02,
-	0x38, 0x43, 0x10, 0xb5, 0x00, 0x71, 0x08, 0xff, 0x7d, 0x14, 0xaf, 0x2f, 0x73, 0x92, 0xce, 0x76,
-	0x11, 0xc1, 0x39, 0x84, 0x69, 0x8f, 0x92, 0x70, 0x8b, 0x04, 0xd7, 0xf1, 0xf2, 0x51, 0xf2, 0x59,
-	0x70, 0x3f, 0xa1, 0x41, 0x00, 0x67, 0x08, 0x22, 0x17, 0x06, 0x98, 0xd1, 0x48, 0x46, 0xb5, 0x33,
-	0x01, 0xc5, 0x74, 0x95, 0x18, 0x8b, 0x12, 0xf4, 0x65, 0x07, 0xc6, 0x64, 0x5a, 0x0e, 0x66, 0xa7,
-	0x95, 0xf1, 0xec, 0xd7, 0x6d, 0x79, 0x60, 0xae, 0xe8, 0xd4, 0xd3, 0x68, 0x51, 0x03, 0x1c, 0xe3,
-	0x4c, 0x23, 0xdc, 0xe7, 0xe0, 0x4c, 0x4e, 0x75, 0x2b, 0x47, 0xe8, 0xef, 0x3a, 0x50, 0xd2, 0xb2,
-	0x45, 0xa2, 0x57, 0x61, 0x38, 0xac, 0x58, 0x0f, 0x51, 0x5c, 0xad, 0x74, 0x85, 0x28, 0x2a, 0x10,
-	0x4e, 0x19, 0x1e, 0x24, 0xb2, 0x32, 0x37, 0xb5, 0xe5, 0xdb, 0xdc, 0xec, 0x43, 0x47, 0x56, 0xfe,
-	0x72, 0x11, 0x52, 0x4a, 0x87, 0x4c, 0x17, 0x93, 0xc6, 0x61, 0x16, 0xf6, 0x8d, 0xc3, 0xac, 0xc1,
-	0x29, 0x8f, 0x79, 0xb9, 0x8f, 0x98, 0x24, 0x86, 0x27, 0x0b, 0x36, 0x29, 0xe0, 0x2c, 0x49, 0xca,
-	0x25, 0x4e, 0xab, 0x32, 0x2e, 0xfd, 0x87, 0xe6, 0x52, 0x31, 0x29, 0xe0, 0x2c, 0x49, 0xf4, 0x02,
-	0x94, 0xab, 0xec, 0x56, 0x33, 0xef, 0xe3, 0xe2, 0xe6, 0xb5, 0x30, 0x59, 0x8b, 0x48, 0x4c, 0x82,
-	0x44, 0xa4, 0x83, 0x7b, 0x50, 0x8c, 0x42, 0x79, 0xb6, 0x07, 0x1e, 0xee, 0x49, 0x81, 0x1e, 0x74,
-	0x98, 0x9b, 0xdc, 0x4f, 0x76, 0x98, 0x10, 0x11, 0xf1, 0x03, 0xea, 0xa0, 0x53, 0xd1, 0x0b, 0xb1,
-	0x89, 0x8b, 0x7e, 0xc9, 0x81, 0xd1, 0xa6, 0x74, 0x24, 0xe0, 0x4e, 0x53, 0xe6, 0x36, 0xc5, 0x56,
-	0xa6, 0xdf, 0xb2, 0x4e, 0x99, 0x6b, 0x23, 0x06, 0x08, 0x9b, 0xbc, 0xb3, 0x19, 0x7b, 0x86, 0x0e,
-	0x98, 0xb1, 0xe7, 0x07, 0x0e, 0x8c, 0x67, 0xb9, 0xa1, 0x2d, 0x78, 0xa0, 0xe5, 0x45, 0x5b, 0x8b,
-	0xc1, 0x66, 0xc4, 0x6e, 0xaf, 0x24, 0x7c, 0x32, 0x4c, 0x6f, 0x26, 0x24, 0x9a, 0xf3, 0x76, 0xb8,
-	0x63, 0xb6, 0xa8, 0x1e, 0xc7, 0x7a, 0x60, 0x65, 0x3f, 0x64, 0xbc, 0x3f, 0x2d, 0x54, 0x81, 0x73,
-	0x14, 0x81, 0x25, 0xf4, 0xf3, 0xc3, 0x20, 0x65, 0x52, 0x60, 0x4c, 0x54, 0x04, 0xe5, 0x4a, 0x1e,
-	0x12, 0xce, 0xaf, 0xeb, 0x5e, 0x81, 0x01, 0x7e, 0x99, 0xf0, 0xae, 0x3c, 0x5b, 0xee, 0x7f, 0x28,
-	0x80, 0x54, 0x2d, 0xff, 0x6a, 0x3b, 0x0a, 0xe9, 0x26, 0x1a, 0x31, 0xb5, 0x49, 0xd8, 0x4b, 0xd8,
-	0x26, 0x2a, 0x52, 0x67, 0x8a, 0x12, 0xaa, 0x73, 0x93, 0x5b, 0x7e, 0x32, 0x1b, 0xd6, 0xa4, 0x95,
-	0x84, 0xe9, 0xdc, 0x57, 0x04, 0x0c, 0xab, 0x52, 0xf7, 0xd3, 0x0e, 0x8c, 0xd2, 0x5e, 0x36, 0x9b,
-	0xa4, 0x59, 0x49, 0x48, 0x3b, 0x46, 0x31, 0x14, 0x63, 0xfa, 0x8f, 0x3d, 0x63, 0x62, 0x7a, 0x01,
-	0x95, 0xb4, 0x35, 0x2f, 0x12, 0x65, 0x82, 0x39, 0x2f, 0xf7, 0x3b, 0x7d, 0x30, 0xac, 0x06, 0xfb,
-	0x00, 0xf6, 0xdb, 0xcb, 0x69, 0x56, 0x5b, 0x2e, 0x81, 0xcb, 0x5a, 0x46, 0xdb, 0xdb, 0x74, 0xe8,
-	0x82, 0x1d, 0x9e, 0xbf, 0x23, 0x4d, 0x6f, 0xfb, 0xb8, 0xe9, 0x04, 0x3f, 0xaf, 0xcf, 0x3f, 0x0d,
-	0x5f, 0x78, 0xc3, 0x6f, 0xe9, 0x31, 0x08, 0xfd, 0xb6, 0x76, 0x33, 0xe5, 0x60, 0xed, 0x1d, 0x7c,
-	0x90, 0x79, 0xf0, 0xa8, 0x78, 0xa0, 0x07, 0x8f, 0x1e, 0x83, 0x7e, 0x12, 0x74, 0x5a, 0x4c, 0x55,
-	0x1a, 0x66, 0x87, 0x8c, 0xfe, 0x2b, 0x41, 0xa7, 0x65, 0xf6, 0x8c, 0xa1, 0xa0, 0x0f, 0x40, 0xa9,
-	0x46, 0xe2, 0x6a, 0xe4, 0xb3, 0xa4, 0x14, 0xc2, 0x36, 0x74, 0x3f, 0x33, 0xb8, 0xa5, 0x60, 0xb3,
-	0xa2, 0x5e, 0xc1, 0x7d, 0x19, 0x06, 0xd6, 0x9a, 0x9d, 0xba, 0x1f, 0xa0, 0x36, 0x0c, 0xf0, 0x14,
-	0x15, 0x62, 0xb7, 0xb7, 0x70, 0x72, 0xe5, 0xa2, 0x42, 0x8b, 0x8f, 0xe1, 0xf7, 0x90, 0x05, 0x1f,
-	0xf7, 0x53, 0x05, 0xa0, 0x87, 0xfb, 0x85, 0x59, 0xf4, 0xb7, 0xbb, 0xde, 0xf7, 0xf9, 0xb9, 0x9c,
-	0xf7, 0x7d, 0x46, 0x19, 0x72, 0xce, 0xd3, 0x3e, 0x4d, 0x18, 0x65, 0xde, 0x18, 0xb9, 0x07, 0x0a,
-	0xb5, 0xfa, 0xc9, 0x03, 0x66, 0x75, 0xd0, 0xab, 0x8a, 0x1d, 0x41, 0x07, 0x61, 0x93, 0x38, 0x5a,
-	0x81, 0x33, 0x3c, 0x39, 0xea, 0x1c, 0x69, 0x7a, 0x3b, 0x99, 0x24, 0x68, 0xf7, 0xc9, 0x27, 0xdb,
-	0xe6, 0xba, 0x51, 0x70, 0x5e, 0x3d, 0xf7, 0xf7, 0xfa, 0x41, 0xf3, 0x81, 0x1c, 0x60, 0xb5, 0xbc,
-	0x94, 0xf1, 0x78, 0xad, 0x58, 0xf1, 0x78, 0x49, 0x37, 0x12, 0x97, 0x40, 0xa6, 0x93, 0x8b, 0x36,
-	0xaa, 0x41, 0x9a, 0x6d, 0xd1, 0x47, 0xd5, 0xa8, 0xab, 0xa4, 0xd9, 0xc6, 0xac, 0x44, 0xdd, 0xc2,
-	0xec, 0xef, 0x79, 0x0b, 0xb3, 0x01, 0xc5, 0xba, 0xd7, 0xa9, 0x13, 0x11, 0x1b, 0x6a, 0xc1, 0xb9,
-	0xc9, 0xee, 0x85, 0x70, 0xe7, 0x26, 0xfb, 0x17, 0x73, 0x06, 0x74, 0xb1, 0x37, 0x64, 0xb0, 0x8c,
-	0x30, 0xf3, 0x5a, 0x58, 0xec, 0x2a, 0xfe, 0x86, 0x2f, 0x76, 0xf5, 0x13, 0xa7, 0xcc, 0x50, 0x1b,
-	0x06, 0xab, 0x3c, 0xb7, 0x8c, 0xd0, 0x59, 0x16, 0x6d, 0x5c, 0x33, 0x65, 0x04, 0xb9, 0x3d, 0x46,
-	0xfc, 0xc0, 0x92, 0x8d, 0x7b, 0x09, 0x4a, 0xda, 0x33, 0x23, 0xf4, 0x33, 0xa8, 0xb4, 0x26, 0xda,
-	0x67, 0x98, 0xf3, 0x12, 0x0f, 0xb3, 0x12, 0xf7, 0x9b, 0xfd, 0xa0, 0xac, 0x71, 0xfa, 0xa5, 0x48,
-	0xaf, 0xaa, 0x25, 0x61, 0x32, 0x12, 0x04, 0x84, 0x01, 0x16, 0xa5, 0x54, 0xaf, 0x6b, 0x91, 0xa8,
-	0xae, 0xce, 0xd1, 0x42, 0x5c, 0x2b, 0xbd, 0x6e, 0x45, 0x2f, 0xc4, 0x26, 0x2e, 0x55, 0xca, 0x5b,
-	0x22, 0x26, 0x20, 0x1b, 0xf2, 0x2d, 0x63, 0x05, 0xb0, 0xc2, 0x60, 0x59, 0x1c, 0x5a, 0x5a, 0x08,
-	0x81, 0x08, 0x11, 0xb5, 0xe1, 0x92, 0xd2, 0xa8, 0xf2, 0x50, 0x2e, 0x1d, 0x82, 0x0d, 0xae, 0x68,
-	0x01, 0x4e, 0xc7, 0x24, 0x59, 0xbd, 0x19, 0x90, 0x48, 0xe5, 0x4f, 0x10, 0x69, 0x42, 0xd4, 0x95,
-	0x91, 0x4a, 0x16, 0x01, 0x77, 0xd7, 0xc9, 0x8d, 0xaa, 0x2d, 0x1e, 0x3a, 0xaa, 0x76, 0x0e, 0xc6,
-	0x37, 0x3d, 0xbf, 0xd9, 0x89, 0x48, 0xcf, 0xd8, 0xdc, 0xf9, 0x4c, 0x39, 0xee, 0xaa, 0xc1, 0x6e,
-	0x2d, 0x35, 0xbd, 0x7a, 0x5c, 0x1e, 0xd4, 0x6e, 0x2d, 0x51, 0x00, 0xe6, 0x70, 0xf7, 0x37, 0x1d,
-	0xe0, 0xf9, 0x99, 0xa6, 0x37, 0x37, 0xfd, 0xc0, 0x4f, 0x76, 0xd0, 0xd7, 0x1c, 0x18, 0x0f, 0xc2,
-	0x1a, 0x99, 0x0e, 0x12, 0x5f, 0x02, 0xed, 0xe5, 0xd4, 0x67, 0xbc, 0xae, 0x65, 0xc8, 0x73, 0x53,
-	0x53, 0x16, 0x8a, 0xbb, 0x9a, 0xe1, 0x5e, 0x80, 0x73, 0xb9, 0x04, 0xdc, 0x1f, 0xf4, 0x81, 0x99,
-	0x66, 0x0a, 0x3d, 0x03, 0xc5, 0x26, 0x4b, 0x7c, 0xe2, 0x1c, 0x31, 0x7f, 0x18, 0x1b, 0x2b, 0x9e,
-	0x19, 0x85, 0x53, 0x42, 0x73, 0x50, 0x62, 0xb9, 0xab, 0x44, 0x5a, 0x9a, 0x82, 0x91, 0xef, 0xa1,
-	0x84, 0xd3, 0xa2, 0xdb, 0xe6, 0x4f, 0xac, 0x57, 0x43, 0xaf, 0xc0, 0xe0, 0x06, 0x4f, 0xf0, 0x69,
-	0xcf, 0x6b, 0x28, 0x32, 0x86, 0x32, 0xdd, 0x48, 0xa6, 0x0f, 0xbd, 0x9d, 0xfe, 0x8b, 0x25, 0x47,
-	0xb4, 0x03, 0x43, 0x9e, 0xfc, 0xa6, 0xfd, 0xb6, 0xae, 0x90, 0x18, 0xf3, 0x47, 0x84, 0xe8, 0xc8,
-	0x6f, 0xa8, 0xd8, 0x65, 0x82, 0x9e, 0x8a, 0x07, 0x0a, 0x7a, 0xfa, 0xb6, 0x03, 0x90, 0xbe, 0x86,
-	0x82, 0x6e, 0xc1, 0x50, 0xfc, 0xa4, 0x61, 0xa8, 0xb0, 0x91, 0x7e, 0x40, 0x50, 0xd4, 0xae, 0xe8,
-	0x0a, 0x08, 0x56, 0xdc, 0xee, 0x64, 0x5c, 0xf9, 0xa9, 0x03, 0x67, 0xf3, 0x5e, 0x6d, 0x79, 0x1b,
-	0x5b, 0x7c, 0x58, 0xbb, 0x8a, 0xa8, 0xb0, 0x16, 0x91, 0x4d, 0xff, 0x56, 0x4e, 0x9a, 0x69, 0x5e,
-	0x80, 0x53, 0x1c, 0xf7, 0x4f, 0x07, 0x41, 0x31, 0x3e, 0x26, 0x3b, 0xcc, 0x23, 0xf4, 0xcc, 0x54,
-	0x4f, 0x75, 0x2e, 0x85, 0x87, 0x19, 0x14, 0x8b, 0x52, 0x7a, 0x6e, 0x92, 0xe1, 0xfa, 0x42, 0x64,
-	0xb3, 0x59, 0x28, 0xc3, 0xfa, 0xb1, 0x2a, 0xcd, 0xb3, 0xec, 0x14, 0x4f, 0xc4, 0xb2, 0x33, 0x60,
-	0xdf, 0xb2, 0xd3, 0x02, 0x14, 0xf3, 0x85, 0xc2, 0xcc, 0x29, 0x82, 0xd1, 0xc8, 0xa1, 0x0d, 0xcd,
-	0x95, 0x2e, 0x22, 0x38, 0x87, 0x30, 0x8b, 0xc2, 0x08, 0x9b, 0x64, 0x1a, 0x5f, 0x13, 0x87, 0x8f,
-	0x34, 0x0a, 0x83, 0x83, 0xb1, 0x2c, 0x3f, 0xa2, 0x29, 0x05, 0xfd, 0xb6, 0xb3, 0x8f, 0xad, 0x6a,
-	0xd8, 0xd6, 0x16, 0x94, 0x9b, 0xe3, 0x8f, 0x9d, 0xa4, 0x8e, 0x62, 0x00, 0xfb, 0xba, 0x03, 0xa7,
-	0x49, 0x50, 0x8d, 0x76, 0x18, 0x1d, 0x41, 0x4d, 0x38, 0xc9, 0xaf, 0xdb, 0x58, 0xeb, 0x57, 0xb2,
-	0xc4, 0xb9, 0x2f, 0xaa, 0x0b, 0x8c, 0xbb, 0x9b, 0x81, 0x56, 0x61, 0xa8, 0xea, 0x89, 0x79, 0x51,
-	0x3a, 0xcc, 0xbc, 0xe0, 0xae, 0xbe, 0x69, 0x31, 0x1b, 0x14, 0x11, 0xf7, 0xc7, 0x05, 0x38, 0x93,
-	0xd3, 0x24, 0x76, 0x93, 0xac, 0x45, 0x17, 0xc0, 0x62, 0x2d, 0xbb, 0xfc, 0x97, 0x04, 0x1c, 0x2b,
-	0x0c, 0xb4, 0x06, 0x67, 0xb7, 0x5a, 0x71, 0x4a, 0x65, 0x36, 0x0c, 0x12, 0x72, 0x4b, 0x0a, 0x03,
-	0xe9, 0x40, 0x3f, 0xbb, 0x94, 0x83, 0x83, 0x73, 0x6b, 0x52, 0x6d, 0x89, 0x04, 0xde, 0x46, 0x93,
-	0xa4, 0x45, 0x22, 0xdc, 0x4b, 0x69, 0x4b, 0x57, 0x32, 0xe5, 0xb8, 0xab, 0x06, 0x7a, 0xc3, 0x81,
-	0xfb, 0x62, 0x12, 0x6d, 0x93, 0xa8, 0xe2, 0xd7, 0xc8, 0x6c, 0x27, 0x4e, 0xc2, 0x16, 0x89, 0x8e,
-	0x68, 0x9d, 0x9d, 0xdc, 0xdb, 0x9d, 0xbc, 0xaf, 0xd2, 0x9b, 0x1a, 0xde, 0x8f, 0x95, 0xfb, 0x86,
-	0x03, 0x63, 0x15, 0x76, 0x76, 0x57, 0xaa, 0xbb, 0xed, 0x2c, 0xaf, 0x8f, 0xa8, 0xa4, 0x22, 0x19,
-	0x21, 0x6c, 0xa6, 0x01, 0x71, 0x5f, 0x84, 0xf1, 0x0a, 0x69, 0x79, 0xed, 0x06, 0xbb, 0x5f, 0xcd,
-	0x03, 0xc8, 0x2e, 0xc1, 0x70, 0x2c, 0x61, 0xd9, 0x77, 0x9f, 0x14, 0x32, 0x4e, 0x71, 0xd0, 0xc3,
-	0x3c, 0xd8, 0x4d, 0x5e, 0x85, 0x1a, 0xe6, 0x87, 0x1c, 0x1e, 0x21, 0x17, 0x63, 0x59, 0xe6, 0x7e,
-	0xbb, 0x00, 0x23, 0x69, 0x7d, 0xb2, 0x89, 0xea, 0x70, 0xaa, 0xaa, 0x5d, 0x23, 0x4c, 0x2f, 0x70,
-	0x1c, 0xfc, 0xc6, 0x21, 0x4f, 0x3e, 0x6d, 0x12, 0xc1, 0x59, 0xaa, 0x87, 0x8f, 0x2c, 0x7c, 0x25,
-	0x13, 0x59, 0x68, 0xe5, 0x41, 0x89, 0xca, 0x4e, 0x50, 0x55, 0x71, 0x89, 0x64, 0x53, 0x86, 0x3c,
-	0x74, 0x05, 0x2a, 0x7e, 0xb1, 0x00, 0xa7, 0xd4, 0x38, 0x09, 0x27, 0xe9, 0x6b, 0xd9, 0x78, 0x42,
-	0x6c, 0x23, 0x37, 0x93, 0xf9, 0xe1, 0xf7, 0x89, 0x29, 0x7c, 0x2d, 0x1b, 0x53, 0x78, 0xac, 0xec,
-	0xbb, 0xfc, 0xbe, 0xdf, 0x2e, 0xc0, 0x90, 0xca, 0x14, 0xf5, 0x0c, 0x14, 0xd9, 0xb1, 0xf9, 0xee,
-	0x94, 0x7f, 0x76, 0x04, 0xc7, 0x9c, 0x12, 0x25, 0xc9, 0x62, 0x96, 0x8e, 0x9c, 0x8f, 0x78, 0x98,
-	0x1b, 0x4f, 0xbd, 0x28, 0xc1, 0x9c, 0x12, 0x5a, 0x82, 0x3e, 0x12, 0xd4, 0xc4, 0xe4, 0x39, 0x3c,
-	0x41, 0xf6, 0x3c, 0xdc, 0x95, 0xa0, 0x86, 0x29, 0x15, 0x96, 0xae, 0x8e, 0x2b, 0x7b, 0x99, 0x80,
-	0x7d, 0xa1, 0xe9, 0x89, 0x52, 0x77, 0x06, 0x8c, 0x54, 0x86, 0x47, 0xba, 0x30, 0xf2, 0x4b, 0x7d,
-	0x30, 0x50, 0xe9, 0x6c, 0xd0, 0x33, 0xd1, 0xb7, 0x1c, 0x38, 0x73, 0x33, 0x93, 0xf0, 0x3b, 0x5d,
-	0xa4, 0xd7, 0xed, 0x19, 0xa1, 0xf5, 0xd8, 0x3b, 0x65, 0x7a, 0xcb, 0x29, 0xc4, 0x79, 0xcd, 0x31,
-	0x72, 0xee, 0xf6, 0x1d, 0x4b, 0xce, 0xdd, 0x5b, 0xc7, 0x7c, 0xa9, 0x65, 0xb4, 0xd7, 0x85, 0x16,
-	0xf7, 0xf7, 0x8a, 0x00, 0xfc, 0x6b, 0xac, 0xb6, 0x93, 0x83, 0x98, 0x15, 0x9f, 0x82, 0x91, 0x3a,
-	0x09, 0x48, 0x24, 0x23, 0x2b, 0x33, 0x6f, 0x55, 0x2d, 0x68, 0x65, 0xd8, 0xc0, 0x64, 0x93, 0x25,
-	0x48, 0xa2, 0x1d, 0xae, 0xe7, 0x67, 0x2f, 0xae, 0xa8, 0x12, 0xac, 0x61, 0xa1, 0x29, 0xc3, 0xeb,
-	0xc3, 0x03, 0x08, 0xc6, 0xf6, 0x71, 0xd2, 0x7c, 0x00, 0xc6, 0xcc, 0x04, 0x35, 0x42, 0xdb, 0x54,
-	0x0e, 0x7f, 0x33, 0xaf, 0x0d, 0xce, 0x60, 0xd3, 0x85, 0x50, 0x8b, 0x76, 0x70, 0x27, 0x10, 0x6a,
-	0xa7, 0x5a, 0x08, 0x73, 0x0c, 0x8a, 0x45, 0x29, 0xcb, 0xec, 0xc1, 0x36, 0x60, 0x0e, 0x17, 0xd9,
-	0x41, 0xd2, 0xcc, 0x1e, 0x5a, 0x19, 0x36, 0x30, 0x29, 0x07, 0x61, 0x96, 0x05, 0x73, 0xa9, 0x65,
-	0x6c, 0xa9, 0x6d, 0x18, 0x0b, 0x4d, 0x73, 0x12, 0xd7, 0xc1, 0xde, 0x73, 0xc0, 0xa9, 0x67, 0xd4,
-	0xe5, 0x81, 0x1a, 0x19, 0xeb, 0x53, 0x86, 0x3e, 0xd5, 0xbb, 0xf5, 0x6b, 0x1b, 0x23, 0x66, 0x60,
-	0x6e, 0xcf, 0x9b, 0x15, 0x6b, 0x70, 0xb6, 0x1d, 0xd6, 0xd6, 0x22, 0x3f, 0x8c, 0xfc, 0x64, 0x67,
-	0xb6, 0xe9, 0xc5, 0x31, 0x9b, 0x18, 0xa3, 0xa6, 0x3e, 0xb6, 0x96, 0x83, 0x83, 0x73, 0x6b, 0xd2,
-	0x03, 0x59, 0x5b, 0x00, 0x59, 0x78, 0x5c, 0x91, 0xef, 0x64, 0x12, 0x11, 0xab, 0x52, 0xf7, 0x0c,
-	0x9c, 0xae, 0x74, 0xda, 0xed, 0xa6, 0x4f, 0x6a, 0xca, 0xab, 0xe2, 0x7e, 0x10, 0x4e, 0x89, 0x8c,
-	0xbc, 0x4a, 0xfb, 0x39, 0x54, 0xfe, 0x78, 0xf7, 0xdd, 0x70, 0x2a, 0xb3, 0x95, 0xde, 0x21, 0xe2,
-	0xc3, 0xfd, 0xaf, 0x7d, 0xbc, 0x8a, 0x16, 0x7c, 0x84, 0x5e, 0xc9, 0x6a, 0x39, 0x76, 0x72, 0xcb,
-	0x6a, 0xfa, 0x8d, 0x48, 0x14, 0x9b, 0xa7, 0x31, 0x35, 0xe4, 0xdd, 0x03, 0x6b, 0x57, 0x84, 0x58,
-	0x84, 0x3e, 0xdf, 0x87, 0x8c, 0x0b, 0x0c, 0x1f, 0x07, 0x50, 0x6c, 0x65, 0xfa, 0x02, 0xdb, 0xfd,
-	0x64, 0x2b, 0x5e, 0x41, 0x62, 0xac, 0x71, 0x44, 0x01, 0x0c, 0xb2, 0x86, 0x10, 0x79, 0x81, 0xd5,
-	0x5a, 0x5f, 0x99, 0x92, 0xb9, 0xc2, 0x69, 0x63, 0xc9, 0xc4, 0xfd, 0x5c, 0x01, 0xf2, 0x63, 0xe4,
-	0xd0, 0xc7, 0xbb, 0x3f, 0xf8, 0x33, 0x16, 0x07, 0x42, 0x04, 0xe9, 0xf5, 0xfe, 0xe6, 0x81, 0xf9,
-	0xcd, 0x57, 0x2c, 0x8d, 0x83, 0xe0, 0xdb, 0xf5, 0xe5, 0xdd, 0xff, 0xe5, 0x40, 0x69, 0x7d, 0x7d,
-	0x59, 0x29, 0x03, 0x18, 0xce, 0xc7, 0x3c, 0x37, 0x04, 0x0b, 0x04, 0x98, 0x0d, 0x5b, 0x6d, 0x1e,
-	0x17, 0x20, 0xe2, 0x15, 0x58, 0xfa, 0xe8, 0x4a, 0x2e, 0x06, 0xee, 0x51, 0x13, 0x2d, 0xc2, 0x19,
-	0xbd, 0xa4, 0xa2, 0x3d, 0xe6, 0x59, 0x14, 0xa9, 0xa2, 0xba, 0x8b, 0x71, 0x5e, 0x9d, 0x2c, 0x29,
-	0x61, 0xff, 0x66, 0x1b, 0x7a, 0x0e, 0x29, 0x51, 0x8c, 0xf3, 0xea, 0xb8, 0xab, 0x50, 0x5a, 0xf7,
-	0x22, 0xd5, 0xf1, 0x0f, 0xc1, 0x78, 0x35, 0x6c, 0x49, 0x05, 0x67, 0x99, 0x6c, 0x93, 0xa6, 0xe8,
-	0x32, 0x7f, 0x22, 0x27, 0x53, 0x86, 0xbb, 0xb0, 0xdd, 0xdf, 0x78, 0x10, 0xd4, 0x5d, 0xd7, 0x03,
-	0xec, 0xc1, 0x6d, 0x15, 0x3d, 0x5c, 0xb4, 0x1c, 0x3d, 0xac, 0x76, 0xa3, 0x4c, 0x04, 0x71, 0x92,
-	0x46, 0x10, 0x0f, 0xd8, 0x8e, 0x20, 0x56, 0x6a, 0x79, 0x57, 0x14, 0xf1, 0x57, 0x1c, 0x18, 0x09,
-	0xc2, 0x1a, 0x51, 0x0e, 0xdb, 0x41, 0xb6, 0xc2, 0x5f, 0xb0, 0x77, 0x19, 0x83, 0x47, 0xc3, 0x0a,
-	0xf2, 0x3c, 0xb2, 0x5d, 0x6d, 0xe2, 0x7a, 0x11, 0x36, 0xda, 0x81, 0xe6, 0x35, 0x4b, 0x38, 0x77,
-	0x38, 0xdd, 0x9f, 0x77, 0xa2, 0xbc, 0xa3, 0x59, 0xfb, 0x96, 0xa6, 0x59, 0x0e, 0xdb, 0xb2, 0xf0,
-	0xca, 0x7b, 0x89, 0x9a, 0xdf, 0x4c, 0x66, 0x40, 0x4f, 0x35, 0x4e, 0x17, 0x06, 0x78, 0x08, 0xbc,
-	0x48, 0x4a, 0xc6, 0xdc, 0xb9, 0x3c, 0x3c, 0x1e, 0x8b, 0x12, 0x94, 0xc8, 0xa0, 0x90, 0x92, 0xad,
-	0xf7, 0x4c, 0x8c, 0xa0, 0x93, 0xfc, 0xa8, 0x10, 0xf4, 0xb4, 0x6e, 0xa9, 0x18, 0x39, 0x88, 0xa5,
-	0x62, 0xb4, 0xa7, 0x95, 0xe2, 0x0b, 0x0e, 0x8c, 0x54, 0xb5, 0xf7, 0x45, 0xca, 0x8f, 0xda, 0x7a,
-	0x66, 0x3d, 0xef, 0x19, 0x18, 0xee, 0x25, 0x34, 0xde, 0x33, 0x31, 0xb8, 0xb3, 0x4c, 0xac, 0xcc,
-	0x2c, 0xc3, 0x94, 0x23, 0x2b, 0x19, 0x4e, 0x4c, 0x33, 0x8f, 0x0c, 0xae, 0xa5, 0x30, 0x2c, 0x78,
-	0xa1, 0x57, 0x61, 0x48, 0xde, 0xa2, 0x10, 0xb7, 0x0d, 0xb0, 0x0d, 0xb7, 0x8d, 0xe9, 0x1b, 0x96,
-	0xe9, 0x1b, 0x39, 0x14, 0x2b, 0x8e, 0xa8, 0x01, 0x7d, 0x35, 0xaf, 0x2e, 0xee, 0x1d, 0xac, 0xd8,
-	0x49, 0x8f, 0x2b, 0x79, 0xb2, 0x43, 0xec, 0xdc, 0xf4, 0x02, 0xa6, 0x2c, 0xd0, 0xad, 0xf4, 0x81,
-	0x86, 0x71, 0x6b, 0xbb, 0xaf, 0xa9, 0x48, 0x72, 0x9d, 0xa0, 0xeb, 0xbd, 0x87, 0x9a, 0x70, 0xa7,
-	0xff, 0x35, 0xc6, 0x76, 0xde, 0x4e, 0x7e, 0x5d, 0x9e, 0x31, 0x27, 0x75, 0xc9, 0x53, 0x2e, 0x8d,
-	0x24, 0x69, 0x97, 0x7f, 0xde, 0x16, 0x17, 0x96, 0xf7, 0x85, 0xbf, 0x88, 0xbf, 0xbe, 0xbe, 0x86,
-	0x19, 0x75, 0xd4, 0x84, 0x81, 0x36, 0x8b, 0xf4, 0x29, 0xbf, 0xd3, 0xd6, 0xde, 0xc2, 0x23, 0x87,
-	0xf8, 0xdc, 0xe4, 0xff, 0x63, 0xc1, 0x03, 0x5d, 0x81, 0x41, 0xfe, 0xce, 0x10, 0xbf, 0xf7, 0x51,
-	0xba, 0x3c, 0xd1, 0xfb, 0xb5, 0xa2, 0x74, 0xa3, 0xe0, 0xbf, 0x63, 0x2c, 0xeb, 0xa2, 0x2f, 0x3a,
-	0x30, 0x46, 0x25, 0x6a, 0xfa, 0x30, 0x52, 0x19, 0xd9, 0x92, 0x59, 0xd7, 0x63, 0xaa, 0x91, 0x48,
-	0x59, 0xa3, 0x0e, 0x92, 0x8b, 0x06, 0x3b, 0x9c, 0x61, 0x8f, 0x5e, 0x83, 0xa1, 0xd8, 0xaf, 0x91,
-	0xaa, 0x17, 0xc5, 0xe5, 0x33, 0xc7, 0xd3, 0x94, 0xd4, 0x81, 0x27, 0x18, 0x61, 0xc5, 0x12, 0xfd,
-	0x2a, 0x7b, 0xb8, 0xb6, 0xda, 0xf0, 0xb7, 0xc9, 0x72, 0x58, 0xe5, 0x07, 0x9f, 0xb3, 0xb6, 0xd6,
-	0xbe, 0x74, 0x55, 0x4a, 0xca, 0xc2, 0xaf, 0x65, 0xb2, 0xc3, 0x59, 0xfe, 0xe8, 0xef, 0x38, 0x70,
-	0x8e, 0xbf, 0x20, 0x91, 0x7d, 0x14, 0xe5, 0xdc, 0x11, 0x8d, 0x58, 0xec, 0xc2, 0xca, 0x74, 0x1e,
-	0x49, 0x9c, 0xcf, 0x89, 0xe5, 0x7b, 0x36, 0xdf, 0xb1, 0x3a, 0x6f, 0xd5, 0x91, 0x7d, 0xf0, 0xb7,
-	0xab, 0xd0, 0x13, 0x50, 0x6a, 0x8b, 0xed, 0xd0, 0x8f, 0x5b, 0xec, 0xfa, 0x51, 0x1f, 0xbf, 0x18,
-	0xba, 0x96, 0x82, 0xb1, 0x8e, 0x63, 0x24, 0xff, 0x7e, 0x6c, 0xbf, 0xe4, 0xdf, 0xe8, 0x3a, 0x94,
-	0x92, 0xb0, 0x29, 0xf2, 0xdf, 0xc6, 0xe5, 0x32, 0x9b, 0x81, 0x17, 0xf3, 0xd6, 0xd6, 0xba, 0x42,
-	0x4b, 0xcf, 0xfa, 0x29, 0x2c, 0xc6, 0x3a, 0x1d, 0x16, 0xb0, 0x2d, 0x5e, 0xe6, 0x88, 0xd8, 0x21,
-	0xff, 0xde, 0x4c, 0xc0, 0xb6, 0x5e, 0x88, 0x4d, 0x5c, 0xb4, 0x00, 0xa7, 0xdb, 0x5d, 0x56, 0x02,
-	0x7e, 0xed, 0x51, 0xc5, 0xc8, 0x74, 0x9b, 0x08, 0xba, 0xeb, 0xf4, 0x48, 0x70, 0x7d, 0xff, 0x51,
-	0x12, 0x5c, 0xa3, 0x1a, 0xdc, 0xef, 0x75, 0x92, 0x90, 0x65, 0x2c, 0x32, 0xab, 0xf0, 0x88, 0xf4,
-	0x07, 0x79, 0x90, 0xfb, 0xde, 0xee, 0xe4, 0xfd, 0xd3, 0xfb, 0xe0, 0xe1, 0x7d, 0xa9, 0xa0, 0x97,
-	0x61, 0x88, 0x88, 0x24, 0xdd, 0xe5, 0x9f, 0xb3, 0xb5, 0xf5, 0x9b, 0x69, 0xbf, 0x65, 0xb0, 0x2f,
-	0x87, 0x61, 0xc5, 0x0f, 0xad, 0x43, 0xa9, 0x11, 0xc6, 0xc9, 0x74, 0xd3, 0xf7, 0x62, 0x12, 0x97,
-	0x1f, 0x60, 0x53, 0x21, 0x57, 0xa3, 0xba, 0x2a, 0xd1, 0xd2, 0x99, 0x70, 0x35, 0xad, 0x89, 0x75,
-	0x32, 0x88, 0x30, 0x27, 0x35, 0x0b, 0xc7, 0x97, 0x0e, 0xb8, 0x8b, 0xac, 0x63, 0x8f, 0xe4, 0x51,
-	0x5e, 0x0b, 0x6b, 0x15, 0x13, 0x5b, 0x79, 0xa9, 0x75, 0x20, 0xce, 0xd2, 0x44, 0x4f, 0xc1, 0x48,
-	0x3b, 0xac, 0x55, 0xda, 0xa4, 0xba, 0xe6, 0x25, 0xd5, 0x46, 0x79, 0xd2, 0xb4, 0x36, 0xae, 0x69,
-	0x65, 0xd8, 0xc0, 0x44, 0x6d, 0x18, 0x6c, 0xf1, 0x0c, 0x15, 0xe5, 0x87, 0x6c, 0x9d, 0x58, 0x44,
-	0xca, 0x0b, 0x61, 0x19, 0xe0, 0x3f, 0xb0, 0x64, 0x83, 0xfe, 0xb1, 0x03, 0xa7, 0x32, 0xd7, 0xe4,
-	0xca, 0xef, 0xb0, 0xe9, 0xdb, 0xd1, 0x08, 0xcf, 0x3c, 0xc2, 0x86, 0xcf, 0x04, 0xde, 0xee, 0x06,
-	0xe1, 0x6c, 0x8b, 0xf8, 0xb8, 0xb0, 0x34, 0x33, 0xe5, 0x87, 0xed, 0x8d, 0x0b, 0x23, 0x28, 0xc7,
-	0x85, 0xfd, 0xc0, 0x92, 0x0d, 0x7a, 0x0c, 0x06, 0x45, 0xea, 0xc8, 0xf2, 0x23, 0xa6, 0xeb, 0x5f,
-	0x64, 0x98, 0xc4, 0xb2, 0xbc, 0x2b, 0x75, 0xcc, 0xe3, 0xb6, 0x52, 0xc7, 0xa8, 0xf3, 0xde, 0xe1,
-	0x53, 0xc7, 0x4c, 0x7c, 0x10, 0x4e, 0x77, 0x9d, 0x12, 0x0f, 0x95, 0xbb, 0xe5, 0x2e, 0x73, 0xbf,
-	0xb8, 0xbf, 0xee, 0x80, 0x9e, 0x2c, 0xc0, 0xfa, 0x73, 0x3f, 0x4f, 0xc1, 0x48, 0x95, 0xbf, 0xbe,
-	0xca, 0xd3, 0x0d, 0xf4, 0x9b, 0xc6, 0xec, 0x59, 0xad, 0x0c, 0x1b, 0x98, 0xee, 0x55, 0x40, 0xdd,
-	0x6f, 0x31, 0x1c, 0xc9, 0x2b, 0xf4, 0x4f, 0x1d, 0x18, 0x35, 0xd4, 0x1b, 0xeb, 0x1e, 0xeb, 0x79,
-	0x40, 0x2d, 0x3f, 0x8a, 0xc2, 0x48, 0x7f, 0xe6, 0x52, 0xa4, 0x04, 0x61, 0x91, 0x2c, 0x2b, 0x5d,
-	0xa5, 0x38, 0xa7, 0x86, 0xfb, 0xcf, 0xfb, 0x21, 0x0d, 0xe1, 0x57, 0x99, 0xaa, 0x9d, 0x9e, 0x99,
-	0xaa, 0x1f, 0x87, 0xa1, 0x17, 0xe3, 0x30, 0x58, 0x4b, 0xf3, 0x59, 0xab, 0x6f, 0xf1, 0x74, 0x65,
-	0xf5, 0x1a, 0xc3, 0x54, 0x18, 0x0c, 0xfb, 0xa5, 0x79, 0xbf, 0x99, 0x74, 0x27, 0x3c, 0x7e, 0xfa,
-	0x19, 0x0e, 0xc7, 0x0a, 0x83, 0xbd, 0x78, 0xb9, 0x4d, 0x94, 0x97, 0x23, 0x7d, 0xf1, 0x92, 0x3f,
-	0xb3, 0xc2, 0xca, 0xd0, 0x25, 0x18, 0x56, 0x1e, 0x12, 0xe1, 0x76, 0x51, 0x23, 0xa5, 0xdc, 0x28,
-	0x38, 0xc5, 0x61, 0xba, 0xab, 0xb0, 0xaa, 0x0b, 0x6b, 0x4f, 0xc5, 0xc6, 0x49, 0x2a, 0x63, 0xa7,
-	0xe7, 0x1b, 0x96, 0x04, 0x63, 0xc5, 0x32, 0xcf, 0x6b, 0x3f, 0x7c, 0x2c, 0x5e, 0x7b, 0xed, 0x3e,
-	0x49, 0xf1, 0xa0, 0xf7, 0x49, 0xcc, 0xb9, 0x3d, 0x74, 0xa0, 0xb9, 0xfd, 0x99, 0x3e, 0x18, 0x7c,
-	0x96, 0x44, 0xec, 0xa9, 0x80, 0xc7, 0x60, 0x70, 0x9b, 0xff, 0x9b, 0xbd, 0x8c, 0x2c, 0x30, 0xb0,
-	0x2c, 0xa7, 0xdf, 0x6d, 0xa3, 0xe3, 0x37, 0x6b, 0x73, 0xe9, 0x2a, 0x4e, 0x53, 0x79, 0xca, 0x02,
-	0x9c, 0xe2, 0xd0, 0x0a, 0x75, 0x7a, 0x08, 0x69, 0xb5, 0xfc, 0x24, 0x1b, 0x84, 0xb7, 0x20, 0x0b,
-	0x70, 0x8a, 0x83, 0x1e, 0x81, 0x81, 0xba, 0x9f, 0xac, 0x7b, 0xf5, 0xac, 0xdb, 0x77, 0x81, 0x41,
-	0xb1, 0x28, 0x65, 0x3e, 0x3f, 0x3f, 0x59, 0x8f, 0x08, 0x33, 0x42, 0x77, 0x65, 0x53, 0x59, 0xd0,
-	0xca, 0xb0, 0x81, 0xc9, 0x9a, 0x14, 0x8a, 0x9e, 0x89, 0x08, 0xe4, 0xb4, 0x49, 0xb2, 0x00, 0xa7,
-	0x38, 0x74, 0xfe, 0x57, 0xc3, 0x56, 0xdb, 0x6f, 0x8a, 0xd8, 0x78, 0x6d, 0xfe, 0xcf, 0x0a, 0x38,
-	0x56, 0x18, 0x14, 0x9b, 0x8a, 0x30, 0x2a, 0x7e, 0xb2, 0xaf, 0x0b, 0xae, 0x09, 0x38, 0x56, 0x18,
-	0xee, 0xb3, 0x30, 0xca, 0x57, 0xf2, 0x6c, 0xd3, 0xf3, 0x5b, 0x0b, 0xb3, 0xe8, 0x4a, 0xd7, 0x7d,
-	0x92, 0xc7, 0x72, 0xee, 0x93, 0x9c, 0x33, 0x2a, 0x75, 0xdf, 0x2b, 0x71, 0x7f, 0x58, 0x80, 0xa1,
-	0x13, 0x7c, 0xa0, 0xf5, 0xc4, 0xdf, 0x1a, 0x47, 0xb7, 0x32, 0x8f, 0xb3, 0xae, 0xd9, 0xbc, 0x1e,
-	0xb6, 0xef, 0xc3, 0xac, 0xff, 0xad, 0x00, 0xe7, 0x25, 0xaa, 0x3c, 0x76, 0x2e, 0xcc, 0xb2, 0x47,
-	0xef, 0x8e, 0x7f, 0xa0, 0x23, 0x63, 0xa0, 0xd7, 0xec, 0x1d, 0x9c, 0x17, 0x66, 0x7b, 0x0e, 0xf5,
-	0xcb, 0x99, 0xa1, 0xc6, 0x56, 0xb9, 0xee, 0x3f, 0xd8, 0x7f, 0xee, 0xc0, 0x44, 0xfe, 0x60, 0x9f,
-	0xc0, 0x7b, 0xb8, 0xaf, 0x99, 0xef, 0xe1, 0xfe, 0x82, 0xbd, 0x29, 0x66, 0x76, 0xa5, 0xc7, 0xcb,
-	0xb8, 0xff, 0xd3, 0x81, 0xb3, 0xb2, 0x02, 0xdb, 0x3d, 0x67, 0xfc, 0x80, 0x45, 0x26, 0x1d, 0xff,
-	0x34, 0x7b, 0xd5, 0x98, 0x66, 0xcf, 0xdb, 0xeb, 0xb8, 0xde, 0x8f, 0x5e, 0x13, 0xce, 0xfd, 0x33,
-	0x07, 0xca, 0x79, 0x15, 0x4e, 0xe0, 0x93, 0xbf, 0x62, 0x7e, 0xf2, 0x67, 0x8f, 0xa7, 0xe7, 0xbd,
-	0x3f, 0x78, 0xb9, 0xd7, 0x40, 0xa1, 0xa6, 0xd4, 0xab, 0x1c, 0x5b, 0xee, 0x73, 0xce, 0x22, 0x5f,
-	0x41, 0x6b, 0xc2, 0x40, 0xcc, 0x42, 0x70, 0xc4, 0x14, 0xb8, 0x6a, 0x43, 0xdb, 0xa2, 0xf4, 0x84,
-	0x3b, 0x80, 0xfd, 0x8f, 0x05, 0x0f, 0xf7, 0x37, 0x0b, 0x70, 0x41, 0xbd, 0x73, 0x4d, 0xb6, 0x49,
-	0x33, 0x5d, 0x1f, 0xec, 0x55, 0x14, 0x4f, 0xfd, 0xb4, 0xf7, 0x2a, 0x4a, 0xca, 0x22, 0x5d, 0x0b,
-	0x29, 0x0c, 0x6b, 0x3c, 0x51, 0x05, 0xce, 0xb1, 0x57, 0x4c, 0xe6, 0xfd, 0xc0, 0x6b, 0xfa, 0x2f,
-	0x93, 0x08, 0x93, 0x56, 0xb8, 0xed, 0x35, 0x85, 0xa6, 0xae, 0xee, 0xa3, 0xcf, 0xe7, 0x21, 0xe1,
-	0xfc, 0xba, 0x5d, 0x66, 0x84, 0xbe, 0x83, 0x9a, 0x11, 0xdc, 0x3f, 0x76, 0x60, 0xe4, 0x04, 0x5f,
-	0x05, 0x0f, 0xcd, 0x25, 0xf1, 0xb4, 0xbd, 0x25, 0xd1, 0x63, 0x19, 0xec, 0x16, 0xa1, 0xeb, 0xa1,
-	0x64, 0xf4, 0x59, 0x47, 0x05, 0x29, 0xf1, 0x60, 0xd0, 0x8f, 0xd8, 0x6b, 0xc7, 0x61, 0xb2, 0xa6,
-	0xa2, 0xaf, 0x67, 0xec, 0x01, 0x05, 0x5b, 0x09, 0xce, 0xba, 0x5a, 0x73, 0x84, 0x94, 0xb2, 0x5f,
-	0x71, 0x00, 0x78, 0x3b, 0x45, 0xca, 0x7a, 0xda, 0xb6, 0x8d, 0x63, 0x1b, 0x29, 0xca, 0x84, 0x37,
-	0x4d, 0x2d, 0xa1, 0xb4, 0x00, 0x6b, 0x2d, 0xb9, 0x8b, 0x5c, 0xb1, 0x77, 0x9d, 0xa6, 0xf6, 0x8b,
-	0x0e, 0x9c, 0xca, 0x34, 0x37, 0xa7, 0xfe, 0xa6, 0xf9, 0xae, 0xa7, 0x05, 0xcd, 0xca, 0x4c, 0x64,
-	0xae, 0x1b, 0x4f, 0xfe, 0xa5, 0x0b, 0xc6, 0x0b, 0xf3, 0xe8, 0x15, 0x18, 0x96, 0x96, 0x0f, 0x39,
-	0xbd, 0x6d, 0xbe, 0x6f, 0xac, 0x8e, 0x37, 0x12, 0x12, 0xe3, 0x94, 0x5f, 0x26, 0x06, 0xb2, 0x70,
-	0xa0, 0x18, 0xc8, 0xb7, 0xf7, 0x75, 0xe4, 0x7c, 0x63, 0x7b, 0xff, 0xb1, 0x18, 0xdb, 0xef, 0xb7,
-	0x6e, 0x6c, 0x7f, 0xe0, 0x84, 0x8d, 0xed, 0x9a, 0x3f, 0xb3, 0x78, 0x17, 0xfe, 0xcc, 0x57, 0xe0,
-	0xec, 0x76, 0x7a, 0xe8, 0x54, 0x33, 0x49, 0x24, 0xc5, 0x7a, 0x2c, 0xd7, 0xc4, 0x4e, 0x0f, 0xd0,
-	0x71, 0x42, 0x82, 0x44, 0x3b, 0xae, 0xa6, 0xe1, 0x97, 0xcf, 0xe6, 0x90, 0xc3, 0xb9, 0x4c, 0xb2,
-	0x8e, 0xa9, 0xc1, 0x03, 0x38, 0xa6, 0xbe, 0xe3, 0xc0, 0x39, 0xaf, 0xeb, 0x02, 0x23, 0x26, 0x9b,
-	0x22, 0x3a, 0xe6, 0x86, 0x3d, 0x15, 0xc2, 0x20, 0x2f, 0x3c, 0x80, 0x79, 0x45, 0x38, 0xbf, 0x41,
-	0xe8, 0xe1, 0x34, 0x4a, 0x80, 0x07, 0xed, 0xe6, 0xbb, 0xf4, 0xbf, 0x9e, 0x0d, 0x3d, 0x02, 0x36,
-	0xf4, 0x1f, 0xb3, 0x7b, 0xda, 0xb6, 0x10, 0x7e, 0x54, 0xba, 0x8b, 0xf0, 0xa3, 0x8c, 0x97, 0x70,
-	0xc4, 0x92, 0x97, 0x30, 0x80, 0x71, 0xbf, 0xe5, 0xd5, 0xc9, 0x5a, 0xa7, 0xd9, 0xe4, 0x37, 0x92,
-	0xe4, 0x0b, 0xd4, 0xb9, 0x16, 0xbc, 0xe5, 0xb0, 0xea, 0x35, 0x45, 0xce, 0x0f, 0x15, 0xb0, 0xac,
-	0x6e, 0x5e, 0x2d, 0x66, 0x28, 0xe1, 0x2e, 0xda, 0x74, 0xc2, 0xb2, 0xfc, 0x8e, 0x24, 0xa1, 0xa3,
-	0xcd, 0x62, 0x5c, 0x86, 0xf8, 0x84, 0xbd, 0x9a, 0x82, 0xb1, 0x8e, 0x83, 0x96, 0x60, 0xb8, 0x16,
-	0xc4, 0xe2, 0x2e, 0xf6, 0x29, 0x26, 0xcc, 0xde, 0x45, 0x45, 0xe0, 0xdc, 0xb5, 0x8a, 0xba, 0x85,
-	0x7d, 0x7f, 0x4e, 0xc2, 0x52, 0x55, 0x8e, 0xd3, 0xfa, 0x68, 0x85, 0x11, 0x13, 0x6f, 0xeb, 0xf1,
-	0xd0, 0x93, 0x07, 0x7b, 0x78, 0xc1, 0xe6, 0xae, 0xc9, 0xd7, 0x01, 0x47, 0x05, 0x3b, 0xf1, 0x48,
-	0x5e, 0x4a, 0x41, 0x7b, 0x09, 0xfc, 0xf4, 0xbe, 0x2f, 0x81, 0xb3, 0x4c, 0xc5, 0x49, 0x53, 0x79,
-	0xb2, 0x2f, 0x5a, 0xcb, 0x54, 0x9c, 0x06, 0x75, 0x8a, 0x4c, 0xc5, 0x29, 0x00, 0xeb, 0x2c, 0xd1,
-	0x6a, 0x2f, 0x8f, 0xfe, 0x19, 0x26, 0x34, 0x0e, 0xef, 0x9f, 0xd7, 0x43, 0xbf, 0xcf, 0xee, 0x17,
-	0xfa, 0xdd, 0xed, 0x8a, 0x3e, 0x77, 0x08, 0x57, 0x74, 0x83, 0xe5, 0x90, 0x5d, 0x98, 0x15, 0xde,
-	0x7f, 0x0b, 0xe7, 0x3b, 0x96, 0x73, 0x86, 0x07, 0xc9, 0xb2, 0x7f, 0x31, 0x67, 0xd0, 0x33, 0x3a,
-	0xfe, 0xc2, 0x91, 0xa3, 0xe3, 0x33, 0xfe, 0xdc, 0x7b, 0x8f, 0xcd, 0x9f, 0x3b, 0x71, 0x02, 0xfe,
-	0xdc, 0xfb, 0x0e, 0xec, 0xcf, 0xbd, 0x05, 0x67, 0xda, 0x61, 0x6d, 0xce, 0x8f, 0xa3, 0x0e, 0xbb,
-	0x6f, 0x39, 0xd3, 0xa9, 0xd5, 0x49, 0xc2, 0x1c, 0xc2, 0xa5, 0xcb, 0xef, 0xd2, 0x1b, 0xd9, 0x66,
-	0xab, 0x52, 0x2e, 0xb8, 0x4c, 0x05, 0x66, 0x07, 0x61, 0xd1, 0xbe, 0x39, 0x85, 0x38, 0x8f, 0x85,
-	0xee, 0x49, 0x7e, 0xf0, 0x64, 0x3c, 0xc9, 0x1f, 0x82, 0xa1, 0xb8, 0xd1, 0x49, 0x6a, 0xe1, 0xcd,
-	0x80, 0x85, 0x0b, 0x0c, 0xcf, 0xbc, 0x43, 0xd9, 0xa5, 0x05, 0xfc, 0xf6, 0xee, 0xe4, 0xb8, 0xfc,
-	0x5f, 0x33, 0x49, 0x0b, 0x08, 0xfa, 0x46, 0x8f, 0x9b, 0x55, 0xee, 0x71, 0xde, 0xac, 0xba, 0x70,
-	0xa8, 0x5b, 0x55, 0x79, 0xee, 0xf2, 0x87, 0x7e, 0xe6, 0xdc, 0xe5, 0x5f, 0x73, 0x60, 0x74, 0x5b,
-	0xb7, 0xff, 0x0b, 0x97, 0xbe, 0x85, 0x80, 0x21, 0xc3, 0xad, 0x30, 0xe3, 0x52, 0xa1, 0x65, 0x80,
-	0x6e, 0x67, 0x01, 0xd8, 0x6c, 0x49, 0x4e, 0x30, 0xd3, 0xc3, 0x6f, 0x57, 0x30, 0xd3, 0x6b, 0x50,
-	0x6a, 0x87, 0x35, 0x79, 0x62, 0x65, 0x7e, 0x7e, 0xbb, 0xb1, 0xcc, 0x5c, 0xff, 0x4c, 0x59, 0x60,
-	0x9d, 0x1f, 0xfa, 0x82, 0x03, 0xe3, 0xf2, 0x90, 0x25, 0xfc, 0x77, 0xb1, 0x88, 0xc6, 0xb4, 0x79,
-	0xb6, 0xe3, 0x49, 0x8d, 0x33, 0x7c, 0x70, 0x17, 0x67, 0xaa, 0x90, 0xa8, 0xe0, 0xb7, 0x7a, 0xcc,
-	0x82, 0x8e, 0x85, 0x42, 0x32, 0x9d, 0x82, 0xb1, 0x8e, 0x83, 0xbe, 0xe9, 0x40, 0xb1, 0x11, 0x86,
-	0x5b, 0x71, 0xf9, 0x31, 0x26, 0xd0, 0x9f, 0xb3, 0xac, 0x68, 0x5e, 0xa5, 0xb4, 0xb9, 0x86, 0xf9,
-	0x84, 0x34, 0x04, 0x31, 0xd8, 0xed, 0xdd, 0xc9, 0x31, 0xe3, 0x3d, 0xae, 0xf8, 0xf5, 0xb7, 0x34,
-	0x88, 0x30, 0x54, 0xb2, 0xa6, 0xa1, 0x37, 0x1d, 0x18, 0xbf, 0x99, 0xb1, 0x4e, 0x88, 0x70, 0x54,
-	0x6c, 0xdf, 0xee, 0xc1, 0x87, 0x3b, 0x0b, 0xc5, 0x5d, 0x2d, 0x40, 0x9f, 0x37, 0xad, 0x96, 0x3c,
-	0x6e, 0xd5, 0xe2, 0x00, 0x66, 0xac, 0xa4, 0xfc, 0x3a, 0x52, 0xbe, 0xf9, 0xf2, 0xee, 0x83, 0x45,
-	0x68, 0x67, 0xd2, 0x8f, 0x95, 0x53, 0x95, 0x98, 0xc6, 0x13, 0x0b, 0x8b, 0xdd, 0xf8, 0xfc, 0xba,
-	0xed, 0xe4, 0xcd, 0xf3, 0x30, 0x66, 0x3a, 0xea, 0xd0, 0x7b, 0xcc, 0x37, 0x51, 0x2e, 0x66, 0x9f,
-	0x97, 0x18, 0x95, 0xf8, 0xc6, 0x13, 0x13, 0xc6, 0x1b, 0x10, 0x85, 0x63, 0x7d, 0x03, 0xa2, 0xef,
-	0x64, 0xde, 0x80, 0x18, 0x3f, 0x8e, 0x37, 0x20, 0x4e, 0x1f, 0xea, 0x0d, 0x08, 0xed, 0x0d, 0x8e,
-	0xfe, 0x3b, 0xbc, 0xc1, 0x31, 0x0d, 0xa7, 0xe4, 0x9d, 0x23, 0x22, 0xd2, 0xec, 0x73, 0x1f, 0xbe,
-	0x7a, 0x26, 0x7e, 0xd6, 0x2c, 0xc6, 0x59, 0x7c, 0xba, 0xc8, 0x8a, 0x01, 0xab, 0x39, 0x60, 0x2b,
-	0x28, 0xcb, 0x9c, 0x5a, 0xec, 0x2c, 0x2c, 0x44, 0x94, 0x8c, 0xb2, 0x2e, 0x32, 0xd8, 0x6d, 0xf9,
-	0x0f, 0xe6, 0x2d, 0x40, 0x2f, 0x40, 0x39, 0xdc, 0xdc, 0x6c, 0x86, 0x5e, 0x2d, 0x7d, 0xa8, 0x42,
-	0x06, 0x19, 0xf0, 0x5b, 0xb5, 0x2a, 0x2b, 0xf1, 0x6a, 0x0f, 0x3c, 0xdc, 0x93, 0x02, 0xfa, 0x0e,
-	0x55, 0x4c, 0x92, 0x30, 0x22, 0xb5, 0xd4, 0xf0, 0x32, 0xcc, 0xfa, 0x4c, 0xac, 0xf7, 0xb9, 0x62,
-	0xf2, 0xe1, 0xbd, 0x57, 0x1f, 0x25, 0x53, 0x8a, 0xb3, 0xcd, 0x42, 0x11, 0x9c, 0x6f, 0xe7, 0xd9,
-	0x7d, 0x62, 0x71, 0x53, 0x6a, 0x3f, 0xeb, 0x93, 0x7a, 0x0c, 0x3d, 0xd7, 0x72, 0x14, 0xe3, 0x1e,
-	0x94, 0xf5, 0xc7, 0x24, 0x86, 0x4e, 0xe6, 0x31, 0x89, 0x4f, 0x00, 0x54, 0x65, 0x52, 0x3a, 0x69,
-	0x49, 0x58, 0xb2, 0x72, 0x85, 0x87, 0xd3, 0xd4, 0xde, 0x05, 0x56, 0x6c, 0xb0, 0xc6, 0x12, 0xfd,
-	0xdf, 0xdc, 0xd7, 0x56, 0xb8, 0xb9, 0xa4, 0x6e, 0x7d, 0x4e, 0xfc, 0xcc, 0xbd, 0xb8, 0xf2, 0x4f,
-	0x1c, 0x98, 0xe0, 0x33, 0x2f, 0xab, 0xdc, 0x53, 0xd5, 0x42, 0xdc, 0x29, 0xb2, 0x1d, 0x87, 0xc2,
-	0x93, 0x4b, 0x19, 0x5c, 0x99, 0xd7, 0x7a, 0x9f, 0x96, 0xa0, 0xaf, 0xe4, 0x1c, 0x29, 0x4e, 0xd9,
-	0x32, 0x40, 0xe6, 0xbf, 0x99, 0x71, 0x66, 0xef, 0x20, 0xa7, 0x88, 0x7f, 0xd6, 0xd3, 0x3e, 0x8a,
-	0x58, 0xf3, 0x7e, 0xf1, 0x98, 0xec, 0xa3, 0xfa, 0xc3, 0x1e, 0x87, 0xb2, 0x92, 0x7e, 0xd1, 0x81,
-	0x71, 0x2f, 0x13, 0x37, 0xc2, 0x8c, 0x3a, 0x56, 0x0c, 0x4c, 0xd3, 0x51, 0x1a, 0x8c, 0xc2, 0x94,
-	0xbc, 0x6c, 0x88, 0x0a, 0xee, 0x62, 0x8e, 0x7e, 0xe8, 0xc0, 0x7d, 0xe9, 0xeb, 0x21, 0x71, 0x7a,
-	0x47, 0x58, 0x34, 0xee, 0x2c, 0x5b, 0x8d, 0x2f, 0x59, 0x5f, 0x8d, 0xeb, 0xbd, 0x79, 0xf2, 0x75,
-	0xf9, 0x90, 0x58, 0x97, 0xf7, 0xed, 0x83, 0x89, 0xf7, 0x6b, 0xfa, 0xc4, 0x67, 0x1d, 0xfe, 0xbc,
-	0x5a, 0x4f, 0x95, 0x6f, 0xc3, 0x54, 0xf9, 0x96, 0x6d, 0x3e, 0xf0, 0xa4, 0xeb, 0x9e, 0xbf, 0xe2,
-	0xc0, 0xd9, 0xbc, 0x1d, 0x29, 0xa7, 0x49, 0x1f, 0x33, 0x9b, 0x64, 0xf1, 0x94, 0xa5, 0x37, 0xc8,
-	0xca, 0xeb, 0x30, 0x13, 0xd7, 0xe0, 0xc1, 0x3b, 0x7d, 0xc5, 0x3b, 0xd1, 0x1b, 0xd2, 0xd5, 0xe2,
-	0x3f, 0x1b, 0xd6, 0x5c, 0x8a, 0x09, 0x69, 0x5b, 0x0f, 0xc8, 0x0e, 0x60, 0xc0, 0x0f, 0x9a, 0x7e,
-	0x40, 0xc4, 0x3d, 0x51, 0x9b, 0x67, 0x58, 0xf1, 0x3e, 0x14, 0xa5, 0x8e, 0x05, 0x97, 0xb7, 0xd9,
-	0xc3, 0x98, 0x7d, 0x71, 0xaf, 0xff, 0xe4, 0x5f, 0xdc, 0xbb, 0x09, 0xc3, 0x37, 0xfd, 0xa4, 0xc1,
-	0x22, 0x23, 0x84, 0xe3, 0xce, 0xc2, 0xfd, 0x4a, 0x4a, 0x2e, 0xed, 0xfb, 0x0d, 0xc9, 0x00, 0xa7,
-	0xbc, 0xd0, 0x25, 0xce, 0x98, 0x85, 0x61, 0x67, 0xe3, 0x63, 0x6f, 0xc8, 0x02, 0x9c, 0xe2, 0xd0,
-	0xc1, 0x1a, 0xa1, 0xbf, 0x64, 0xb6, 0x2a, 0x91, 0x40, 0xda, 0x46, 0x62, 0x50, 0x41, 0x91, 0xdf,
-	0x62, 0xbe, 0xa1, 0xf1, 0xc0, 0x06, 0x47, 0x95, 0xc3, 0x7b, 0xa8, 0x67, 0x0e, 0xef, 0x57, 0x99,
-	0xc2, 0x96, 0xf8, 0x41, 0x87, 0xac, 0x06, 0x22, 0x78, 0x7b, 0xd9, 0xce, 0x9d, 0x6b, 0x4e, 0x93,
-	0x1f, 0xc1, 0xd3, 0xdf, 0x58, 0xe3, 0xa7, 0xf9, 0x4f, 0x4a, 0xfb, 0xfa, 0x4f, 0x52, 0x93, 0xcb,
-	0x88, 0x75, 0x93, 0x4b, 0x42, 0xda, 0x56, 0x4c, 0x2e, 0x3f, 0x53, 0xe6, 0x80, 0x3f, 0x77, 0x00,
-	0x29, 0xbd, 0x4b, 0x09, 0xd4, 0x13, 0x88, 0x90, 0xfc, 0xa4, 0x03, 0x10, 0xa8, 0x77, 0x59, 0xed,
-	0xee, 0x82, 0x9c, 0x66, 0xda, 0x80, 0x14, 0x86, 0x35, 0x9e, 0xee, 0x9f, 0x3a, 0x69, 0x20, 0x72,
-	0xda, 0xf7, 0x13, 0x88, 0x08, 0xdb, 0x31, 0x23, 0xc2, 0xd6, 0x2d, 0x9a, 0xee, 0x55, 0x37, 0x7a,
-	0xc4, 0x86, 0xfd, 0xa4, 0x00, 0xa7, 0x74, 0xe4, 0x0a, 0x39, 0x89, 0x8f, 0x7d, 0xd3, 0x08, 0x87,
-	0xbd, 0x6e, 0xb7, 0xbf, 0x15, 0xe1, 0x01, 0xca, 0x0b, 0xbd, 0xfe, 0x44, 0x26, 0xf4, 0xfa, 0x86,
-	0x7d, 0xd6, 0xfb, 0xc7, 0x5f, 0xff, 0x77, 0x07, 0xce, 0x64, 0x6a, 0x9c, 0xc0, 0x04, 0xdb, 0x36,
-	0x27, 0xd8, 0x33, 0xd6, 0x7b, 0xdd, 0x63, 0x76, 0x7d, 0xab, 0xd0, 0xd5, 0x5b, 0x76, 0x88, 0xfb,
-	0x8c, 0x03, 0x45, 0xaa, 0x2d, 0xcb, 0xe0, 0xac, 0x8f, 0x1d, 0xcb, 0x0c, 0x60, 0x7a, 0xbd, 0x90,
-	0xce, 0xaa, 0x7d, 0x0c, 0x86, 0x39, 0xf7, 0x89, 0x4f, 0x3b, 0x00, 0x29, 0xd2, 0xdb, 0xa5, 0x02,
-	0xbb, 0xdf, 0x2d, 0xc0, 0xb9, 0xdc, 0x69, 0x84, 0x3e, 0xa7, 0x2c, 0x72, 0x8e, 0xed, 0xd0, 0x43,
-	0x83, 0x91, 0x6e, 0x98, 0x1b, 0x35, 0x0c, 0x73, 0xc2, 0x1e, 0xf7, 0x76, 0x1d, 0x60, 0x84, 0x98,
-	0xd6, 0x06, 0xeb, 0xc7, 0x4e, 0x1a, 0xcd, 0xaa, 0xf2, 0x29, 0xfd, 0x25, 0xbc, 0x91, 0xe3, 0xfe,
-	0x44, 0xbb, 0xae, 0x20, 0x3b, 0x7a, 0x02, 0xb2, 0xe2, 0xa6, 0x29, 0x2b, 0xb0, 0x7d, 0x3f, 0x72,
-	0x0f, 0x61, 0xf1, 0x12, 0xe4, 0x39, 0x96, 0x0f, 0x96, 0xae, 0xd2, 0xb8, 0xdb, 0x5a, 0x38, 0xf0,
-	0xdd, 0xd6, 0x51, 0x28, 0x3d, 0xef, 0xab, 0x54, 0xa7, 0x33, 0x53, 0xdf, 0xfb, 0xd1, 0xc5, 0x7b,
-	0xbe, 0xff, 0xa3, 0x8b, 0xf7, 0xfc, 0xf0, 0x47, 0x17, 0xef, 0xf9, 0xe4, 0xde, 0x45, 0xe7, 0x7b,
-	0x7b, 0x17, 0x9d, 0xef, 0xef, 0x5d, 0x74, 0x7e, 0xb8, 0x77, 0xd1, 0xf9, 0x4f, 0x7b, 0x17, 0x9d,
-	0xbf, 0xf7, 0x27, 0x17, 0xef, 0x79, 0x7e, 0x48, 0x76, 0xec, 0x2f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xdc, 0x36, 0x17, 0xe9, 0xb5, 0xda, 0x00, 0x00,
+	// 12437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x24, 0x49,
+	0x56, 0x18, 0x3c, 0x91, 0x59, 0x59, 0x87, 0xd7, 0xd9, 0xd1, 0x57, 0x4c, 0xcd, 0x4c, 0x57, 0x13,
+	0xb3, 0x3b, 0xcc, 0xb0, 0xb3, 0xd5, 0x4c, 0xcf, 0xf2, 0x31, 0x1f, 0x48, 0xcb, 0xd6, 0xd1, 0xd5,
+	0xdd, 0xd3, 0xd5, 0x5d, 0x35, 0x2f, 0xab, 0xa7, 0xb5, 0x07, 0xcb, 0x46, 0x65, 0x7a, 0x55, 0xc6,
+	0x56, 0x66, 0x44, 0x4e, 0x44, 0x64, 0x77, 0xd7, 0xec, 0xec, 0xa1, 0xe5, 0x5c, 0x58, 0x58, 0x40,
+	0xcb, 0xc2, 0xae, 0x90, 0x84, 0x10, 0x48, 0x18, 0x60, 0x32, 0x83, 0x5f, 0x32, 0x21, 0x93, 0x61,
+	0xfa, 0x81, 0x81, 0xc9, 0x24, 0x03, 0x13, 0x66, 0xec, 0x0f, 0xe8, 0x11, 0x8d, 0x84, 0xc9, 0x24,
+	0xc3, 0x64, 0xa0, 0x93, 0x96, 0x84, 0xc9, 0x9e, 0x5f, 0xe1, 0x1e, 0x19, 0x59, 0x9d, 0x55, 0xed,
+	0xd5, 0x33, 0x06, 0xfa, 0x55, 0x95, 0xcf, 0x9f, 0xbf, 0xe7, 0xee, 0xe1, 0xc7, 0xf3, 0x77, 0x39,
+	0xd9, 0xdc, 0x0d, 0xb3, 0x56, 0x6f, 0x7b, 0xb1, 0x11, 0x77, 0x2e, 0x04, 0xc9, 0x6e, 0xdc, 0x4d,
+	0xe2, 0x4f, 0xb2, 0x7f, 0xde, 0x7f, 0x27, 0x4e, 0xf6, 0x76, 0xda, 0xf1, 0x9d, 0xf4, 0xc2, 0xed,
+	0x97, 0x2f, 0x74, 0xf7, 0x76, 0x2f, 0x04, 0xdd, 0x30, 0xbd, 0x20, 0xa1, 0x17, 0x6e, 0xbf, 0x14,
+	0xb4, 0xbb, 0xad, 0xe0, 0xa5, 0x0b, 0xbb, 0x34, 0xa2, 0x49, 0x90, 0xd1, 0xe6, 0x62, 0x37, 0x89,
+	0xb3, 0xd8, 0xfd, 0x50, 0x4e, 0x71, 0x51, 0x52, 0x64, 0xff, 0x7c, 0x97, 0xa2, 0xb8, 0x78, 0xfb,
+	0xe5, 0xc5, 0xee, 0xde, 0xee, 0x22, 0x52, 0x5c, 0x94, 0xd0, 0x45, 0x49, 0x71, 0xfe, 0xfd, 0x5a,
+	0x9b, 0x76, 0xe3, 0xdd, 0xf8, 0x02, 0x23, 0xbc, 0xdd, 0xdb, 0x61, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f,
+	0x67, 0x38, 0xef, 0xef, 0xbd, 0x92, 0x2e, 0x86, 0x31, 0xb6, 0xef, 0x42, 0x23, 0x4e, 0xe8, 0x85,
+	0xdb, 0x7d, 0x8d, 0x9a, 0x7f, 0x8f, 0x86, 0xd3, 0x8d, 0xdb, 0x61, 0x63, 0xbf, 0x0c, 0xeb, 0x03,
+	0x39, 0x56, 0x27, 0x68, 0xb4, 0xc2, 0x88, 0x26, 0xfb, 0x79, 0xd7, 0x3b, 0x34, 0x0b, 0xca, 0x6a,
+	0x5d, 0x18, 0x54, 0x2b, 0xe9, 0x45, 0x59, 0xd8, 0xa1, 0x7d, 0x15, 0xfe, 0xbf, 0x87, 0x55, 0x48,
+	0x1b, 0x2d, 0xda, 0x09, 0xfa, 0xea, 0xbd, 0x3c, 0xa8, 0x5e, 0x2f, 0x0b, 0xdb, 0x17, 0xc2, 0x28,
+	0x4b, 0xb3, 0xa4, 0x58, 0xc9, 0xbf, 0x44, 0x46, 0x97, 0x3a, 0x71, 0x2f, 0xca, 0xdc, 0x6f, 0x27,
+	0xb5, 0xdb, 0x41, 0xbb, 0x47, 0x3d, 0xe7, 0xbc, 0xf3, 0xfc, 0xc4, 0xf2, 0x7b, 0x7f, 0xf3, 0xde,
+	0xc2, 0x13, 0xf7, 0xef, 0x2d, 0xd4, 0x5e, 0x47, 0xe0, 0x83, 0x7b, 0x0b, 0xa7, 0x68, 0xd4, 0x88,
+	0x9b, 0x61, 0xb4, 0x7b, 0xe1, 0x93, 0x69, 0x1c, 0x2d, 0xde, 0xe8, 0x75, 0xb6, 0x69, 0x02, 0xbc,
+	0x8e, 0xff, 0x6f, 0x2a, 0x64, 0x76, 0x29, 0x69, 0xb4, 0xc2, 0xdb, 0xb4, 0x9e, 0x21, 0xfd, 0xdd,
+	0x7d, 0xb7, 0x45, 0xaa, 0x59, 0x90, 0x30, 0x72, 0x93, 0x17, 0xaf, 0x2f, 0x3e, 0xea, 0x77, 0x5f,
+	0xdc, 0x0a, 0x12, 0x49, 0x7b, 0x79, 0xec, 0xfe, 0xbd, 0x85, 0xea, 0x56, 0x90, 0x00, 0xb2, 0x70,
+	0xdb, 0x64, 0x24, 0x8a, 0x23, 0xea, 0x55, 0x18, 0xab, 0x1b, 0x8f, 0xce, 0xea, 0x46, 0x1c, 0xa9,
+	0x7e, 0x2c, 0x8f, 0xdf, 0xbf, 0xb7, 0x30, 0x82, 0x10, 0x60, 0x5c, 0xb0, 0x5f, 0x6f, 0x86, 0x5d,
+	0xaf, 0x6a, 0xab, 0x5f, 0x1f, 0x09, 0xbb, 0x66, 0xbf, 0x3e, 0x12, 0x76, 0x01, 0x59, 0xf8, 0x5f,
+	0xa8, 0x90, 0x89, 0xa5, 0x64, 0xb7, 0xd7, 0xa1, 0x51, 0x96, 0xba, 0x9f, 0x25, 0xa4, 0x1b, 0x24,
+	0x41, 0x87, 0x66, 0x34, 0x49, 0x3d, 0xe7, 0x7c, 0xf5, 0xf9, 0xc9, 0x8b, 0xd7, 0x1e, 0x9d, 0xfd,
+	0xa6, 0xa4, 0xb9, 0xec, 0x8a, 0x4f, 0x4e, 0x14, 0x28, 0x05, 0x8d, 0xa5, 0xfb, 0x29, 0x32, 0x11,
+	0x24, 0x59, 0xb8, 0x13, 0x34, 0xb2, 0xd4, 0xab, 0x30, 0xfe, 0xaf, 0x3e, 0x3a, 0xff, 0x25, 0x41,
+	0x72, 0xf9, 0x84, 0x60, 0x3f, 0x21, 0x21, 0x29, 0xe4, 0xfc, 0xfc, 0x7f, 0x3a, 0x42, 0x26, 0x97,
+	0x92, 0xec, 0xf2, 0x4a, 0x3d, 0x0b, 0xb2, 0x5e, 0xea, 0xfe, 0x4b, 0x87, 0x9c, 0x4c, 0xf9, 0xb0,
+	0x85, 0x34, 0xdd, 0x4c, 0xe2, 0x06, 0x4d, 0x53, 0xda, 0x14, 0xe3, 0xb2, 0x63, 0xa5, 0x5d, 0x92,
+	0xd9, 0x62, 0xbd, 0x9f, 0xd1, 0xa5, 0x28, 0x4b, 0xf6, 0x97, 0x5f, 0x12, 0x6d, 0x3e, 0x59, 0x82,
+	0xf1, 0xf9, 0xb7, 0x17, 0x5c, 0xd9, 0x95, 0xcb, 0x2b, 0x02, 0x61, 0x1f, 0xca, 0x5a, 0xed, 0x7e,
+	0xd5, 0x21, 0x53, 0xdd, 0xb8, 0x99, 0x02, 0x6d, 0xc4, 0xbd, 0x2e, 0x6d, 0x8a, 0xe1, 0xfd, 0x2e,
+	0xbb, 0xdd, 0xd8, 0xd4, 0x38, 0xf0, 0xf6, 0x9f, 0x12, 0xed, 0x9f, 0xd2, 0x8b, 0xc0, 0x68, 0x8a,
+	0xfb, 0x0a, 0x99, 0x8a, 0xe2, 0xac, 0xde, 0xa5, 0x8d, 0x70, 0x27, 0xa4, 0x4d, 0x36, 0xf1, 0xc7,
+	0xf3, 0x9a, 0x37, 0xb4, 0x32, 0x30, 0x30, 0xe7, 0xd7, 0x88, 0x37, 0x68, 0xe4, 0xdc, 0x39, 0x52,
+	0xdd, 0xa3, 0xfb, 0x7c, 0xb3, 0x01, 0xfc, 0xd7, 0x3d, 0x25, 0x37, 0x20, 0x5c, 0xc6, 0xe3, 0x62,
+	0x67, 0xf9, 0xb6, 0xca, 0x2b, 0xce, 0xfc, 0x77, 0x90, 0x13, 0x7d, 0x4d, 0x3f, 0x0c, 0x01, 0xff,
+	0x57, 0xc7, 0xc8, 0xb8, 0xfc, 0x14, 0xee, 0x79, 0x32, 0x12, 0x05, 0x1d, 0xb9, 0xcf, 0x4d, 0x89,
+	0x7e, 0x8c, 0xdc, 0x08, 0x3a, 0xb8, 0xc2, 0x83, 0x0e, 0x45, 0x8c, 0x6e, 0x90, 0xb5, 0xbc, 0x8a,
+	0x89, 0xb1, 0x19, 0x64, 0x2d, 0x60, 0x25, 0xee, 0xd3, 0x64, 0xa4, 0x13, 0x37, 0x29, 0x1b, 0x8b,
+	0x1a, 0xdf, 0x21, 0xae, 0xc7, 0x4d, 0x0a, 0x0c, 0x8a, 0xf5, 0x77, 0x92, 0xb8, 0xe3, 0x8d, 0x98,
+	0xf5, 0xd7, 0x92, 0xb8, 0x03, 0xac, 0xc4, 0xfd, 0x29, 0x87, 0xcc, 0xc9, 0xb9, 0xbd, 0x1e, 0x37,
+	0x82, 0x2c, 0x8c, 0x23, 0xaf, 0xc6, 0x76, 0x14, 0xb0, 0xb7, 0xa4, 0x24, 0xe5, 0x65, 0x4f, 0x34,
+	0x61, 0xae, 0x58, 0x02, 0x7d, 0xad, 0x70, 0x2f, 0x12, 0xb2, 0xdb, 0x8e, 0xb7, 0x83, 0x36, 0x0e,
+	0x88, 0x37, 0xca, 0xba, 0xa0, 0x76, 0x86, 0xcb, 0xaa, 0x04, 0x34, 0x2c, 0xf7, 0x2e, 0x19, 0x0b,
+	0xf8, 0xee, 0xef, 0x8d, 0xb1, 0x4e, 0xbc, 0x66, 0xa3, 0x13, 0xc6, 0x71, 0xb2, 0x3c, 0x79, 0xff,
+	0xde, 0xc2, 0x98, 0x00, 0x82, 0x64, 0xe7, 0xbe, 0x48, 0xc6, 0xe3, 0x2e, 0xb6, 0x3b, 0x68, 0x7b,
+	0xe3, 0x6c, 0x62, 0xce, 0x89, 0xb6, 0x8e, 0x6f, 0x08, 0x38, 0x28, 0x0c, 0xf7, 0x05, 0x32, 0x96,
+	0xf6, 0xb6, 0xf1, 0x3b, 0x7a, 0x13, 0xac, 0x63, 0xb3, 0x02, 0x79, 0xac, 0xce, 0xc1, 0x20, 0xcb,
+	0xdd, 0x6f, 0x21, 0x93, 0x09, 0x6d, 0xf4, 0x92, 0x94, 0xe2, 0x87, 0xf5, 0x08, 0xa3, 0x7d, 0x52,
+	0xa0, 0x4f, 0x42, 0x5e, 0x04, 0x3a, 0x9e, 0xfb, 0x41, 0x32, 0x83, 0x1f, 0xf8, 0xd2, 0xdd, 0x6e,
+	0x42, 0xd3, 0x14, 0xbf, 0xea, 0x24, 0x63, 0x74, 0x46, 0xd4, 0x9c, 0x59, 0x33, 0x4a, 0xa1, 0x80,
+	0xed, 0xbe, 0x45, 0x48, 0xa0, 0xf6, 0x0c, 0x6f, 0x8a, 0x0d, 0xe6, 0xba, 0xbd, 0x19, 0x71, 0x79,
+	0x65, 0x79, 0x06, 0xbf, 0x63, 0xfe, 0x1b, 0x34, 0x7e, 0x38, 0x3e, 0x4d, 0xda, 0xa6, 0x19, 0x6d,
+	0x7a, 0xd3, 0xac, 0xc3, 0x6a, 0x7c, 0x56, 0x39, 0x18, 0x64, 0x39, 0x4e, 0x93, 0x84, 0xa6, 0xbd,
+	0x76, 0x96, 0x5e, 0xa3, 0xfb, 0xde, 0x8c, 0x39, 0x4d, 0x40, 0x95, 0x80, 0x86, 0x85, 0x1f, 0xab,
+	0xd1, 0xa2, 0x8d, 0xbd, 0xb4, 0xd7, 0xf1, 0x66, 0x59, 0x0d, 0xf5, 0xb1, 0x56, 0x04, 0x1c, 0x14,
+	0x86, 0xff, 0xb7, 0x2b, 0x44, 0x6b, 0xa7, 0xbb, 0x4c, 0xc6, 0xc5, 0xce, 0x29, 0x16, 0xfd, 0xf2,
+	0x73, 0xb2, 0xb2, 0x9c, 0x23, 0x0f, 0xee, 0x95, 0xee, 0xb8, 0xaa, 0x9e, 0xfb, 0x69, 0x32, 0xd9,
+	0x8d, 0x9b, 0xd7, 0x69, 0x16, 0x34, 0x83, 0x2c, 0x10, 0xf2, 0x82, 0x85, 0x33, 0x4c, 0x52, 0x5c,
+	0x9e, 0xc5, 0xc9, 0xb1, 0x99, 0xb3, 0x00, 0x9d, 0x9f, 0xfb, 0x2a, 0x71, 0x53, 0x9a, 0xdc, 0x0e,
+	0x1b, 0x74, 0xa9, 0xd1, 0x40, 0xa1, 0x8b, 0x2d, 0xb1, 0x2a, 0xeb, 0xcc, 0xbc, 0xe8, 0x8c, 0x5b,
+	0xef, 0xc3, 0x80, 0x92, 0x5a, 0xfe, 0xef, 0x56, 0xc8, 0x8c, 0xd6, 0xd7, 0x2e, 0x6d, 0xb8, 0xbf,
+	0xe0, 0x90, 0x59, 0x75, 0x60, 0x2e, 0xef, 0xdf, 0xc0, 0x79, 0xcb, 0x8f, 0x43, 0x6a, 0x73, 0x06,
+	0x21, 0xaf, 0xc5, 0x25, 0x93, 0x0f, 0x3f, 0x4d, 0xce, 0x8a, 0x3e, 0xcc, 0x16, 0x4a, 0xa1, 0xd8,
+	0xac, 0xf9, 0xaf, 0x38, 0xe4, 0x54, 0x19, 0x89, 0x92, 0x5d, 0xbd, 0xa5, 0xef, 0xea, 0x56, 0xb7,
+	0x47, 0xe4, 0x8a, 0x9d, 0xd1, 0x4f, 0x8a, 0xbf, 0xa8, 0x90, 0x39, 0x7d, 0x0a, 0x31, 0x59, 0xe3,
+	0x5f, 0x38, 0xe4, 0xb4, 0xec, 0x81, 0x98, 0xda, 0xc6, 0xf0, 0x76, 0xac, 0x0e, 0x2f, 0x3f, 0xab,
+	0x97, 0xca, 0xf8, 0xf1, 0x61, 0x7e, 0x46, 0x0c, 0xf3, 0xe9, 0x52, 0x1c, 0x28, 0x6f, 0xea, 0xfc,
+	0xcf, 0x39, 0x64, 0x7e, 0x30, 0xd1, 0x92, 0x81, 0xef, 0x9a, 0x03, 0xff, 0x11, 0x7b, 0x9d, 0xe4,
+	0xec, 0xd9, 0xf0, 0xb3, 0xce, 0xea, 0x1f, 0xe0, 0x97, 0xc7, 0x49, 0xdf, 0x29, 0xe5, 0xbe, 0x44,
+	0x26, 0xc5, 0x86, 0xbf, 0x1e, 0xef, 0xa6, 0xac, 0x91, 0xe3, 0x7c, 0xad, 0x2d, 0xe5, 0x60, 0xd0,
+	0x71, 0xdc, 0x26, 0xa9, 0xa4, 0x2f, 0x7b, 0x15, 0x5b, 0x1b, 0x68, 0xfd, 0x65, 0x25, 0xa7, 0x8e,
+	0xde, 0xbf, 0xb7, 0x50, 0xa9, 0xbf, 0x0c, 0x95, 0xf4, 0x65, 0xbc, 0x0b, 0xec, 0x86, 0x99, 0xbd,
+	0xbb, 0xc0, 0xe5, 0x30, 0x53, 0x7c, 0xd8, 0x5d, 0xe0, 0x72, 0x98, 0x01, 0xb2, 0xc0, 0x3b, 0x4e,
+	0x2b, 0xcb, 0xba, 0xde, 0x88, 0xad, 0x3b, 0xce, 0x95, 0xad, 0xad, 0x4d, 0xc5, 0x8b, 0x49, 0x30,
+	0x08, 0x01, 0xc6, 0xc5, 0xfd, 0x01, 0x07, 0x47, 0x9c, 0x17, 0xc6, 0xc9, 0xbe, 0x10, 0x4d, 0x6e,
+	0xda, 0x9b, 0x02, 0x71, 0xb2, 0xaf, 0x98, 0x8b, 0x0f, 0xa9, 0x0a, 0x40, 0x67, 0xcd, 0x3a, 0xde,
+	0xdc, 0x49, 0xbd, 0x51, 0x6b, 0x1d, 0x5f, 0x5d, 0xab, 0x17, 0x3a, 0xbe, 0xba, 0x56, 0x07, 0xc6,
+	0x05, 0x3f, 0x68, 0x12, 0xdc, 0xf1, 0xc6, 0x6c, 0x7d, 0x50, 0x08, 0xee, 0x98, 0x1f, 0x14, 0x82,
+	0x3b, 0x80, 0x2c, 0x90, 0x53, 0x9c, 0xa6, 0xde, 0xb8, 0x2d, 0x4e, 0x1b, 0xf5, 0xba, 0xc9, 0x69,
+	0xa3, 0x5e, 0x07, 0x64, 0xc1, 0x26, 0x69, 0x23, 0xf5, 0x26, 0x6c, 0x71, 0xba, 0xbc, 0x52, 0xe0,
+	0x74, 0x79, 0xa5, 0x0e, 0xc8, 0x02, 0xb7, 0x8c, 0xe0, 0xcd, 0x5e, 0xc2, 0xc5, 0xa5, 0xc9, 0x8b,
+	0x1b, 0x16, 0xe6, 0x0b, 0x92, 0x53, 0xdc, 0x26, 0x50, 0x21, 0xc1, 0x40, 0xc0, 0x19, 0xf9, 0xbf,
+	0x51, 0xcd, 0xb7, 0x0b, 0xb9, 0x9f, 0xbb, 0x3f, 0xc6, 0x0e, 0x42, 0xb1, 0x17, 0x08, 0xe1, 0xda,
+	0x39, 0x36, 0xe1, 0xfa, 0x24, 0x3f, 0xf1, 0x0c, 0x76, 0x50, 0xe4, 0xef, 0xfe, 0xb8, 0xd3, 0x7f,
+	0x7b, 0x0e, 0xec, 0x9f, 0x65, 0x0a, 0x90, 0xf2, 0xb3, 0xe2, 0xc0, 0x4b, 0xf5, 0xfc, 0x0f, 0x38,
+	0x64, 0xc6, 0xac, 0x50, 0x72, 0x0e, 0x7c, 0xc2, 0x3c, 0x07, 0x2c, 0x5e, 0xf9, 0xf5, 0x7d, 0xff,
+	0x0b, 0x0e, 0x99, 0x96, 0x70, 0x14, 0xc0, 0x53, 0xf7, 0x2e, 0x19, 0x97, 0x2d, 0xf5, 0x1c, 0xdb,
+	0xac, 0x73, 0xc9, 0x53, 0x35, 0x46, 0x71, 0xf3, 0x7f, 0x61, 0x94, 0x28, 0x39, 0x12, 0x68, 0x37,
+	0x4e, 0x43, 0xb6, 0x13, 0x1d, 0xe1, 0x14, 0x8a, 0xb4, 0x53, 0xe8, 0x75, 0x9b, 0xa7, 0x50, 0xde,
+	0x2c, 0xe3, 0x3c, 0xfa, 0xf1, 0xc2, 0xbe, 0xcd, 0x0f, 0xa6, 0xef, 0x3a, 0x96, 0x7d, 0x5b, 0x6b,
+	0xc2, 0xc1, 0x3b, 0xf8, 0x6d, 0xb1, 0x83, 0xf3, 0xa3, 0xeb, 0x6f, 0xd8, 0xdd, 0xc1, 0xb5, 0x56,
+	0x14, 0xf7, 0xf2, 0x84, 0xef, 0xb0, 0xfc, 0xec, 0xba, 0x65, 0x75, 0x87, 0xd5, 0xb8, 0x9a, 0x7b,
+	0x6d, 0xc2, 0xf7, 0xda, 0x51, 0x5b, 0x3c, 0x2f, 0xaf, 0x0c, 0xe4, 0xa9, 0x76, 0xdd, 0x37, 0xe5,
+	0xae, 0xcb, 0x4f, 0xad, 0x0f, 0x5b, 0xde, 0x75, 0x35, 0xbe, 0xfd, 0xfb, 0xef, 0x1b, 0xe4, 0x74,
+	0x3f, 0x1e, 0xd0, 0x1d, 0xf7, 0x02, 0x99, 0x68, 0xc4, 0xd1, 0x4e, 0xb8, 0x7b, 0x3d, 0xe8, 0x8a,
+	0xfb, 0x9a, 0xda, 0x8b, 0x56, 0x64, 0x01, 0xe4, 0x38, 0xee, 0x33, 0x7c, 0xe3, 0xe1, 0x3a, 0x97,
+	0x49, 0x81, 0x5a, 0xc5, 0x2b, 0x24, 0xc2, 0xbf, 0x6d, 0xfc, 0xa7, 0x7e, 0x66, 0xe1, 0x89, 0xcf,
+	0xfd, 0xfe, 0xf9, 0x27, 0xfc, 0xdf, 0xa9, 0x92, 0xa7, 0x4a, 0x79, 0x0a, 0x69, 0xfd, 0x97, 0x0d,
+	0x69, 0x5d, 0x2b, 0xf7, 0x1c, 0x5b, 0x5f, 0xa5, 0x94, 0x7d, 0x99, 0x5c, 0xae, 0x15, 0xc3, 0xe9,
+	0x60, 0xd0, 0x40, 0xa1, 0xd2, 0x29, 0xed, 0x06, 0x0d, 0xea, 0x55, 0xcc, 0x81, 0xba, 0x21, 0x0b,
+	0x20, 0xc7, 0xe1, 0x97, 0xf4, 0x9d, 0xa0, 0xd7, 0xce, 0xbc, 0x6a, 0xf1, 0x92, 0xce, 0xc0, 0x20,
+	0xcb, 0xdd, 0x9f, 0x76, 0x88, 0xdb, 0xcf, 0x55, 0x2c, 0xc4, 0xad, 0xe3, 0x18, 0x87, 0xe5, 0x33,
+	0xf7, 0xb5, 0x4b, 0xb8, 0xd6, 0xd3, 0x92, 0x76, 0x68, 0xdf, 0xf4, 0x33, 0x64, 0xc6, 0xbc, 0x1c,
+	0x0c, 0xa1, 0xa5, 0x63, 0xca, 0x9c, 0x06, 0xea, 0x14, 0xbd, 0x8a, 0x39, 0x0e, 0x75, 0x0e, 0x06,
+	0x59, 0xee, 0x2e, 0x90, 0x1a, 0x4d, 0x92, 0x38, 0x11, 0x77, 0x6d, 0x36, 0x8d, 0x2f, 0x21, 0x00,
+	0x38, 0xdc, 0xff, 0xe3, 0x0a, 0xf1, 0x06, 0xdd, 0x4e, 0xdc, 0x5f, 0xd5, 0xee, 0xd5, 0xbc, 0x50,
+	0xaa, 0xdf, 0xe3, 0xe3, 0xbb, 0x13, 0x15, 0x0a, 0xd2, 0x01, 0x37, 0x6c, 0x51, 0x0a, 0xc5, 0x06,
+	0xce, 0x7f, 0x59, 0xbb, 0x61, 0xeb, 0x24, 0x4a, 0x0e, 0xf8, 0x1d, 0xf3, 0x80, 0xdf, 0xb4, 0xdd,
+	0x29, 0xfd, 0x98, 0xff, 0x83, 0x1a, 0x39, 0x29, 0x4b, 0xeb, 0x14, 0x8f, 0xca, 0xd7, 0x7a, 0x34,
+	0xd9, 0x77, 0x7f, 0xcf, 0x21, 0xa7, 0x82, 0xa2, 0xea, 0x26, 0xa4, 0xc7, 0x30, 0xd0, 0x1a, 0xd7,
+	0xc5, 0xa5, 0x12, 0x8e, 0x7c, 0xa0, 0x2f, 0x8a, 0x81, 0x3e, 0x55, 0x86, 0x32, 0x40, 0xb3, 0x5f,
+	0xda, 0x01, 0x54, 0x9f, 0x4b, 0x38, 0x53, 0xf7, 0xf0, 0x25, 0xae, 0xd4, 0xe7, 0x4b, 0x5a, 0x19,
+	0x18, 0x98, 0x58, 0x33, 0xa3, 0x9d, 0x6e, 0x3b, 0xc8, 0xa8, 0xa6, 0x28, 0x52, 0x35, 0xb7, 0xb4,
+	0x32, 0x30, 0x30, 0xdd, 0xe7, 0xc8, 0x68, 0x14, 0x37, 0xe9, 0xd5, 0xa6, 0x50, 0x41, 0xcf, 0x88,
+	0x3a, 0xa3, 0x37, 0x18, 0x14, 0x44, 0xa9, 0xfb, 0xde, 0x5c, 0xdf, 0x57, 0x63, 0x4b, 0x68, 0xb2,
+	0x54, 0xd7, 0xf7, 0xf7, 0x1d, 0x32, 0x81, 0x35, 0xb6, 0xf6, 0xbb, 0x14, 0xcf, 0x36, 0xfc, 0x22,
+	0xcd, 0xe3, 0xf9, 0x22, 0x37, 0x24, 0x1b, 0x53, 0xd5, 0x31, 0xa1, 0xe0, 0x9f, 0x7f, 0x7b, 0x61,
+	0x5c, 0xfe, 0x80, 0xbc, 0x55, 0xf3, 0x97, 0xc9, 0x93, 0x03, 0xbf, 0xe6, 0xa1, 0x8c, 0x0d, 0x7f,
+	0x8d, 0xcc, 0x98, 0x8d, 0x38, 0x94, 0xa5, 0xe1, 0x9f, 0x68, 0xcb, 0x8e, 0xf7, 0x4b, 0xec, 0x67,
+	0xef, 0x98, 0x34, 0xab, 0x26, 0xc3, 0xaa, 0x57, 0x29, 0x99, 0x0c, 0xab, 0x62, 0x32, 0xac, 0xfa,
+	0x68, 0x51, 0x2b, 0x11, 0xf3, 0xf0, 0x60, 0xee, 0x25, 0x6d, 0xcf, 0x31, 0x0f, 0xe6, 0x9b, 0xb0,
+	0x0e, 0x08, 0x77, 0xbf, 0xac, 0xed, 0x8e, 0x58, 0xad, 0x27, 0x0c, 0x27, 0x96, 0x8c, 0x00, 0x06,
+	0xe1, 0xfe, 0xfd, 0x4f, 0x14, 0x40, 0xb1, 0x09, 0xfe, 0x8f, 0x57, 0xc8, 0x33, 0x07, 0x0a, 0xad,
+	0xa5, 0x0d, 0x77, 0xde, 0xf1, 0x86, 0xe3, 0xb1, 0x96, 0xd0, 0x6e, 0x7c, 0x13, 0xd6, 0xc5, 0xf7,
+	0x52, 0xc7, 0x1a, 0x70, 0x30, 0xc8, 0x72, 0x14, 0x1d, 0xf6, 0xe8, 0xfe, 0x5a, 0x9c, 0x74, 0x82,
+	0xcc, 0xab, 0x9a, 0xa2, 0xc3, 0x35, 0x59, 0x00, 0x39, 0x8e, 0xff, 0x7b, 0x0e, 0x29, 0x36, 0xc0,
+	0x0d, 0xc8, 0x4c, 0x2f, 0xa5, 0x09, 0x1e, 0xa9, 0x75, 0xda, 0x48, 0xa8, 0x9c, 0x9e, 0xef, 0x5d,
+	0xe4, 0xfe, 0x04, 0xd8, 0xc3, 0xc5, 0x46, 0x9c, 0xd0, 0xc5, 0xdb, 0x2f, 0x2d, 0x72, 0x8c, 0x6b,
+	0x74, 0xbf, 0x4e, 0xdb, 0x14, 0x69, 0x2c, 0xbb, 0x68, 0xd4, 0xb8, 0x69, 0x10, 0x80, 0x02, 0x41,
+	0x64, 0xd1, 0x0d, 0xd2, 0xf4, 0x4e, 0x9c, 0x34, 0x05, 0x8b, 0xca, 0xa1, 0x59, 0x6c, 0x1a, 0x04,
+	0xa0, 0x40, 0xd0, 0xff, 0x5d, 0xbc, 0x3e, 0xea, 0x52, 0xab, 0xfb, 0x33, 0x28, 0xfb, 0x20, 0x64,
+	0xb9, 0x1d, 0x6f, 0xaf, 0xc4, 0x51, 0x16, 0x84, 0x11, 0x95, 0xee, 0x08, 0x5b, 0x96, 0x64, 0x64,
+	0x83, 0x76, 0xae, 0xc3, 0xef, 0x2f, 0x83, 0x92, 0xb6, 0xa0, 0x8c, 0xb3, 0xdd, 0x8e, 0xb7, 0x8b,
+	0x76, 0x46, 0x44, 0x02, 0x56, 0xe2, 0xff, 0x99, 0x43, 0xce, 0x0e, 0x10, 0xc6, 0xdd, 0xaf, 0x38,
+	0x64, 0x7a, 0xfb, 0x5d, 0xd1, 0x37, 0xb3, 0x19, 0x68, 0x03, 0x43, 0x00, 0x9e, 0x44, 0x62, 0x6e,
+	0x56, 0x4c, 0x1b, 0xd8, 0xb2, 0x51, 0x0a, 0x05, 0x6c, 0xff, 0x6f, 0x55, 0x48, 0x09, 0x17, 0xb4,
+	0x1e, 0xd1, 0xa8, 0xd9, 0x8d, 0xc3, 0x28, 0x13, 0x9b, 0x91, 0xda, 0xf5, 0x2e, 0x09, 0x38, 0x28,
+	0x0c, 0x71, 0xff, 0x10, 0x03, 0x53, 0xe9, 0xbb, 0x7f, 0x88, 0x96, 0xe7, 0x38, 0xee, 0x2e, 0x99,
+	0x0b, 0xb8, 0x7d, 0x85, 0xcd, 0x3d, 0x36, 0x4d, 0xab, 0x87, 0x99, 0xa6, 0xa7, 0x98, 0x81, 0xb5,
+	0x40, 0x02, 0xfa, 0x88, 0xa2, 0x65, 0xb1, 0x97, 0xd2, 0xfa, 0xea, 0xb5, 0x95, 0x84, 0x36, 0xf9,
+	0xad, 0x58, 0xb3, 0x2c, 0xde, 0xcc, 0x8b, 0x40, 0xc7, 0xf3, 0x7f, 0xba, 0x42, 0xc6, 0x96, 0x83,
+	0xc6, 0x5e, 0xbc, 0xb3, 0x83, 0x43, 0xd1, 0xec, 0x25, 0xb9, 0x62, 0x4b, 0x1b, 0x8a, 0x55, 0x01,
+	0x07, 0x85, 0xe1, 0x6e, 0x91, 0x51, 0xbe, 0xe0, 0xc5, 0xb2, 0xfb, 0x66, 0xad, 0x3f, 0xca, 0x53,
+	0x88, 0x4d, 0x07, 0xf4, 0x14, 0x5a, 0xe4, 0x9e, 0x42, 0x8b, 0x57, 0xa3, 0x6c, 0x23, 0xa9, 0x67,
+	0x49, 0x18, 0xed, 0x2e, 0x13, 0x3c, 0x2e, 0xd6, 0x18, 0x0d, 0x10, 0xb4, 0xb0, 0x1b, 0x9d, 0xe0,
+	0xae, 0x64, 0x27, 0xb6, 0x1f, 0xd5, 0x8d, 0xeb, 0x79, 0x11, 0xe8, 0x78, 0x78, 0x9a, 0x34, 0x82,
+	0xae, 0x37, 0x62, 0x9e, 0x26, 0x2b, 0x41, 0x17, 0x10, 0xee, 0x7e, 0x2b, 0x19, 0xfd, 0x64, 0x98,
+	0x65, 0x34, 0x61, 0x02, 0xc9, 0xc4, 0xf2, 0x82, 0x3c, 0xac, 0x5e, 0x65, 0xd0, 0x07, 0xf7, 0x16,
+	0xa6, 0xc5, 0x20, 0x70, 0x00, 0x08, 0x74, 0xff, 0x77, 0x1c, 0x32, 0xb1, 0x1c, 0xa4, 0x61, 0xe3,
+	0x2f, 0xd1, 0xa6, 0xf6, 0x71, 0x52, 0x5b, 0x09, 0x1a, 0x2d, 0xea, 0xde, 0x2c, 0x5e, 0xa6, 0x27,
+	0x2f, 0x3e, 0x5f, 0xc6, 0x46, 0x5d, 0xac, 0x75, 0x4e, 0xd3, 0x83, 0xae, 0xdc, 0xfe, 0x7f, 0x71,
+	0x08, 0x61, 0x86, 0x57, 0xbe, 0x64, 0xa4, 0xdb, 0x83, 0x33, 0xd0, 0xed, 0xe1, 0x45, 0x32, 0x1e,
+	0x46, 0x19, 0x4d, 0x6e, 0x07, 0x6d, 0xaf, 0x62, 0xce, 0xbb, 0xab, 0x02, 0x0e, 0x0a, 0x03, 0x0f,
+	0xd8, 0x7e, 0x27, 0x87, 0xea, 0xb1, 0xe9, 0x61, 0x4f, 0x0d, 0xe7, 0xe0, 0xe0, 0xbf, 0xed, 0x90,
+	0x99, 0x95, 0x76, 0x48, 0xa3, 0x6c, 0x85, 0x26, 0x19, 0x9b, 0x2e, 0xbb, 0x64, 0xae, 0xa1, 0x20,
+	0x47, 0x99, 0x30, 0x8c, 0xf7, 0x4a, 0x81, 0x04, 0xf4, 0x11, 0x75, 0x9b, 0x64, 0x96, 0xc3, 0xf2,
+	0x3d, 0xe6, 0x50, 0xb3, 0x86, 0xe9, 0x9a, 0x57, 0x4c, 0x0a, 0x50, 0x24, 0xe9, 0xff, 0x89, 0x43,
+	0xce, 0xae, 0xb4, 0x7b, 0x69, 0x46, 0x93, 0x5b, 0x62, 0xdc, 0xe4, 0x65, 0xc1, 0xfd, 0x04, 0x19,
+	0xef, 0x48, 0xfb, 0xb7, 0xf3, 0x90, 0xed, 0x80, 0x8d, 0x3c, 0x62, 0x63, 0x63, 0x36, 0xb6, 0x3f,
+	0x49, 0x1b, 0x19, 0xda, 0xb2, 0x73, 0x3b, 0x7f, 0x0e, 0x03, 0x45, 0xd5, 0xed, 0x92, 0x91, 0xb4,
+	0x4b, 0x1b, 0xf6, 0xbc, 0xf1, 0x64, 0x1f, 0x50, 0xbf, 0x9d, 0x4f, 0x4b, 0xfc, 0x05, 0x8c, 0x93,
+	0xff, 0xbf, 0x1c, 0xf2, 0xd4, 0x80, 0xfe, 0xae, 0x87, 0x69, 0xe6, 0x7e, 0xac, 0xaf, 0xcf, 0x8b,
+	0xc3, 0xf5, 0x19, 0x6b, 0xb3, 0x1e, 0xab, 0x69, 0x2e, 0x21, 0x5a, 0x7f, 0x3f, 0x43, 0x6a, 0x61,
+	0x46, 0x3b, 0x52, 0xa9, 0x6f, 0x41, 0xfd, 0x36, 0xa0, 0x2f, 0xcb, 0xd3, 0xd2, 0x27, 0xf3, 0x2a,
+	0xf2, 0x03, 0xce, 0xd6, 0xdf, 0x23, 0xa3, 0x2b, 0x71, 0xbb, 0xd7, 0x89, 0x86, 0xf3, 0x6c, 0xca,
+	0xf6, 0xbb, 0xb4, 0x28, 0x71, 0xb0, 0xcb, 0x14, 0x2b, 0x91, 0x6a, 0xb8, 0x6a, 0xb9, 0x1a, 0xce,
+	0xff, 0x2d, 0x87, 0xe0, 0x5e, 0xd2, 0x0c, 0x85, 0x5d, 0x96, 0x93, 0xe3, 0x0c, 0x9f, 0xd1, 0xc9,
+	0xe1, 0x4e, 0xad, 0x10, 0x35, 0xfa, 0x1f, 0x27, 0xa3, 0x29, 0x53, 0x70, 0x88, 0x36, 0xac, 0xc9,
+	0x0d, 0x9e, 0xab, 0x3d, 0x1e, 0xdc, 0x5b, 0x18, 0xca, 0xcd, 0x76, 0x51, 0xd1, 0xe6, 0xf5, 0x40,
+	0x50, 0x45, 0xf1, 0xb9, 0x43, 0xd3, 0x34, 0xd8, 0x95, 0xf7, 0x65, 0x25, 0x3e, 0x5f, 0xe7, 0x60,
+	0x90, 0xe5, 0xfe, 0x4f, 0x38, 0x64, 0x5a, 0x89, 0x02, 0x78, 0x19, 0x72, 0x6f, 0xe8, 0x42, 0x03,
+	0x9f, 0x29, 0xcf, 0x0c, 0xd8, 0x67, 0x39, 0xd2, 0x43, 0x64, 0x8a, 0x0f, 0x90, 0xa9, 0x26, 0xed,
+	0xd2, 0xa8, 0x49, 0xa3, 0x46, 0x48, 0xf9, 0x0c, 0x99, 0x58, 0x9e, 0xc3, 0xdb, 0xfb, 0xaa, 0x06,
+	0x07, 0x03, 0xcb, 0xff, 0x95, 0x0a, 0x39, 0x93, 0x93, 0xa3, 0x69, 0xdc, 0x4b, 0x1a, 0xf4, 0x26,
+	0x36, 0x79, 0x88, 0x2f, 0xbc, 0x44, 0x66, 0x1b, 0xdd, 0xde, 0xf5, 0xb0, 0xdd, 0x0e, 0x53, 0xda,
+	0x88, 0xa3, 0x26, 0x1f, 0xe8, 0x6a, 0x7e, 0x03, 0x59, 0xd9, 0xbc, 0xa9, 0x17, 0x43, 0x11, 0x1f,
+	0x49, 0x74, 0x68, 0x27, 0x4e, 0xf6, 0x37, 0x69, 0xb0, 0xb7, 0xbc, 0x9f, 0xd1, 0xd4, 0xab, 0x9a,
+	0x24, 0xae, 0x9b, 0xc5, 0x50, 0xc4, 0x47, 0xe1, 0x20, 0x8c, 0x81, 0x06, 0x4d, 0x5e, 0x7d, 0x84,
+	0x55, 0x57, 0xc2, 0xc1, 0xd5, 0x0d, 0x55, 0x04, 0x3a, 0x1e, 0x6a, 0x3c, 0xc2, 0xf8, 0x56, 0x12,
+	0x66, 0x94, 0xd7, 0xab, 0xb1, 0x7a, 0x4a, 0xe3, 0x71, 0x75, 0x23, 0x2f, 0x03, 0x03, 0xd3, 0xff,
+	0x59, 0x87, 0x3c, 0xa9, 0xc6, 0xac, 0x4e, 0x33, 0xa0, 0x59, 0xb2, 0xaf, 0x5c, 0x91, 0x0f, 0x27,
+	0x2f, 0xdd, 0xc2, 0x1b, 0x58, 0x96, 0xf0, 0x0f, 0x76, 0x34, 0x81, 0x69, 0x92, 0xdf, 0xd7, 0x18,
+	0x11, 0x90, 0xd4, 0xfc, 0x1f, 0xa9, 0x92, 0x53, 0x7a, 0x23, 0xd5, 0xa6, 0xfc, 0xdd, 0x0e, 0x21,
+	0x6a, 0xd6, 0xe0, 0x70, 0x55, 0xed, 0x58, 0x4f, 0x8d, 0xd9, 0x9d, 0x6f, 0xdb, 0x0a, 0x9c, 0x82,
+	0xc6, 0xd6, 0xfd, 0x30, 0x99, 0xba, 0x8d, 0x1b, 0x09, 0xbd, 0x8e, 0x02, 0x2b, 0x7e, 0x74, 0x6c,
+	0xc6, 0x42, 0xd9, 0x02, 0x78, 0x3d, 0xc7, 0xcb, 0x3f, 0x8f, 0x06, 0x4c, 0xc1, 0x20, 0x85, 0xa2,
+	0xc0, 0x74, 0xa2, 0x7f, 0x12, 0x61, 0x95, 0xf9, 0xa8, 0xc5, 0x3e, 0x16, 0xbf, 0xfa, 0xf2, 0x89,
+	0xfb, 0xf7, 0x16, 0xa6, 0x0d, 0x10, 0x98, 0x8d, 0xf0, 0x3f, 0x4c, 0xd8, 0x58, 0x84, 0x51, 0x8f,
+	0x6e, 0x44, 0xee, 0xb3, 0x52, 0x4b, 0xcc, 0x2d, 0x7b, 0x6a, 0xb7, 0xd5, 0x35, 0xc5, 0xa8, 0x4d,
+	0xd9, 0x09, 0xc2, 0x36, 0x73, 0xd1, 0x45, 0x2c, 0xa5, 0x4d, 0x59, 0x63, 0x50, 0x10, 0xa5, 0xfe,
+	0x22, 0x19, 0x5b, 0xc1, 0xbe, 0xd3, 0x04, 0xe9, 0xea, 0x9e, 0xf5, 0xd3, 0x86, 0x67, 0xbd, 0xf4,
+	0xa0, 0xdf, 0x22, 0xa7, 0x57, 0x12, 0x1a, 0x64, 0xb4, 0xfe, 0xf2, 0x72, 0xaf, 0xb1, 0x47, 0x33,
+	0xee, 0xbe, 0x98, 0xba, 0xdf, 0x4e, 0xa6, 0x63, 0x76, 0xcc, 0xae, 0xc7, 0x8d, 0xbd, 0x30, 0xda,
+	0x15, 0x4a, 0xff, 0xd3, 0x82, 0xca, 0xf4, 0x86, 0x5e, 0x08, 0x26, 0xae, 0xff, 0xef, 0x2a, 0x64,
+	0x6a, 0x25, 0x89, 0x23, 0x79, 0x94, 0x3c, 0x86, 0xe3, 0x3f, 0x33, 0x8e, 0x7f, 0x0b, 0x82, 0x9e,
+	0xde, 0xfe, 0x41, 0x22, 0x80, 0xfb, 0x96, 0x3a, 0x56, 0xaa, 0xb6, 0x2e, 0xc1, 0x06, 0x5f, 0x46,
+	0x3b, 0xff, 0xd8, 0xe6, 0xa1, 0xe3, 0xff, 0x7b, 0x87, 0xcc, 0xe9, 0xe8, 0x8f, 0x41, 0xea, 0x48,
+	0x4d, 0xa9, 0xe3, 0x86, 0xdd, 0xfe, 0x0e, 0x10, 0x35, 0xde, 0x1e, 0x33, 0xfb, 0xc9, 0xbc, 0x2d,
+	0x7e, 0xca, 0x21, 0x53, 0x77, 0x34, 0x80, 0xe8, 0xac, 0x6d, 0xc1, 0xef, 0x3d, 0x72, 0x9b, 0xd1,
+	0xa1, 0x0f, 0x0a, 0xbf, 0xc1, 0x68, 0x09, 0xee, 0xfb, 0x18, 0x2c, 0xd3, 0xec, 0xb5, 0x69, 0xf1,
+	0xbe, 0x52, 0x17, 0x70, 0x50, 0x18, 0xee, 0xc7, 0xc8, 0x89, 0x46, 0x1c, 0x35, 0x7a, 0x49, 0x42,
+	0xa3, 0xc6, 0xfe, 0x26, 0x8b, 0x03, 0x12, 0x42, 0xc4, 0xa2, 0xa8, 0x76, 0x62, 0xa5, 0x88, 0xf0,
+	0xa0, 0x0c, 0x08, 0xfd, 0x84, 0xb8, 0xb9, 0x2a, 0xc5, 0x63, 0x5e, 0x5c, 0xf9, 0x35, 0x73, 0x15,
+	0x03, 0x83, 0x2c, 0x77, 0x6f, 0x92, 0xb3, 0x69, 0x16, 0x24, 0x59, 0x18, 0xed, 0xae, 0xd2, 0xa0,
+	0xd9, 0x0e, 0x23, 0x5a, 0xe7, 0x67, 0xb3, 0x38, 0x11, 0x9f, 0xba, 0x7f, 0x6f, 0xe1, 0x6c, 0xbd,
+	0x1c, 0x05, 0x06, 0xd5, 0x75, 0x3f, 0x4e, 0xe6, 0x85, 0x41, 0x6c, 0xa7, 0xd7, 0x7e, 0x35, 0xde,
+	0x4e, 0xaf, 0x84, 0x29, 0x6a, 0x92, 0xd6, 0xc3, 0x4e, 0x98, 0x31, 0x93, 0x75, 0x6d, 0xf9, 0xdc,
+	0xfd, 0x7b, 0x0b, 0xf3, 0xf5, 0x81, 0x58, 0x70, 0x00, 0x05, 0x17, 0xc8, 0x19, 0xbe, 0xf9, 0xf5,
+	0xd1, 0x1e, 0x63, 0xb4, 0xe7, 0xef, 0xdf, 0x5b, 0x38, 0xb3, 0x56, 0x8a, 0x01, 0x03, 0x6a, 0xe2,
+	0x17, 0xcc, 0xc2, 0x0e, 0x7d, 0x13, 0xc3, 0x7b, 0xc6, 0xcd, 0x2f, 0xb8, 0x25, 0xe0, 0xa0, 0x30,
+	0xdc, 0x4f, 0xe6, 0x33, 0x11, 0x97, 0x8b, 0x37, 0x71, 0xc4, 0x1d, 0x8e, 0x5d, 0xe7, 0x6e, 0x69,
+	0x94, 0x98, 0x2f, 0xaf, 0x41, 0xdb, 0xfd, 0x1e, 0x87, 0x4c, 0xa5, 0x59, 0xac, 0x62, 0x77, 0x3c,
+	0x62, 0x6b, 0xda, 0xd7, 0x35, 0xaa, 0x5c, 0x58, 0xd4, 0x21, 0x60, 0x70, 0x75, 0xdf, 0x47, 0x26,
+	0xe4, 0x04, 0x4e, 0xbd, 0x49, 0x26, 0x5f, 0xb2, 0x0b, 0xbf, 0x9c, 0xdf, 0x29, 0xe4, 0xe5, 0x28,
+	0x3e, 0xde, 0x69, 0xd1, 0xc8, 0x9b, 0x32, 0xc5, 0xc7, 0x5b, 0x2d, 0x1a, 0x01, 0x2b, 0xf1, 0xff,
+	0xb8, 0x4a, 0xdc, 0xfe, 0x8d, 0xcf, 0xbd, 0x46, 0x46, 0x83, 0x46, 0x86, 0xfe, 0xfd, 0xdc, 0x1e,
+	0xf7, 0x6c, 0x99, 0x50, 0xc0, 0x07, 0x10, 0xe8, 0x0e, 0xc5, 0x79, 0x4f, 0xf3, 0xdd, 0x72, 0x89,
+	0x55, 0x05, 0x41, 0xc2, 0x8d, 0xc9, 0x89, 0x76, 0x90, 0x66, 0xb2, 0x85, 0x4d, 0xfc, 0x90, 0xe2,
+	0xb8, 0xf8, 0xa6, 0xe1, 0x3e, 0x15, 0xd6, 0x58, 0x3e, 0x8d, 0xeb, 0x71, 0xbd, 0x48, 0x08, 0xfa,
+	0x69, 0x63, 0xe4, 0x54, 0x43, 0x5e, 0x17, 0xa4, 0x58, 0x73, 0xcd, 0x8a, 0xe4, 0xc1, 0x69, 0x1a,
+	0x92, 0x95, 0x60, 0x03, 0x1a, 0x4b, 0x54, 0x46, 0xb2, 0x75, 0x43, 0x9b, 0xb4, 0x29, 0x84, 0x61,
+	0x75, 0x71, 0xa8, 0xcb, 0x02, 0xc8, 0x71, 0x34, 0x29, 0x83, 0x2f, 0xf8, 0x01, 0x52, 0x86, 0xfb,
+	0x0a, 0xa9, 0x75, 0x5b, 0x41, 0x2a, 0xe3, 0x34, 0x7c, 0xb9, 0x6b, 0x6f, 0x22, 0x90, 0x6d, 0x4d,
+	0xda, 0xb7, 0x64, 0x40, 0xe0, 0x15, 0xfc, 0x9f, 0x9c, 0x26, 0x63, 0xab, 0x4b, 0x97, 0xb7, 0x82,
+	0x74, 0x6f, 0x88, 0x5b, 0x05, 0x2e, 0x43, 0x21, 0xac, 0x16, 0x37, 0x52, 0x29, 0xc4, 0x82, 0xc2,
+	0x70, 0x23, 0x32, 0x1a, 0x46, 0xb8, 0xf3, 0x78, 0x33, 0xb6, 0x2c, 0x5d, 0xea, 0x0e, 0xcc, 0x54,
+	0x91, 0x57, 0x19, 0x75, 0x10, 0x5c, 0xdc, 0xb7, 0xd0, 0xb5, 0x4e, 0x84, 0xc9, 0x89, 0xf3, 0xff,
+	0x9a, 0x0d, 0x05, 0x93, 0x20, 0xa9, 0x3b, 0xd1, 0x09, 0x10, 0xe4, 0x0c, 0xdd, 0xcf, 0x39, 0x64,
+	0x52, 0x76, 0x1d, 0xbd, 0x4c, 0x46, 0xac, 0x05, 0x3c, 0xe6, 0x44, 0xb9, 0x87, 0x95, 0x06, 0x00,
+	0x9d, 0x65, 0xdf, 0x3d, 0xb3, 0x36, 0xcc, 0x3d, 0xd3, 0xbd, 0x43, 0x26, 0xee, 0x84, 0x59, 0x8b,
+	0x9d, 0xf0, 0xc2, 0xaa, 0xbb, 0xf6, 0xe8, 0xad, 0x46, 0x72, 0xf9, 0x88, 0xdd, 0x92, 0x0c, 0x20,
+	0xe7, 0x85, 0xcb, 0x01, 0x7f, 0xb0, 0x30, 0x43, 0x6f, 0xcc, 0xd4, 0xcd, 0xdf, 0x92, 0x05, 0x90,
+	0xe3, 0xe0, 0x10, 0x4f, 0xe1, 0xaf, 0x3a, 0x7d, 0xa3, 0x87, 0x5b, 0x8b, 0x37, 0x6e, 0x6b, 0x5e,
+	0x49, 0x8a, 0x7c, 0xb0, 0x6e, 0x69, 0x3c, 0xc0, 0xe0, 0xa8, 0xb6, 0xce, 0x89, 0x41, 0x5b, 0x27,
+	0x86, 0xee, 0x34, 0xd4, 0x65, 0xc2, 0x23, 0xb6, 0x3c, 0xcf, 0xf3, 0x0b, 0x0a, 0x0f, 0xdd, 0xc9,
+	0x7f, 0x83, 0xc6, 0x0f, 0x77, 0x8c, 0x38, 0xba, 0x74, 0x37, 0xcc, 0x44, 0xc0, 0x91, 0xda, 0x31,
+	0x36, 0x18, 0x14, 0x44, 0x29, 0xf7, 0x1e, 0xc2, 0x49, 0x90, 0x8a, 0x53, 0x40, 0xf3, 0x1e, 0x62,
+	0x60, 0x90, 0xe5, 0xee, 0xdf, 0x71, 0x48, 0xad, 0x15, 0xc7, 0x7b, 0xa9, 0x37, 0x7d, 0xbe, 0x6a,
+	0x47, 0xa6, 0x16, 0x3b, 0xce, 0xe2, 0x15, 0x24, 0x6b, 0x86, 0x50, 0xd6, 0x18, 0xec, 0xc1, 0xbd,
+	0x85, 0x99, 0xf5, 0x70, 0x87, 0x36, 0xf6, 0x1b, 0x6d, 0xca, 0x20, 0x9f, 0x7f, 0x5b, 0x83, 0x5c,
+	0xba, 0x4d, 0xa3, 0x0c, 0x78, 0xab, 0xd0, 0x1f, 0xb3, 0x1b, 0x24, 0x41, 0xbb, 0x4d, 0xdb, 0x61,
+	0xca, 0x23, 0x8a, 0xaa, 0x22, 0x02, 0x27, 0x07, 0x83, 0x8e, 0x83, 0xa7, 0xe5, 0x76, 0x90, 0x35,
+	0x5a, 0xf5, 0xf0, 0x4d, 0xea, 0xb9, 0xac, 0x02, 0x3b, 0x2d, 0x97, 0x25, 0x10, 0xf2, 0x72, 0xb7,
+	0x47, 0x4e, 0xe0, 0x36, 0xbb, 0x16, 0xa4, 0xd9, 0x56, 0x2b, 0xa1, 0x69, 0x2b, 0x6e, 0x37, 0xbd,
+	0xb9, 0x23, 0x6a, 0x04, 0xd8, 0x69, 0xb5, 0x56, 0x24, 0x07, 0xfd, 0x1c, 0xdc, 0x84, 0xcc, 0x09,
+	0x21, 0x2b, 0xe7, 0x7a, 0xe2, 0x88, 0x5c, 0x99, 0x20, 0x53, 0x2f, 0x50, 0x83, 0x3e, 0xfa, 0xf3,
+	0x5f, 0x70, 0x08, 0xc9, 0xbf, 0x49, 0x89, 0xc7, 0x03, 0x35, 0x7d, 0x84, 0x2c, 0xe8, 0x26, 0x8c,
+	0xaf, 0xac, 0xbb, 0x50, 0x7c, 0xae, 0x42, 0x26, 0x71, 0x9e, 0xc8, 0xd3, 0xe4, 0x39, 0x32, 0x9a,
+	0x05, 0xc9, 0x2e, 0x95, 0x56, 0x3f, 0x35, 0xb3, 0xb7, 0x18, 0x14, 0x44, 0xa9, 0x1b, 0x91, 0x5a,
+	0x16, 0xa4, 0x7b, 0xf2, 0x46, 0x74, 0xd5, 0xda, 0x6c, 0xcd, 0x2f, 0x43, 0xf8, 0x2b, 0x05, 0xce,
+	0xc6, 0x7d, 0x9e, 0x8c, 0xcb, 0x8f, 0x27, 0xee, 0xe4, 0x53, 0x78, 0x1e, 0xca, 0x6f, 0x0c, 0xaa,
+	0x14, 0xf7, 0x3b, 0x56, 0x65, 0x2d, 0x0f, 0x0a, 0x55, 0xfb, 0xdd, 0x96, 0x2c, 0x80, 0x1c, 0x07,
+	0x2d, 0xa0, 0x23, 0xab, 0xfc, 0x32, 0x3d, 0xca, 0xd5, 0x7f, 0x9e, 0x63, 0x6b, 0x3f, 0x41, 0xba,
+	0x75, 0x46, 0x53, 0xbb, 0xce, 0xb2, 0xdf, 0x20, 0x78, 0xa1, 0xb6, 0x66, 0x26, 0x4b, 0x82, 0x28,
+	0xdd, 0x61, 0x06, 0x59, 0xd4, 0x9a, 0x55, 0x6c, 0xed, 0x00, 0x5b, 0x06, 0xdd, 0x7a, 0x46, 0xbb,
+	0xb9, 0x5d, 0xd8, 0x2c, 0x83, 0x42, 0x1b, 0xfc, 0x9f, 0x74, 0x08, 0xc9, 0x5b, 0x8f, 0x31, 0x2a,
+	0xd3, 0x81, 0xee, 0x31, 0xee, 0x39, 0xb6, 0xe6, 0xa6, 0xe1, 0x88, 0xce, 0xf5, 0x48, 0x06, 0x08,
+	0x4c, 0xc6, 0xf8, 0xbd, 0xc6, 0x57, 0x69, 0x23, 0x64, 0x21, 0x9c, 0xeb, 0x64, 0x04, 0x2f, 0x24,
+	0x9e, 0x73, 0x68, 0x89, 0x36, 0xd7, 0xc7, 0xa3, 0x20, 0xcb, 0xa8, 0x0c, 0xeb, 0xbd, 0x83, 0x12,
+	0x1a, 0xfe, 0xa7, 0x39, 0x8a, 0x29, 0x09, 0xed, 0x86, 0x80, 0x83, 0xc2, 0x70, 0x5f, 0x21, 0xa3,
+	0x09, 0x0d, 0xd2, 0x38, 0x12, 0xd3, 0xf1, 0xbc, 0xa4, 0x0a, 0x0c, 0x8a, 0xbb, 0xb0, 0xec, 0x0f,
+	0x87, 0x80, 0xc0, 0xd7, 0xf5, 0xeb, 0xb5, 0x87, 0xe8, 0xd7, 0x7f, 0xdd, 0x21, 0xa7, 0x99, 0xee,
+	0x4c, 0x96, 0xa0, 0xee, 0x0d, 0xf0, 0xa6, 0xfd, 0x02, 0x19, 0xeb, 0x06, 0x59, 0x46, 0x13, 0xa9,
+	0x8e, 0x55, 0x44, 0x36, 0x39, 0x18, 0x64, 0x39, 0xca, 0x76, 0x89, 0xac, 0xe7, 0x55, 0x6c, 0xc9,
+	0x76, 0xaa, 0x29, 0xf9, 0x42, 0x54, 0x20, 0xc8, 0x19, 0xfa, 0xdf, 0x42, 0x6a, 0xec, 0xc8, 0x61,
+	0x9a, 0x04, 0x61, 0x84, 0x2b, 0x6a, 0x90, 0xa5, 0x71, 0x0e, 0x14, 0x86, 0xff, 0x31, 0x32, 0x73,
+	0xe9, 0x2e, 0x6d, 0xf4, 0xb2, 0x38, 0xe1, 0x86, 0xd7, 0x01, 0xa1, 0x9f, 0xce, 0x91, 0x42, 0x3f,
+	0xff, 0xb9, 0x43, 0x4e, 0xe0, 0x59, 0xbe, 0x82, 0xd1, 0x7e, 0x6a, 0x4c, 0xdf, 0x47, 0x26, 0xa8,
+	0x00, 0x72, 0xaf, 0xc9, 0x1a, 0x3f, 0xda, 0x24, 0x66, 0x0a, 0x79, 0xf9, 0x3b, 0x3c, 0xaa, 0xbf,
+	0xe8, 0x90, 0x49, 0xcd, 0xb1, 0x1d, 0x5b, 0xb3, 0xbb, 0x52, 0xe7, 0x6a, 0x4f, 0xcf, 0xb1, 0xd5,
+	0x9a, 0xcb, 0x92, 0x64, 0xde, 0x1a, 0x05, 0x82, 0x9c, 0xe1, 0x43, 0x1c, 0xcf, 0xfd, 0xdf, 0x70,
+	0xc8, 0xe9, 0x52, 0x2f, 0xfc, 0x77, 0xb8, 0xd9, 0x86, 0xf3, 0x57, 0x65, 0x08, 0xe7, 0xaf, 0x5f,
+	0x71, 0x48, 0x4e, 0x09, 0xf7, 0x95, 0xed, 0xbc, 0xe5, 0xda, 0xbe, 0x22, 0x38, 0x89, 0x52, 0xf7,
+	0x2d, 0x72, 0xd6, 0x9c, 0x82, 0x47, 0xb4, 0x5c, 0x73, 0x95, 0x55, 0x39, 0x25, 0x18, 0xc4, 0xc2,
+	0xff, 0xaa, 0x43, 0x6a, 0x97, 0x83, 0xde, 0x2e, 0x1d, 0x4a, 0x89, 0x8e, 0x47, 0x72, 0x42, 0x83,
+	0x76, 0x26, 0x15, 0x0a, 0xe2, 0x48, 0x06, 0x01, 0x03, 0x55, 0xea, 0x2e, 0x91, 0x89, 0xb8, 0x4b,
+	0x0d, 0xdf, 0x95, 0x67, 0xe5, 0xe8, 0x6d, 0xc8, 0x02, 0xdc, 0x06, 0x19, 0x77, 0x05, 0x81, 0xbc,
+	0x96, 0xff, 0xb5, 0x51, 0x32, 0xa9, 0xc5, 0x6b, 0xe2, 0x0d, 0x21, 0xa1, 0xdd, 0xb8, 0x78, 0x8b,
+	0xc6, 0x09, 0x03, 0xac, 0x04, 0x37, 0x91, 0x84, 0xde, 0x66, 0xbb, 0x6a, 0xf1, 0x16, 0x0d, 0x02,
+	0x0e, 0x0a, 0x03, 0x9d, 0xd6, 0x9b, 0xb4, 0x9b, 0xb5, 0x58, 0xf3, 0x46, 0xb8, 0xd3, 0xfa, 0x2a,
+	0x02, 0x80, 0xc3, 0x11, 0x61, 0x87, 0x66, 0x8d, 0x16, 0xb3, 0x17, 0x09, 0xaf, 0xf6, 0x35, 0x04,
+	0x00, 0x87, 0x97, 0x78, 0xc1, 0xd4, 0x8e, 0xdf, 0x0b, 0x66, 0xd4, 0xb2, 0x17, 0x8c, 0xdb, 0x25,
+	0x27, 0xd3, 0xb4, 0xb5, 0x99, 0x84, 0xb7, 0x83, 0x8c, 0xe6, 0xb3, 0x6f, 0xec, 0x30, 0x7c, 0xce,
+	0xb2, 0x1c, 0x2d, 0xf5, 0x2b, 0x45, 0x2a, 0x50, 0x46, 0xda, 0xad, 0x93, 0xd3, 0x61, 0x94, 0xd2,
+	0x46, 0x2f, 0xa1, 0x57, 0x77, 0xa3, 0x38, 0xa1, 0x57, 0xe2, 0x14, 0xc9, 0x89, 0x0c, 0x13, 0x2a,
+	0xce, 0xe3, 0x6a, 0x19, 0x12, 0x94, 0xd7, 0x75, 0x2f, 0x93, 0x13, 0xcd, 0x30, 0x0d, 0xb6, 0xdb,
+	0xb4, 0xde, 0xdb, 0xee, 0xc4, 0x5c, 0x61, 0x37, 0xc1, 0x08, 0x3e, 0x29, 0xb5, 0xcb, 0xab, 0x45,
+	0x04, 0xe8, 0xaf, 0x83, 0x46, 0xd2, 0x34, 0x8c, 0x76, 0xdb, 0x74, 0x39, 0x09, 0xa2, 0x46, 0x4b,
+	0xa4, 0xa6, 0x50, 0x56, 0xb8, 0xba, 0x56, 0x06, 0x06, 0x26, 0x5b, 0xf3, 0xbc, 0x4e, 0xe1, 0x8e,
+	0x28, 0xb0, 0x45, 0x29, 0x1a, 0x80, 0x65, 0x1f, 0xea, 0x7b, 0x61, 0x77, 0x6b, 0xbd, 0xce, 0xee,
+	0x8a, 0xe3, 0xb9, 0x01, 0xf8, 0xaa, 0x59, 0x0c, 0x45, 0x7c, 0xff, 0xeb, 0x0e, 0x99, 0xd2, 0xc3,
+	0xb4, 0xf0, 0x0a, 0x4f, 0x5a, 0xab, 0x6b, 0x75, 0x7e, 0x1e, 0xda, 0x13, 0x67, 0xaf, 0x28, 0x9a,
+	0xb9, 0x16, 0x2e, 0x87, 0x81, 0xc6, 0x73, 0x88, 0xb4, 0x2e, 0xcf, 0x92, 0xda, 0x4e, 0x8c, 0xd2,
+	0x76, 0xd5, 0xb4, 0x00, 0xae, 0x21, 0x10, 0x78, 0x99, 0xff, 0x5f, 0x1d, 0x72, 0xa6, 0x3c, 0x02,
+	0xed, 0xdd, 0xd0, 0xc9, 0x8b, 0x98, 0x25, 0x2a, 0x6b, 0x19, 0xe7, 0x82, 0x96, 0xd8, 0x49, 0x96,
+	0x80, 0x86, 0x35, 0x5c, 0xb7, 0xff, 0x75, 0x85, 0x68, 0x3c, 0xdd, 0x2f, 0x3a, 0x64, 0x1a, 0xd9,
+	0x5e, 0x4b, 0xb6, 0x8d, 0xde, 0x6e, 0xd8, 0xe9, 0xad, 0x22, 0x9b, 0x1b, 0x3a, 0x0d, 0x30, 0x98,
+	0xcc, 0x51, 0xfa, 0x09, 0x9a, 0xcd, 0x84, 0xa6, 0xa9, 0x72, 0xb3, 0x60, 0xd2, 0xcf, 0x92, 0x04,
+	0x42, 0x5e, 0x8e, 0xfb, 0x30, 0x06, 0x08, 0xe2, 0xd6, 0x56, 0x94, 0x95, 0x91, 0x09, 0xc2, 0x41,
+	0x61, 0xb8, 0xaf, 0x93, 0x33, 0xa8, 0xfe, 0xe7, 0x97, 0x13, 0x9a, 0x6c, 0x26, 0x71, 0x46, 0x1b,
+	0x59, 0xa8, 0x64, 0xe7, 0x73, 0xa2, 0xee, 0x99, 0xd5, 0x52, 0x2c, 0x18, 0x50, 0xdb, 0xff, 0xe1,
+	0x11, 0x62, 0xf6, 0x09, 0xbd, 0xc3, 0xf6, 0x92, 0xed, 0x15, 0xe6, 0xf3, 0x77, 0x14, 0x2f, 0x34,
+	0xe6, 0x1d, 0x76, 0xcd, 0xa4, 0x00, 0x45, 0x92, 0x82, 0xcb, 0x35, 0xba, 0x9f, 0x05, 0xdb, 0x47,
+	0xf6, 0x41, 0xbb, 0x66, 0x52, 0x80, 0x22, 0x49, 0xf4, 0x00, 0xd9, 0x4b, 0xb6, 0xe5, 0xe9, 0x51,
+	0x74, 0x0f, 0xbd, 0x96, 0x17, 0x81, 0x8e, 0x87, 0x9f, 0x66, 0x2f, 0xd9, 0xc6, 0x03, 0x5b, 0xde,
+	0x94, 0xd5, 0xa7, 0xb9, 0x26, 0xe0, 0xa0, 0x30, 0xdc, 0x2e, 0x71, 0xf7, 0xe4, 0xe8, 0x29, 0x0f,
+	0x47, 0xaf, 0x76, 0x48, 0x07, 0x49, 0x16, 0xb2, 0x76, 0xad, 0x8f, 0x0e, 0x94, 0xd0, 0x76, 0x3f,
+	0x4c, 0xce, 0xee, 0x25, 0xdb, 0x42, 0x8e, 0xd9, 0x4c, 0xc2, 0xa8, 0x11, 0x76, 0x8d, 0x54, 0x49,
+	0xd2, 0x61, 0xf5, 0xec, 0xb5, 0x72, 0x34, 0x18, 0x54, 0xdf, 0xff, 0xd5, 0x11, 0xc2, 0x52, 0x30,
+	0xe0, 0x36, 0xdd, 0xa1, 0x59, 0x2b, 0x6e, 0x16, 0x45, 0xb3, 0xeb, 0x0c, 0x0a, 0xa2, 0x54, 0x06,
+	0x66, 0x54, 0x06, 0x04, 0x66, 0xdc, 0x21, 0x63, 0x2d, 0x1a, 0x34, 0x69, 0x22, 0x4d, 0x1e, 0xeb,
+	0x76, 0x92, 0x46, 0x5c, 0x61, 0x44, 0xf3, 0x2b, 0x1b, 0xff, 0x9d, 0x82, 0xe4, 0xe6, 0x7e, 0x1b,
+	0x99, 0x41, 0x19, 0x2b, 0xee, 0x65, 0xd2, 0x6a, 0xc9, 0x4d, 0x1e, 0xec, 0xb0, 0xdf, 0x32, 0x4a,
+	0xa0, 0x80, 0xe9, 0xae, 0x2a, 0xe5, 0x97, 0x32, 0xa5, 0x88, 0x81, 0x55, 0x39, 0xac, 0xea, 0x85,
+	0x72, 0xe8, 0xab, 0xc1, 0x1c, 0xeb, 0xe3, 0xe6, 0xbe, 0x57, 0x33, 0x77, 0xfa, 0xe5, 0xb8, 0xb9,
+	0x0f, 0xac, 0xc4, 0x7d, 0x93, 0x8c, 0xe3, 0x5f, 0xa6, 0x91, 0x19, 0xb7, 0x15, 0xf6, 0x86, 0xa3,
+	0x83, 0x3c, 0x84, 0x7a, 0x85, 0xc9, 0x9e, 0xcb, 0x82, 0x0b, 0x28, 0x7e, 0x78, 0x17, 0xd4, 0x8f,
+	0xcb, 0xd7, 0x69, 0x12, 0xee, 0xec, 0x33, 0x79, 0x66, 0x3c, 0xbf, 0x0b, 0x5e, 0xed, 0xc3, 0x80,
+	0x92, 0x5a, 0xfe, 0x17, 0x2b, 0x64, 0x4a, 0xcf, 0xe4, 0xf1, 0xb0, 0x68, 0x9d, 0x34, 0x9f, 0x14,
+	0x5c, 0xa5, 0x73, 0xc5, 0x42, 0xb7, 0x1f, 0x36, 0x21, 0x5a, 0x64, 0x24, 0xe8, 0x09, 0x41, 0xd6,
+	0x8a, 0xd6, 0x9e, 0xf5, 0x18, 0xc3, 0x6a, 0x58, 0xc8, 0x37, 0xfe, 0x07, 0x8c, 0x83, 0xff, 0xbd,
+	0x55, 0x32, 0x2e, 0x0b, 0xd1, 0x42, 0x4b, 0x72, 0x0f, 0x5c, 0xcf, 0xb1, 0xf5, 0x99, 0x4d, 0xe7,
+	0x61, 0xcd, 0xf8, 0xa7, 0xe0, 0xa0, 0xf1, 0x45, 0x1d, 0x5e, 0x8c, 0x8d, 0xbb, 0x68, 0x2f, 0x1b,
+	0xcd, 0x06, 0x32, 0xbe, 0xc8, 0xb8, 0xe7, 0x7a, 0x7e, 0x06, 0x03, 0xc1, 0x0b, 0x2f, 0xa7, 0xdb,
+	0xd2, 0x1d, 0xde, 0x9e, 0x4d, 0x4c, 0x79, 0xd8, 0xe7, 0x77, 0x4d, 0x05, 0x82, 0x9c, 0xa1, 0xff,
+	0x12, 0x99, 0x31, 0x17, 0x03, 0x5e, 0x56, 0xb6, 0x99, 0x4f, 0x1f, 0x7e, 0x86, 0x29, 0x7e, 0x59,
+	0xe1, 0x8e, 0x7c, 0x1c, 0x8e, 0x11, 0x3c, 0x24, 0xdf, 0x5e, 0x86, 0xb0, 0x49, 0x3e, 0xab, 0xab,
+	0xa4, 0x07, 0xdd, 0x08, 0x3f, 0x4b, 0x26, 0xd8, 0x3f, 0x6c, 0xa1, 0x5b, 0xf3, 0x3d, 0xcf, 0xdb,
+	0x29, 0x96, 0x3a, 0x93, 0x35, 0x5e, 0x97, 0x8c, 0x20, 0xe7, 0xe9, 0xc7, 0x64, 0xae, 0x88, 0xed,
+	0x7e, 0x94, 0x4c, 0xa5, 0xf2, 0x58, 0xcd, 0xe3, 0xd2, 0x87, 0x3c, 0x7e, 0xb9, 0x43, 0x80, 0x56,
+	0x1d, 0x0c, 0x62, 0xfe, 0x06, 0x19, 0xb5, 0x3a, 0x84, 0xfe, 0xcf, 0x3b, 0x64, 0x82, 0xf9, 0x64,
+	0xec, 0xa2, 0x29, 0x4e, 0x55, 0xa9, 0x1e, 0x30, 0xea, 0x29, 0x19, 0xe3, 0xea, 0x03, 0xe9, 0xcb,
+	0x68, 0x61, 0x97, 0xe1, 0x69, 0x6a, 0xf3, 0x5d, 0x86, 0xeb, 0x29, 0x52, 0x90, 0x9c, 0xfc, 0xef,
+	0xab, 0x90, 0xd1, 0xab, 0x51, 0xb7, 0xf7, 0x57, 0x3e, 0x55, 0xea, 0x75, 0x32, 0x82, 0x76, 0x56,
+	0x33, 0xa3, 0xef, 0xd4, 0xf2, 0x7b, 0xf5, 0x6c, 0xbe, 0x9e, 0x99, 0xcd, 0x17, 0x82, 0x3b, 0x52,
+	0x49, 0x2b, 0x2c, 0x31, 0x79, 0x6c, 0xfe, 0x8b, 0x64, 0x62, 0x3d, 0xd8, 0xa6, 0xed, 0x6b, 0x74,
+	0x9f, 0x45, 0xd2, 0x73, 0xb7, 0x33, 0x27, 0xd7, 0x39, 0x18, 0x2e, 0x62, 0xab, 0x64, 0x86, 0x61,
+	0xab, 0xc5, 0x80, 0x37, 0x12, 0x9a, 0xa7, 0x43, 0x74, 0xcc, 0x1b, 0x89, 0x96, 0x0a, 0x51, 0xc3,
+	0xf2, 0x17, 0xc9, 0x64, 0x4e, 0x65, 0x08, 0xae, 0x7f, 0x56, 0x21, 0xd3, 0x86, 0x41, 0xc9, 0xf0,
+	0x58, 0x70, 0x1e, 0xea, 0xb1, 0x60, 0x78, 0x10, 0x54, 0xde, 0x69, 0x0f, 0x82, 0xea, 0xe3, 0xf7,
+	0x20, 0x30, 0x3f, 0xd2, 0xc8, 0x50, 0x1f, 0xe9, 0xcb, 0x0e, 0x19, 0x59, 0x0f, 0xa3, 0xbd, 0xe1,
+	0x36, 0x9a, 0xb4, 0x11, 0x77, 0xfb, 0x36, 0x9a, 0x3a, 0x02, 0x81, 0x97, 0x49, 0xd1, 0xa5, 0x3a,
+	0x40, 0x74, 0xc9, 0xed, 0x80, 0x23, 0x07, 0xd9, 0x01, 0x7d, 0x74, 0xcc, 0xba, 0x1e, 0x44, 0xe1,
+	0x0e, 0x4d, 0x33, 0x36, 0x01, 0xb3, 0x63, 0x0d, 0xbd, 0x9e, 0x1a, 0x90, 0x44, 0xe8, 0x3f, 0x56,
+	0xc8, 0x09, 0xf4, 0x93, 0x0f, 0xdf, 0x0c, 0xf2, 0x30, 0x05, 0xec, 0x63, 0x2b, 0xcc, 0x84, 0x87,
+	0xb1, 0xea, 0xe3, 0x15, 0xcc, 0xf2, 0xd6, 0x0a, 0x1f, 0xa6, 0x8b, 0x66, 0x41, 0x8d, 0x78, 0x93,
+	0xd3, 0xac, 0x3c, 0x79, 0x00, 0x82, 0x2c, 0x80, 0x1c, 0xc7, 0xfd, 0x07, 0x0e, 0x99, 0xde, 0xa3,
+	0xfb, 0x2b, 0x71, 0xa7, 0x1b, 0x47, 0x34, 0x52, 0xfb, 0xf1, 0x8e, 0x8d, 0x9c, 0x97, 0x85, 0xbe,
+	0x2d, 0x5e, 0xd3, 0x19, 0x71, 0xe3, 0xbe, 0xba, 0xbc, 0x1b, 0x65, 0x60, 0xb6, 0x69, 0xfe, 0x43,
+	0xc4, 0xed, 0xaf, 0xfb, 0xb0, 0xb0, 0xfb, 0x09, 0xdd, 0x66, 0xfc, 0xfb, 0x0e, 0x19, 0xe3, 0x0d,
+	0x52, 0x11, 0x2c, 0xce, 0x80, 0x31, 0x6c, 0x91, 0x1a, 0x1b, 0x1f, 0xb1, 0xcc, 0x2f, 0x5b, 0x90,
+	0x07, 0x91, 0x1c, 0xdf, 0x94, 0xd8, 0xbf, 0xc0, 0x19, 0xb0, 0x7b, 0x5c, 0x70, 0x77, 0x49, 0x45,
+	0xa2, 0xe4, 0xf7, 0x38, 0x06, 0x05, 0x51, 0x8a, 0xcb, 0x27, 0xe8, 0x65, 0xb1, 0x70, 0x0b, 0x55,
+	0xcb, 0x67, 0xa9, 0x97, 0xc5, 0x4c, 0xac, 0x8d, 0xfd, 0xaf, 0x55, 0xc9, 0xb8, 0xca, 0x22, 0xca,
+	0x72, 0x3c, 0x45, 0x51, 0x9c, 0x05, 0xdc, 0x9f, 0x8d, 0x1f, 0x6f, 0x1f, 0xb5, 0x97, 0xc5, 0x74,
+	0x71, 0x29, 0xa7, 0xce, 0x3f, 0xa3, 0xba, 0xb7, 0x6b, 0x25, 0xa0, 0x37, 0xc2, 0xfd, 0x0c, 0x19,
+	0x6d, 0xe3, 0x86, 0x2d, 0x4f, 0xbb, 0xd7, 0x2d, 0x36, 0x87, 0x9d, 0x04, 0xa2, 0x25, 0x6a, 0x0c,
+	0x39, 0x10, 0x04, 0xd7, 0xf9, 0x0f, 0x92, 0xb9, 0x62, 0xab, 0x0f, 0x33, 0x81, 0xe6, 0xff, 0x7f,
+	0x71, 0xe0, 0x1c, 0x61, 0xee, 0xbd, 0x46, 0x26, 0xaf, 0xd3, 0x2c, 0x09, 0x1b, 0x8c, 0xc0, 0xc3,
+	0xa6, 0xdf, 0x50, 0x22, 0xd7, 0xf7, 0xb3, 0xe9, 0x8c, 0x34, 0xd1, 0x54, 0x47, 0xba, 0x49, 0x8c,
+	0x57, 0x7e, 0xda, 0x93, 0x1f, 0xdb, 0xc2, 0x15, 0x62, 0x53, 0xd1, 0xe4, 0x6e, 0x45, 0xf9, 0x6f,
+	0xd0, 0xf8, 0xf9, 0x3f, 0xe0, 0x90, 0xda, 0xf5, 0x5e, 0x46, 0xef, 0x0e, 0xb1, 0xc9, 0x1f, 0x3a,
+	0x93, 0x11, 0x86, 0xe5, 0x04, 0x59, 0xb0, 0x1d, 0xa4, 0x52, 0xf5, 0x98, 0x87, 0xe5, 0x08, 0x38,
+	0x28, 0x0c, 0xff, 0xa3, 0x64, 0x8a, 0xb5, 0xe4, 0x4a, 0xdc, 0x46, 0xc1, 0x05, 0x47, 0xb2, 0x83,
+	0xbf, 0x8b, 0x16, 0x21, 0x86, 0x04, 0xbc, 0x0c, 0xd7, 0x20, 0x3a, 0xb8, 0xa8, 0x18, 0x70, 0x35,
+	0x7f, 0xae, 0x30, 0x28, 0x88, 0x52, 0xff, 0xbb, 0x2b, 0x64, 0x92, 0x55, 0x14, 0xfb, 0xf4, 0x3e,
+	0x19, 0x6b, 0x71, 0x3e, 0x62, 0xc8, 0x2d, 0xf8, 0xf5, 0xea, 0xad, 0xd7, 0x6e, 0xcb, 0x1c, 0x00,
+	0x92, 0x1f, 0xb2, 0xbe, 0x13, 0x84, 0xe8, 0xc0, 0xed, 0x55, 0x8e, 0x97, 0xf5, 0x2d, 0xce, 0x06,
+	0x24, 0x3f, 0xff, 0x3b, 0x09, 0x73, 0x16, 0x58, 0x6b, 0x07, 0xbb, 0x7c, 0xe4, 0xe2, 0x3d, 0xda,
+	0x14, 0x87, 0x95, 0x36, 0x72, 0x08, 0x05, 0x51, 0xca, 0xf3, 0x55, 0x64, 0x49, 0xa8, 0x22, 0x62,
+	0xb4, 0x7c, 0x15, 0x0c, 0x2c, 0xe3, 0x9f, 0x9a, 0xfe, 0x3f, 0x1b, 0x21, 0xe4, 0x06, 0x33, 0x5a,
+	0xb3, 0x94, 0x28, 0xdf, 0x2c, 0x9d, 0x57, 0x4d, 0x33, 0xb8, 0x72, 0x5e, 0x65, 0x49, 0x5f, 0x74,
+	0xa7, 0x55, 0xdd, 0xf9, 0xa0, 0x72, 0xb0, 0xf3, 0x81, 0xdb, 0x25, 0x63, 0x71, 0x2f, 0xc3, 0xdb,
+	0x80, 0x10, 0xa7, 0x2c, 0xf8, 0x03, 0x6d, 0x70, 0x82, 0x3c, 0xba, 0x4b, 0xfc, 0x00, 0xc9, 0xc6,
+	0x7d, 0x85, 0x8c, 0x77, 0x93, 0x78, 0x17, 0xa5, 0x23, 0x21, 0xa1, 0x3c, 0x2d, 0x67, 0xf3, 0xa6,
+	0x80, 0x3f, 0xd0, 0xfe, 0x07, 0x85, 0xed, 0xfe, 0x04, 0x8b, 0x8e, 0xd2, 0xe2, 0xfc, 0x98, 0x03,
+	0xa7, 0x95, 0x54, 0x79, 0xe5, 0x71, 0x84, 0xf9, 0xb9, 0x6c, 0x80, 0xc1, 0x6c, 0x05, 0xea, 0xf8,
+	0x67, 0x64, 0x23, 0x57, 0x69, 0x16, 0x84, 0x6d, 0x6f, 0xd4, 0x96, 0x12, 0x65, 0xd3, 0xa0, 0x2b,
+	0x0c, 0x81, 0x06, 0x0c, 0x0a, 0xbc, 0xfd, 0xdf, 0x3a, 0xc5, 0xa7, 0x8f, 0x58, 0xa2, 0xf3, 0xa4,
+	0x12, 0x4a, 0x15, 0x29, 0x11, 0xfd, 0xa9, 0x5c, 0x5d, 0x85, 0x4a, 0xd8, 0x54, 0x9b, 0x55, 0x65,
+	0xe0, 0x66, 0xf5, 0x2d, 0x64, 0xb2, 0x19, 0xa6, 0xdd, 0x76, 0xb0, 0x7f, 0xa3, 0x44, 0x3f, 0xbd,
+	0x9a, 0x17, 0x81, 0x8e, 0xe7, 0xbe, 0x28, 0x22, 0x5e, 0x47, 0x0c, 0x9d, 0xa4, 0x8c, 0x78, 0xcd,
+	0x33, 0x13, 0x31, 0xac, 0xbe, 0x0c, 0x4e, 0xb5, 0xa1, 0x33, 0x38, 0x15, 0xaf, 0x04, 0xa3, 0x8f,
+	0xff, 0x4a, 0xf0, 0xed, 0x64, 0x5a, 0xfe, 0x64, 0x62, 0xba, 0x77, 0x8a, 0xb5, 0x5e, 0x4d, 0x9d,
+	0x2d, 0xbd, 0x10, 0x4c, 0xdc, 0x7c, 0x6d, 0x8f, 0x0d, 0xbb, 0xb6, 0x2f, 0x12, 0xb2, 0x1d, 0xf7,
+	0xa2, 0x66, 0x90, 0xec, 0x5f, 0x5d, 0xf5, 0xc6, 0xcd, 0x1b, 0xc8, 0xb2, 0x2a, 0x01, 0x0d, 0x4b,
+	0xdf, 0x0f, 0x26, 0x1e, 0xb2, 0x1f, 0x7c, 0x94, 0x4c, 0xb0, 0xb8, 0x18, 0xda, 0x5c, 0xca, 0x3c,
+	0x72, 0x68, 0xd7, 0xac, 0xdc, 0x5d, 0x5f, 0x12, 0x81, 0x9c, 0x9e, 0xfb, 0x71, 0x42, 0x76, 0xc2,
+	0x28, 0x4c, 0x5b, 0x8c, 0xfa, 0xe4, 0xa1, 0xa9, 0xab, 0x7e, 0xae, 0x29, 0x2a, 0xa0, 0x51, 0xc4,
+	0xc8, 0x24, 0x9a, 0x66, 0x61, 0x27, 0xc8, 0x68, 0x53, 0x65, 0xdc, 0xf0, 0x98, 0x52, 0x5d, 0x45,
+	0x26, 0x5d, 0x2a, 0x22, 0x3c, 0x28, 0x03, 0x42, 0x3f, 0x21, 0x63, 0xe3, 0x9a, 0x3f, 0xd4, 0xc6,
+	0x55, 0xb2, 0x41, 0x2c, 0xbc, 0x73, 0x1b, 0x84, 0xfb, 0x3f, 0x1d, 0x72, 0x42, 0xee, 0x60, 0xa9,
+	0x1a, 0xa7, 0xd3, 0x6c, 0x2f, 0x6d, 0xd8, 0x78, 0x15, 0x48, 0x25, 0xe7, 0x83, 0x22, 0x17, 0x2e,
+	0x9d, 0x52, 0xf9, 0x31, 0xfa, 0xca, 0x1f, 0x94, 0x01, 0x3f, 0xff, 0xf6, 0xc2, 0x42, 0xff, 0xeb,
+	0x54, 0x8a, 0x38, 0x6e, 0x04, 0x3f, 0xf8, 0xf6, 0xc2, 0x9c, 0xfc, 0x9d, 0x7f, 0xc3, 0xbe, 0x4e,
+	0xba, 0x7f, 0xd3, 0x21, 0xd3, 0xea, 0xcb, 0xae, 0xc4, 0x69, 0xe6, 0x9d, 0x3b, 0xef, 0x58, 0x55,
+	0xbc, 0x31, 0x2f, 0xc8, 0x4b, 0x3a, 0x0b, 0x30, 0x39, 0x96, 0x1c, 0x63, 0xcf, 0xbc, 0x2b, 0x8e,
+	0xb1, 0x67, 0x49, 0xad, 0x1b, 0x37, 0xaf, 0x6e, 0x7a, 0x53, 0xa6, 0xa0, 0xb8, 0x89, 0x40, 0xe0,
+	0x65, 0xe8, 0x3a, 0xd4, 0x0c, 0x68, 0x27, 0x8e, 0xd4, 0xdb, 0x17, 0x53, 0x5c, 0x0e, 0xe5, 0x30,
+	0x50, 0xa5, 0xa8, 0x4e, 0x88, 0x84, 0x90, 0xe4, 0x3d, 0x65, 0x4b, 0x9d, 0x20, 0xc5, 0x2e, 0xce,
+	0x55, 0xfe, 0x02, 0xc5, 0xc9, 0x6d, 0x63, 0x4c, 0x0d, 0x13, 0x67, 0x66, 0x6c, 0x7d, 0x58, 0xae,
+	0x2c, 0x95, 0x11, 0x35, 0xf8, 0x3f, 0x08, 0x1e, 0xba, 0xf4, 0x34, 0xfb, 0x78, 0xa4, 0xa7, 0xe7,
+	0xf1, 0x6d, 0x90, 0xb0, 0xdd, 0x4c, 0x68, 0xe4, 0xcd, 0x31, 0x2d, 0xdf, 0x14, 0x7f, 0x17, 0x84,
+	0xc3, 0x40, 0x95, 0xba, 0xdf, 0x4a, 0xa6, 0xe3, 0x5e, 0xc6, 0x4e, 0x81, 0x1b, 0xcc, 0xd9, 0xf1,
+	0x04, 0x43, 0x67, 0xf3, 0x73, 0x43, 0x2f, 0x00, 0x13, 0x0f, 0x4f, 0xe3, 0x56, 0x9c, 0x66, 0xd2,
+	0x1d, 0xd6, 0x3b, 0x63, 0x9e, 0xc6, 0x57, 0xb4, 0x32, 0x30, 0x30, 0x31, 0xc4, 0xf5, 0x44, 0xa7,
+	0xa8, 0xef, 0xf0, 0xce, 0xb2, 0x91, 0xa9, 0x1f, 0x83, 0x2a, 0x85, 0x47, 0x0b, 0xf4, 0x81, 0xa1,
+	0xbf, 0x11, 0x2c, 0xdb, 0x6d, 0xba, 0x1f, 0x35, 0x5a, 0x49, 0x1c, 0x99, 0xcd, 0x7b, 0xd2, 0x56,
+	0x84, 0x3d, 0xdb, 0xf7, 0xca, 0x58, 0x2c, 0x3f, 0x89, 0x5e, 0x50, 0xa5, 0x45, 0x50, 0xde, 0x28,
+	0xf7, 0x43, 0x64, 0x2e, 0x0b, 0xd2, 0x3d, 0x7e, 0x03, 0xc0, 0x9a, 0xb4, 0xe9, 0x3d, 0xcd, 0x1d,
+	0x98, 0xd0, 0xb6, 0xbb, 0x55, 0x28, 0x83, 0x3e, 0xec, 0xf9, 0x55, 0x72, 0xa6, 0x7c, 0xf7, 0x7d,
+	0xd8, 0xa5, 0xbd, 0xaa, 0x5f, 0xda, 0xd7, 0xc8, 0x93, 0x03, 0xbb, 0x85, 0x62, 0x85, 0xbc, 0x81,
+	0x15, 0xdc, 0x93, 0xfb, 0x6e, 0x4c, 0x33, 0x64, 0x4a, 0x7f, 0x2c, 0xce, 0xff, 0x3f, 0x55, 0x42,
+	0x72, 0xeb, 0x1c, 0xba, 0xc7, 0x71, 0x4b, 0xe0, 0xd5, 0xd5, 0x23, 0xe7, 0xa1, 0x5a, 0x31, 0x08,
+	0x40, 0x81, 0xa0, 0xdb, 0x21, 0x2e, 0x87, 0xf0, 0xdf, 0x47, 0xf1, 0xe8, 0x60, 0x0e, 0x10, 0x2b,
+	0x7d, 0x44, 0xa0, 0x84, 0x30, 0xf6, 0x28, 0x8b, 0xf7, 0x68, 0x74, 0x13, 0xd6, 0x8f, 0x92, 0x24,
+	0x8d, 0xfb, 0x00, 0x18, 0x04, 0xa0, 0x40, 0xd0, 0xf5, 0xc9, 0x28, 0x53, 0x08, 0xcb, 0x38, 0x36,
+	0xb6, 0x41, 0x31, 0xb1, 0x12, 0x23, 0xee, 0xd9, 0x5f, 0x3c, 0x6b, 0x66, 0x64, 0xae, 0x37, 0x66,
+	0x83, 0x91, 0x11, 0x6c, 0x37, 0x6d, 0x59, 0x57, 0x2f, 0xe9, 0xd4, 0xf3, 0x18, 0x05, 0x03, 0x9c,
+	0x42, 0xa1, 0x11, 0xfe, 0x87, 0xc9, 0xc9, 0x92, 0xea, 0x56, 0x94, 0x42, 0xe8, 0x35, 0xad, 0xa5,
+	0x20, 0x47, 0x9b, 0x45, 0x5c, 0xb7, 0xee, 0x7e, 0xbc, 0x51, 0xef, 0x73, 0x3f, 0x56, 0x20, 0xc8,
+	0x19, 0x0e, 0xe3, 0x35, 0x5d, 0x9a, 0x2f, 0xfd, 0x1d, 0x6e, 0xf6, 0xa1, 0xbd, 0xa6, 0x7f, 0xb8,
+	0x46, 0x72, 0x4a, 0x87, 0xcc, 0x41, 0x98, 0xfb, 0x58, 0x57, 0x0e, 0xf4, 0xb1, 0x6e, 0x92, 0xd9,
+	0x80, 0x79, 0xb0, 0x1c, 0x31, 0xf3, 0x20, 0x7f, 0x81, 0xc2, 0xa4, 0x00, 0x45, 0x92, 0xc8, 0x25,
+	0xcd, 0xab, 0x32, 0x2e, 0x23, 0x87, 0xe6, 0x52, 0x37, 0x29, 0x40, 0x91, 0xa4, 0xfb, 0x31, 0xe2,
+	0x35, 0x12, 0x1a, 0x64, 0x94, 0xf7, 0xf1, 0xea, 0xce, 0x8d, 0x38, 0xdb, 0x4c, 0x68, 0x4a, 0xa3,
+	0x4c, 0xe4, 0x18, 0x96, 0xb1, 0x26, 0xde, 0xca, 0x00, 0x3c, 0x18, 0x48, 0x01, 0xef, 0xa4, 0xcc,
+	0x05, 0x26, 0xcc, 0xf6, 0xd9, 0x26, 0x22, 0x7c, 0x83, 0x94, 0x1c, 0x58, 0xd7, 0x0b, 0xc1, 0xc4,
+	0x75, 0x7f, 0xc8, 0x21, 0xd3, 0x6d, 0x69, 0x24, 0x64, 0x91, 0x0f, 0x63, 0xb6, 0x1c, 0x02, 0x36,
+	0xea, 0xf5, 0x75, 0x9d, 0x32, 0x97, 0x46, 0x0c, 0x10, 0x98, 0xbc, 0x8b, 0x69, 0x20, 0xc7, 0x87,
+	0x4c, 0x03, 0xf9, 0xbb, 0x0e, 0x99, 0x2b, 0x72, 0x73, 0xf7, 0xc8, 0x33, 0x9d, 0x20, 0xd9, 0xbb,
+	0x1a, 0xed, 0x24, 0x2c, 0x5e, 0x35, 0xe3, 0x93, 0x61, 0x69, 0x27, 0xa3, 0xc9, 0x6a, 0xb0, 0xcf,
+	0x9d, 0x2e, 0x6a, 0xea, 0x4d, 0xd7, 0x67, 0xae, 0x1f, 0x84, 0x0c, 0x07, 0xd3, 0x42, 0xef, 0x68,
+	0x44, 0x60, 0x59, 0xa2, 0xc3, 0x38, 0xca, 0x99, 0x54, 0x18, 0x13, 0xe5, 0x1d, 0x7d, 0xbd, 0x0c,
+	0x09, 0xca, 0xeb, 0xe2, 0x3b, 0xb4, 0x3c, 0x7d, 0xc0, 0x23, 0x59, 0xad, 0xfd, 0x2f, 0x56, 0x89,
+	0x14, 0x2d, 0xff, 0x6a, 0x3b, 0x01, 0xe0, 0x21, 0xca, 0x5f, 0xde, 0x13, 0xaa, 0x2d, 0xc2, 0xa3,
+	0xbb, 0x10, 0x02, 0xa2, 0x04, 0x65, 0x6e, 0x19, 0x12, 0x24, 0xdf, 0xaa, 0x64, 0x3b, 0x99, 0x80,
+	0x81, 0x2a, 0xe5, 0xaf, 0x21, 0x62, 0x9d, 0x74, 0x2d, 0x6c, 0x4b, 0x3d, 0x96, 0xf6, 0x1a, 0xa2,
+	0x2a, 0x02, 0x1d, 0x0f, 0x4d, 0xb1, 0xd3, 0x32, 0x16, 0x17, 0x43, 0xfd, 0x52, 0x4c, 0x5b, 0x93,
+	0xe2, 0x3f, 0xf6, 0xb4, 0xea, 0x79, 0xa6, 0x0a, 0xda, 0xd5, 0x0c, 0xcb, 0xc8, 0x04, 0x38, 0x2f,
+	0xff, 0xef, 0x8d, 0x90, 0x09, 0xf5, 0x8d, 0x86, 0x30, 0x64, 0x5c, 0xcc, 0x5f, 0x58, 0xe0, 0x1b,
+	0xb7, 0xa7, 0xbd, 0xae, 0x80, 0xca, 0xab, 0xa5, 0x68, 0x9f, 0x07, 0xd8, 0xe6, 0x4f, 0x2d, 0xbc,
+	0x68, 0xfa, 0xc5, 0x9c, 0xd1, 0xa7, 0xad, 0x86, 0xcf, 0x91, 0xdc, 0xbb, 0xba, 0x5b, 0xd2, 0x88,
+	0xad, 0x43, 0x50, 0xf9, 0x5c, 0x0c, 0xf6, 0x47, 0x2a, 0x3c, 0xef, 0x59, 0x1b, 0xea, 0x79, 0xcf,
+	0x17, 0xc8, 0x08, 0x8d, 0x7a, 0x1d, 0x26, 0x61, 0x4d, 0xb0, 0xbb, 0xc9, 0xc8, 0xa5, 0xa8, 0xd7,
+	0x31, 0x7b, 0xc6, 0x50, 0xdc, 0x0f, 0x92, 0xc9, 0x26, 0x4d, 0x1b, 0x49, 0xc8, 0xb2, 0x57, 0x09,
+	0xed, 0xdf, 0xd3, 0x4c, 0xa5, 0x9a, 0x83, 0xcd, 0x8a, 0x7a, 0x05, 0xb7, 0x47, 0x46, 0xf9, 0xf3,
+	0xd6, 0xde, 0xb8, 0xad, 0x54, 0xdc, 0xea, 0xcb, 0xd7, 0x19, 0x61, 0x29, 0x4e, 0xe2, 0xff, 0x20,
+	0x98, 0xf9, 0xff, 0xb9, 0x42, 0x4e, 0x29, 0x3c, 0xe6, 0x59, 0xc2, 0x11, 0x54, 0xc2, 0x44, 0x67,
+	0x60, 0xc2, 0x44, 0x2d, 0x96, 0xb1, 0xf2, 0x90, 0x58, 0xc6, 0x98, 0x8c, 0x75, 0xc2, 0x28, 0xec,
+	0xf4, 0xa4, 0x2b, 0x9a, 0x3d, 0xed, 0x0c, 0xbb, 0x54, 0x5f, 0xe7, 0xc4, 0x41, 0x72, 0x61, 0x0c,
+	0x83, 0xbb, 0x8c, 0xe1, 0xc8, 0xb1, 0x30, 0xe4, 0xc4, 0x41, 0x72, 0xe1, 0x01, 0x58, 0x6f, 0xf4,
+	0xc2, 0x84, 0x65, 0x2e, 0x51, 0xb7, 0x78, 0x10, 0x30, 0x50, 0xa5, 0xfe, 0x1f, 0x55, 0xc9, 0x6c,
+	0xe1, 0xcb, 0xfc, 0xbf, 0xc1, 0x3e, 0xdc, 0x60, 0xdf, 0x91, 0x5e, 0x51, 0x35, 0x5b, 0xef, 0x4b,
+	0x95, 0x2d, 0x81, 0x7e, 0x6f, 0x2b, 0xe3, 0x2b, 0x8f, 0x1e, 0xf8, 0x95, 0xdf, 0x24, 0xa3, 0x9b,
+	0xed, 0xde, 0x6e, 0x18, 0xb9, 0x5d, 0x32, 0xca, 0x53, 0xd3, 0x79, 0x8e, 0xad, 0xc1, 0xe1, 0x02,
+	0x83, 0xe6, 0x01, 0xcb, 0x7e, 0x83, 0xe0, 0x83, 0x26, 0x5d, 0x54, 0xf1, 0x5d, 0x5e, 0x71, 0xff,
+	0x7a, 0xdf, 0xd3, 0xb1, 0xdf, 0x50, 0xf2, 0x74, 0xec, 0x34, 0x43, 0x2e, 0x79, 0x35, 0xb6, 0x4d,
+	0xa6, 0x99, 0x97, 0x81, 0x94, 0x84, 0xc5, 0xe5, 0xfa, 0xe5, 0x21, 0xb3, 0xb9, 0xe9, 0x55, 0x85,
+	0x5c, 0xa8, 0x83, 0xc0, 0x24, 0xee, 0x5e, 0x27, 0x27, 0xf9, 0xbb, 0x1b, 0xab, 0xb4, 0x1d, 0xec,
+	0x17, 0xf2, 0x6b, 0x3f, 0x25, 0xdf, 0x1b, 0x5f, 0xed, 0x47, 0x81, 0xb2, 0x7a, 0x2c, 0xdb, 0xb1,
+	0xa9, 0x36, 0x67, 0x8b, 0x88, 0x26, 0x0d, 0x2a, 0xee, 0x30, 0x35, 0x6d, 0x11, 0x71, 0x30, 0xc8,
+	0xf2, 0xc3, 0x18, 0x5c, 0xef, 0xb0, 0x0c, 0x7c, 0xbb, 0x54, 0x46, 0x1b, 0x6c, 0xd8, 0x33, 0x01,
+	0xd4, 0x91, 0xae, 0x91, 0x7c, 0x6f, 0x97, 0xf2, 0xe4, 0x7b, 0xbb, 0x34, 0xf5, 0x3f, 0x46, 0xa6,
+	0x0d, 0xc4, 0xe1, 0x9e, 0x0e, 0x92, 0x23, 0x50, 0x39, 0x78, 0x04, 0xfc, 0x5f, 0x1b, 0x21, 0x9a,
+	0x6f, 0xc4, 0x10, 0xb4, 0xdf, 0x28, 0x78, 0xc2, 0x5c, 0xb7, 0xe2, 0x09, 0x23, 0xdd, 0x4b, 0xf8,
+	0xe9, 0x65, 0x3a, 0xbf, 0x60, 0xa3, 0x5a, 0xb4, 0xdd, 0xf5, 0xaa, 0x66, 0xa3, 0xae, 0xd0, 0x76,
+	0x17, 0x58, 0x89, 0xca, 0x5e, 0x33, 0x32, 0x30, 0x7b, 0x4d, 0x8b, 0xd4, 0x76, 0x31, 0xd4, 0xd5,
+	0xab, 0xd9, 0x72, 0x8b, 0x62, 0x91, 0xb3, 0x7c, 0xf7, 0x60, 0xff, 0x02, 0x67, 0x80, 0xb2, 0x4f,
+	0x4b, 0xba, 0x13, 0x7b, 0xa3, 0xb6, 0x64, 0x1f, 0xe5, 0xa1, 0xcc, 0x65, 0x1f, 0xf5, 0x13, 0x72,
+	0x66, 0xa8, 0xd5, 0x6e, 0xf0, 0x9c, 0x9c, 0xde, 0x98, 0x2d, 0xad, 0xb6, 0x48, 0xf2, 0xc9, 0xb7,
+	0x68, 0xf1, 0x03, 0x24, 0x1b, 0xff, 0x02, 0x99, 0xd4, 0x5e, 0x00, 0xc5, 0xcf, 0xa0, 0xd2, 0x41,
+	0x6a, 0x9f, 0x01, 0x9d, 0x5d, 0x80, 0x95, 0xf8, 0x3f, 0x3b, 0x42, 0x94, 0xbd, 0x47, 0xcf, 0x80,
+	0x12, 0x34, 0xb4, 0xe4, 0xb5, 0x46, 0x62, 0x35, 0xcc, 0xcd, 0xc0, 0x4b, 0xf1, 0x76, 0xdc, 0xa1,
+	0xc9, 0xae, 0xd2, 0x46, 0x7a, 0x15, 0xf3, 0x76, 0x7c, 0x5d, 0x2f, 0x04, 0x13, 0x17, 0x55, 0x1b,
+	0x1d, 0xe1, 0x35, 0x59, 0x0c, 0x8a, 0x93, 0xde, 0x94, 0xa0, 0x30, 0x58, 0xf6, 0xbb, 0x8e, 0xe6,
+	0x64, 0x29, 0xc4, 0x35, 0x1b, 0xae, 0x2a, 0x1a, 0x55, 0xee, 0xec, 0xae, 0x43, 0xc0, 0xe0, 0x8a,
+	0x41, 0xb5, 0x29, 0xcd, 0x36, 0xee, 0x30, 0xcb, 0x90, 0xc8, 0x3b, 0xe7, 0x8d, 0x98, 0x41, 0xb5,
+	0xf5, 0x22, 0x02, 0xf4, 0xd7, 0x29, 0x8d, 0x3b, 0xaa, 0x1d, 0x3a, 0xee, 0x68, 0x95, 0xcc, 0xed,
+	0x04, 0x61, 0xbb, 0x97, 0xd0, 0x81, 0xd1, 0x4b, 0x6b, 0x85, 0x72, 0xe8, 0xab, 0xc1, 0xe2, 0xba,
+	0xdb, 0xc1, 0x6e, 0xea, 0x8d, 0x69, 0x71, 0xdd, 0x08, 0x00, 0x0e, 0xf7, 0x7f, 0xc9, 0x21, 0x3c,
+	0xaf, 0xed, 0xd2, 0x0e, 0x1a, 0x89, 0xb3, 0x7d, 0xf7, 0xab, 0x0e, 0x99, 0x43, 0x53, 0xd1, 0x52,
+	0x94, 0x85, 0x12, 0x68, 0xef, 0xb9, 0x3b, 0xc6, 0xeb, 0x46, 0x81, 0x3c, 0x57, 0xd8, 0x17, 0xa1,
+	0xd0, 0xd7, 0x0c, 0xff, 0x2c, 0x39, 0x5d, 0x4a, 0xc0, 0xff, 0x6d, 0x87, 0xe4, 0xf9, 0x21, 0xdc,
+	0xd7, 0x48, 0xad, 0xcd, 0x92, 0x45, 0x3a, 0x47, 0xcc, 0x75, 0xc4, 0xc6, 0x89, 0x67, 0x93, 0xe4,
+	0x94, 0x70, 0xbd, 0x6f, 0xf3, 0xc7, 0x22, 0xbc, 0x8a, 0xad, 0xf5, 0x2e, 0x5e, 0x9f, 0xe0, 0xeb,
+	0x5d, 0xfc, 0x00, 0xc9, 0xc6, 0xff, 0x8b, 0x1a, 0x31, 0x33, 0x0e, 0x1f, 0x47, 0xb7, 0x56, 0xf1,
+	0x32, 0x9e, 0x25, 0x32, 0x43, 0x69, 0xc5, 0x48, 0xfd, 0x37, 0x09, 0x79, 0xd1, 0x03, 0xf3, 0x27,
+	0xe8, 0xd5, 0xdc, 0x4f, 0xe5, 0x83, 0x53, 0xb5, 0x3d, 0x38, 0x67, 0xb4, 0xc1, 0x79, 0x50, 0x32,
+	0x4e, 0xee, 0x3e, 0x19, 0x0f, 0xe4, 0x34, 0x1d, 0xb1, 0x15, 0x37, 0x6c, 0x2c, 0x09, 0xe1, 0x97,
+	0x2d, 0x7e, 0x81, 0x62, 0x57, 0xf0, 0x74, 0xaf, 0x0d, 0xe3, 0xe9, 0xee, 0xfe, 0xa0, 0x43, 0x26,
+	0x79, 0xfe, 0x34, 0x9e, 0x5e, 0x85, 0x9b, 0x1a, 0x2c, 0x58, 0xfe, 0xfa, 0xd2, 0xb8, 0xe4, 0x4a,
+	0x95, 0x8d, 0x9c, 0x1f, 0xe8, 0xcc, 0xdd, 0xaf, 0x39, 0x64, 0x36, 0x8e, 0xf4, 0xc4, 0x3a, 0x7c,
+	0xa7, 0xb0, 0xb2, 0xd4, 0x4b, 0xf3, 0xf5, 0xe4, 0x71, 0xff, 0x1b, 0x26, 0x5f, 0x28, 0x36, 0x04,
+	0x83, 0x85, 0x48, 0xfe, 0x4a, 0x2d, 0xbe, 0x7a, 0x96, 0xbe, 0x6c, 0xe8, 0xfa, 0x6d, 0xe4, 0xec,
+	0x13, 0x14, 0xb5, 0x14, 0x3c, 0x02, 0x02, 0x8a, 0xdb, 0xc3, 0xec, 0x13, 0x7f, 0xe6, 0x90, 0x53,
+	0x65, 0xaf, 0xe9, 0xbe, 0x83, 0x2d, 0x3e, 0xac, 0x69, 0x42, 0x54, 0xd8, 0x4c, 0xe8, 0x4e, 0x78,
+	0xb7, 0xe4, 0xf9, 0x2f, 0x5e, 0x00, 0x39, 0x8e, 0xff, 0xa7, 0x63, 0x44, 0x31, 0x3e, 0x26, 0x53,
+	0xc6, 0x73, 0xa8, 0x76, 0xdc, 0xcd, 0x2f, 0x2c, 0x33, 0x79, 0x62, 0xa9, 0xdd, 0x90, 0xa7, 0x91,
+	0xc2, 0xbf, 0x78, 0x85, 0x94, 0xd1, 0xac, 0xd2, 0xef, 0x9d, 0xbf, 0x22, 0xc3, 0x61, 0xa0, 0x4a,
+	0xcb, 0x8c, 0x23, 0xb5, 0xc7, 0x62, 0x1c, 0x19, 0xb5, 0x6f, 0x1c, 0xe9, 0x60, 0x16, 0x28, 0xb6,
+	0xa5, 0x30, 0x8b, 0x84, 0x60, 0x34, 0x75, 0x68, 0x5b, 0x6d, 0xbd, 0x8f, 0x08, 0x94, 0x10, 0x66,
+	0xae, 0xb9, 0x71, 0x9b, 0x2e, 0xc1, 0x0d, 0xa1, 0x88, 0xcb, 0x5d, 0x73, 0x39, 0x18, 0x64, 0xf9,
+	0x11, 0xad, 0x11, 0xee, 0xaf, 0x38, 0x07, 0x98, 0x7b, 0x26, 0x6c, 0xc9, 0x1f, 0xa5, 0x89, 0xf1,
+	0x97, 0x9f, 0x3e, 0xa2, 0x0d, 0xe9, 0x6b, 0x0e, 0x39, 0x41, 0xa3, 0x46, 0xb2, 0xcf, 0xe8, 0x08,
+	0x6a, 0xc2, 0x25, 0xf0, 0xa6, 0x8d, 0xb5, 0x7e, 0xa9, 0x48, 0x9c, 0xbb, 0x73, 0xf4, 0x81, 0xa1,
+	0xbf, 0x19, 0xee, 0x06, 0x19, 0x6f, 0x04, 0x62, 0x5e, 0x4c, 0x1e, 0x66, 0x5e, 0x70, 0x6f, 0x99,
+	0x25, 0x31, 0x1b, 0x14, 0x11, 0x7c, 0xd9, 0xf6, 0x64, 0x49, 0x93, 0x58, 0xa2, 0x85, 0x0e, 0x2e,
+	0x80, 0xab, 0xcd, 0xe2, 0xf2, 0xbf, 0x26, 0xe0, 0xa0, 0x30, 0xdc, 0x4d, 0x72, 0x6a, 0xaf, 0x93,
+	0xe6, 0x54, 0xd0, 0x1f, 0x8b, 0xde, 0x95, 0x9b, 0x81, 0x74, 0x17, 0x3c, 0x75, 0xad, 0x04, 0x07,
+	0x4a, 0x6b, 0xa2, 0xa8, 0x4c, 0xa3, 0x60, 0xbb, 0x4d, 0xf3, 0x22, 0x11, 0x03, 0xa0, 0x44, 0xe5,
+	0x4b, 0x85, 0x72, 0xe8, 0xab, 0x81, 0x39, 0x00, 0x9f, 0x4a, 0x69, 0x72, 0x9b, 0x26, 0xf5, 0xb0,
+	0x49, 0x57, 0x7a, 0x69, 0x16, 0x77, 0x68, 0x72, 0x44, 0x03, 0xe7, 0xc2, 0xfd, 0x7b, 0x0b, 0x4f,
+	0xd5, 0x07, 0x53, 0x83, 0x83, 0x58, 0xf9, 0x7f, 0xd7, 0x21, 0xd5, 0xfa, 0xfa, 0x46, 0xf1, 0x5d,
+	0x34, 0x67, 0xc8, 0x77, 0xd1, 0x2e, 0x62, 0xf6, 0xd8, 0x4e, 0xb7, 0x4d, 0xf1, 0x45, 0x93, 0x62,
+	0xde, 0x96, 0x15, 0x55, 0x02, 0x1a, 0x96, 0x91, 0x1c, 0xbd, 0xfa, 0xb0, 0xe4, 0xe8, 0x18, 0xca,
+	0x31, 0x53, 0x67, 0x8a, 0x76, 0x75, 0xb1, 0xb4, 0xfd, 0xde, 0xcd, 0x73, 0x2a, 0x5d, 0x65, 0xe1,
+	0x94, 0x30, 0x13, 0x4c, 0xfa, 0x9f, 0x24, 0x73, 0x75, 0xda, 0x09, 0xba, 0x2d, 0x96, 0x1f, 0x89,
+	0x87, 0x3d, 0x60, 0x8e, 0x6c, 0x09, 0x2b, 0x3e, 0x18, 0xae, 0x90, 0x21, 0xc7, 0xc1, 0xc7, 0x6b,
+	0x79, 0xf0, 0x86, 0x4c, 0xf8, 0x32, 0x29, 0xc3, 0x29, 0x78, 0xf2, 0x01, 0xfe, 0x8f, 0xff, 0xf3,
+	0x15, 0x32, 0x95, 0xd7, 0xa7, 0x3b, 0xee, 0x2e, 0x99, 0x6d, 0x68, 0x69, 0x40, 0xf2, 0x00, 0xec,
+	0xe1, 0x33, 0x86, 0xf0, 0x67, 0xb8, 0x4c, 0x22, 0x50, 0xa4, 0x7a, 0xf8, 0x78, 0x98, 0x4f, 0x15,
+	0xe2, 0x61, 0xac, 0x98, 0x3f, 0xd0, 0xc5, 0x49, 0x45, 0xd3, 0xd0, 0x1d, 0xe9, 0xd6, 0xd8, 0x17,
+	0x5e, 0xf3, 0xa5, 0x0a, 0x99, 0x55, 0xe3, 0x24, 0x1c, 0xa1, 0x3e, 0x5d, 0x8c, 0x82, 0xb1, 0x60,
+	0x2a, 0x2f, 0x7e, 0xf8, 0x03, 0x22, 0x61, 0x3e, 0x5d, 0x8c, 0x84, 0x39, 0x56, 0xf6, 0x7d, 0xbe,
+	0x5d, 0x3f, 0x5f, 0x21, 0xe3, 0x2a, 0xff, 0xf3, 0x6b, 0xa4, 0xc6, 0x94, 0x3a, 0x8f, 0x76, 0x8f,
+	0x63, 0x0a, 0x22, 0xe0, 0x94, 0x90, 0x24, 0x73, 0x21, 0xf7, 0x2a, 0x8f, 0x42, 0x92, 0x39, 0xa4,
+	0x03, 0xa7, 0xe4, 0x5e, 0x23, 0x55, 0x7c, 0x60, 0xa2, 0x7a, 0x44, 0x82, 0x63, 0x28, 0x23, 0x5f,
+	0x8a, 0x9a, 0x80, 0x54, 0x58, 0x12, 0x7a, 0x2e, 0x8d, 0x16, 0x02, 0x6e, 0x85, 0x28, 0x2a, 0x4a,
+	0xfd, 0x65, 0x62, 0x3c, 0x50, 0x70, 0xa4, 0x80, 0xef, 0x1f, 0xaa, 0x92, 0x51, 0xcc, 0x71, 0x16,
+	0x66, 0xee, 0xcf, 0x39, 0xe4, 0xe4, 0x9d, 0xc2, 0xd3, 0x67, 0xf9, 0x22, 0xbd, 0x69, 0xcf, 0x62,
+	0xac, 0x11, 0xcf, 0x15, 0xeb, 0x25, 0x85, 0x50, 0xd6, 0x1c, 0xe3, 0x25, 0x9d, 0xea, 0xb1, 0xbc,
+	0xa4, 0x73, 0xf7, 0x98, 0x83, 0xd2, 0xa7, 0x07, 0x05, 0xa4, 0xfb, 0xbf, 0x56, 0x23, 0x84, 0x7f,
+	0x8d, 0x8d, 0x6e, 0x36, 0x8c, 0xd2, 0xfb, 0x15, 0x32, 0xb5, 0x4b, 0x23, 0x9a, 0xc8, 0x40, 0x97,
+	0xc2, 0x23, 0xe7, 0x97, 0xb5, 0x32, 0x30, 0x30, 0xd9, 0x64, 0x41, 0xef, 0x4d, 0x7e, 0x11, 0x29,
+	0x06, 0x9e, 0xab, 0x12, 0xd0, 0xb0, 0xdc, 0x45, 0xc3, 0xb3, 0x83, 0xdb, 0x19, 0x67, 0x0e, 0x70,
+	0xc4, 0xf8, 0x20, 0x99, 0x31, 0x13, 0x4c, 0x0a, 0x71, 0x58, 0x39, 0xf5, 0x99, 0x79, 0x29, 0xa1,
+	0x80, 0x8d, 0x0b, 0xa1, 0x89, 0x37, 0xe1, 0x48, 0xc8, 0xc5, 0x6a, 0x21, 0xac, 0x32, 0x28, 0x88,
+	0x52, 0x1c, 0x05, 0x2e, 0x21, 0x70, 0xb8, 0xc8, 0xee, 0x97, 0x67, 0xe6, 0xd3, 0xca, 0xc0, 0xc0,
+	0x44, 0x0e, 0xc2, 0x68, 0x40, 0xcc, 0xa5, 0x56, 0xd0, 0xf4, 0x77, 0xc9, 0x4c, 0x6c, 0x2a, 0x3b,
+	0xb9, 0x90, 0xf8, 0x81, 0x21, 0xa7, 0x9e, 0x51, 0x97, 0x3b, 0x63, 0x9a, 0x30, 0x28, 0xd0, 0x47,
+	0x71, 0x46, 0x0f, 0x36, 0x9e, 0x32, 0xc5, 0x99, 0x81, 0xf1, 0xc0, 0x9b, 0xe4, 0x54, 0x37, 0x6e,
+	0x6e, 0x26, 0x61, 0x8c, 0xfe, 0x57, 0x2b, 0xed, 0x20, 0x4d, 0xd9, 0xc4, 0x98, 0x36, 0x05, 0xc6,
+	0xcd, 0x12, 0x1c, 0x28, 0xad, 0x89, 0x37, 0xc6, 0xae, 0x00, 0x32, 0x17, 0xf8, 0x1a, 0x3f, 0xc9,
+	0x24, 0x22, 0xa8, 0x52, 0xff, 0x24, 0x39, 0x51, 0xef, 0x75, 0xbb, 0xed, 0x90, 0x36, 0x95, 0x0b,
+	0x84, 0xff, 0x1d, 0x64, 0x56, 0xbc, 0xb3, 0xa3, 0xa4, 0x9f, 0x43, 0xbd, 0x0a, 0xe7, 0x7f, 0x33,
+	0x99, 0x2d, 0x1c, 0xa5, 0x0f, 0xf1, 0xea, 0xf4, 0xff, 0x43, 0x95, 0xcc, 0x16, 0x1c, 0x8c, 0xd1,
+	0x27, 0xc8, 0x94, 0x72, 0xec, 0xbc, 0x18, 0xa3, 0xc9, 0x37, 0xe2, 0xf9, 0x97, 0x32, 0x89, 0xa9,
+	0x25, 0x23, 0x66, 0xad, 0x85, 0xbe, 0xb3, 0xb8, 0x52, 0x7e, 0x0e, 0x19, 0x61, 0xb7, 0x9f, 0x21,
+	0x44, 0xb1, 0x95, 0x06, 0x41, 0xdb, 0xfd, 0x64, 0x2b, 0x5e, 0x41, 0x52, 0xd0, 0x38, 0xba, 0x11,
+	0x19, 0x63, 0x0d, 0xa1, 0x32, 0xe1, 0x81, 0xb5, 0xbe, 0x72, 0x53, 0x3c, 0xa7, 0x0d, 0x92, 0x89,
+	0xff, 0xfd, 0x15, 0x52, 0xee, 0x07, 0xef, 0x7e, 0xa6, 0xff, 0x83, 0xbf, 0x66, 0x71, 0x20, 0x38,
+	0x97, 0x03, 0xbe, 0x79, 0x64, 0x7e, 0xf3, 0xeb, 0x96, 0xc6, 0x41, 0xf0, 0xed, 0xfb, 0xf2, 0xfe,
+	0xff, 0x70, 0xc8, 0xe4, 0xd6, 0xd6, 0xba, 0x12, 0x06, 0x80, 0x9c, 0x11, 0xef, 0x4a, 0x32, 0x67,
+	0x3f, 0x71, 0x93, 0x91, 0x4b, 0x4e, 0x3c, 0x0a, 0x55, 0x2f, 0xc5, 0x80, 0x01, 0x35, 0xdd, 0xab,
+	0xe4, 0xa4, 0x5e, 0x22, 0x0c, 0x33, 0xc2, 0x94, 0xcb, 0x53, 0xbd, 0xf6, 0x17, 0x43, 0x59, 0x9d,
+	0x22, 0x29, 0x61, 0x9d, 0xf1, 0xaa, 0xe5, 0xa4, 0x44, 0x31, 0x94, 0xd5, 0xf1, 0x37, 0xc8, 0xe4,
+	0x56, 0x90, 0xa8, 0x8e, 0x7f, 0x88, 0xcc, 0xe1, 0x55, 0x4d, 0x08, 0x38, 0xeb, 0xf4, 0x36, 0x6d,
+	0x8b, 0x2e, 0xf3, 0xc7, 0x82, 0x0b, 0x65, 0xd0, 0x87, 0xed, 0x7f, 0xf5, 0x1b, 0x88, 0xca, 0x55,
+	0x33, 0xc4, 0x19, 0xdc, 0x55, 0x11, 0x42, 0x35, 0xcb, 0x11, 0x42, 0xea, 0x34, 0x2a, 0x44, 0x09,
+	0x65, 0x79, 0x94, 0xd0, 0xa8, 0xed, 0x28, 0x21, 0x25, 0x96, 0xf7, 0x45, 0x0a, 0x7d, 0xc5, 0x21,
+	0x53, 0x68, 0x64, 0x52, 0xee, 0x18, 0x5c, 0xf9, 0xfd, 0x31, 0x7b, 0xb1, 0xb1, 0x8b, 0x37, 0x34,
+	0xf2, 0x3c, 0xb2, 0x4f, 0x1d, 0xe2, 0x7a, 0x11, 0x18, 0xed, 0x70, 0xd7, 0x34, 0xa3, 0x06, 0x37,
+	0x87, 0x3e, 0x5d, 0x76, 0xa3, 0x7c, 0xa8, 0x85, 0xe2, 0xae, 0x26, 0x59, 0x4e, 0xd8, 0x52, 0x41,
+	0xcb, 0x6c, 0x1a, 0x9a, 0x55, 0x57, 0x40, 0x34, 0x89, 0xd3, 0x27, 0xa3, 0x3c, 0xcc, 0x4d, 0x24,
+	0x15, 0x66, 0xce, 0x06, 0x3c, 0x04, 0x0e, 0x44, 0x89, 0x9b, 0x49, 0x0f, 0xce, 0x49, 0x6b, 0x6e,
+	0x1e, 0xba, 0x87, 0x68, 0xb9, 0x0b, 0xa7, 0xfb, 0xaa, 0xae, 0xa9, 0x98, 0x1a, 0x46, 0x53, 0x31,
+	0x3d, 0x50, 0x4b, 0xf1, 0x45, 0x87, 0x4c, 0x35, 0xb4, 0x57, 0x43, 0xbd, 0xe7, 0x6d, 0xf9, 0x4f,
+	0x95, 0x3d, 0xee, 0xca, 0x6d, 0xd8, 0x7a, 0x09, 0x18, 0xdc, 0xd9, 0x1b, 0x1f, 0x4c, 0x2d, 0xe3,
+	0x4d, 0xdb, 0x8a, 0x9d, 0x35, 0xd5, 0x3c, 0xd2, 0xe3, 0x11, 0x61, 0x20, 0x78, 0xb9, 0x6f, 0xa1,
+	0x0f, 0x97, 0x50, 0xd6, 0xcc, 0xd8, 0x72, 0x83, 0x2f, 0x7a, 0x2e, 0x48, 0xbf, 0x30, 0x0e, 0x05,
+	0xc5, 0xd1, 0x6d, 0x91, 0x6a, 0x33, 0xd8, 0xf5, 0x66, 0x6d, 0x9d, 0x49, 0xda, 0x7b, 0x31, 0xfc,
+	0x12, 0xbb, 0xba, 0x74, 0x19, 0x90, 0x85, 0x7b, 0x37, 0x7f, 0x76, 0x71, 0xce, 0xda, 0xe9, 0x6b,
+	0x0a, 0x92, 0x5c, 0x26, 0xe8, 0x7b, 0xc5, 0xb1, 0x29, 0x9c, 0x3d, 0xbe, 0xf1, 0xbc, 0x63, 0xe7,
+	0x65, 0x2d, 0x14, 0x3d, 0x79, 0xc6, 0xcb, 0xdc, 0x61, 0x04, 0xb9, 0xb4, 0xb2, 0xac, 0xeb, 0x7d,
+	0x93, 0x2d, 0x2e, 0x2c, 0x6f, 0x23, 0xe3, 0x82, 0xff, 0x01, 0xa3, 0x8e, 0xd1, 0xa7, 0x5d, 0xe6,
+	0xc7, 0xe7, 0xbd, 0xcf, 0xd6, 0xd9, 0xc2, 0xfd, 0x02, 0xf9, 0xdc, 0xe4, 0xff, 0x83, 0xe0, 0xe1,
+	0x5e, 0x22, 0x63, 0xfc, 0xf5, 0x60, 0x1e, 0xdb, 0x39, 0x79, 0x71, 0x7e, 0xf0, 0x1b, 0xc4, 0xf9,
+	0x41, 0xc1, 0x7f, 0xa7, 0x20, 0xeb, 0xba, 0x5f, 0x72, 0xc8, 0x0c, 0xee, 0xa8, 0x2b, 0xf9, 0xcb,
+	0xca, 0xae, 0xad, 0x3d, 0x0b, 0x13, 0x16, 0xe7, 0x7b, 0x8d, 0xba, 0x48, 0x5e, 0x35, 0xd8, 0x41,
+	0x81, 0xbd, 0xfb, 0x69, 0x32, 0x9e, 0x86, 0x4d, 0xda, 0x08, 0x92, 0xd4, 0x3b, 0x79, 0x3c, 0x4d,
+	0xc9, 0x2d, 0x8c, 0x82, 0x11, 0x28, 0x96, 0xee, 0x8f, 0x39, 0x64, 0x36, 0x48, 0x1a, 0xad, 0xf0,
+	0x36, 0x5d, 0x8f, 0x1b, 0xfc, 0xe2, 0x73, 0xca, 0xd6, 0xda, 0x97, 0xb6, 0x54, 0x49, 0x59, 0x18,
+	0xde, 0x4c, 0x76, 0x50, 0xe4, 0x8f, 0x81, 0xeb, 0xa7, 0xf9, 0xbb, 0x90, 0xc5, 0xa7, 0x4e, 0x4f,
+	0x1f, 0x51, 0x89, 0xc5, 0x82, 0x52, 0x97, 0xca, 0x48, 0x42, 0x39, 0x27, 0xf6, 0x92, 0x90, 0xf9,
+	0x3a, 0xf5, 0x19, 0xab, 0x3e, 0x09, 0xc3, 0xbf, 0x48, 0x5d, 0x7c, 0xd3, 0xec, 0xec, 0x10, 0x6f,
+	0x9a, 0xe9, 0xef, 0x50, 0xbd, 0x70, 0xe0, 0x3b, 0x54, 0x37, 0xc9, 0x64, 0x16, 0xb7, 0xc5, 0xfb,
+	0x15, 0xa9, 0xe7, 0xb1, 0x19, 0x78, 0xae, 0x6c, 0x6d, 0x6d, 0x29, 0xb4, 0xfc, 0xae, 0x9f, 0xc3,
+	0x52, 0xd0, 0xe9, 0xb0, 0xa0, 0x2c, 0xf1, 0xde, 0x66, 0xc2, 0x2e, 0xf9, 0x4f, 0x16, 0x82, 0xb2,
+	0xf4, 0x42, 0x30, 0x71, 0xd1, 0x83, 0xab, 0xdb, 0xa7, 0x25, 0xe0, 0x59, 0x28, 0x94, 0x07, 0x57,
+	0xbf, 0x8a, 0xa0, 0xbf, 0xce, 0x80, 0x17, 0x76, 0x9e, 0x3e, 0xca, 0x0b, 0x3b, 0x6e, 0x93, 0x3c,
+	0x1d, 0xf4, 0xb2, 0x98, 0x39, 0x5f, 0x9b, 0x55, 0x78, 0xd4, 0xd9, 0x79, 0x1e, 0xc8, 0x76, 0xff,
+	0xde, 0xc2, 0xd3, 0x4b, 0x07, 0xe0, 0xc1, 0x81, 0x54, 0x30, 0x07, 0x35, 0x15, 0xaf, 0x04, 0x79,
+	0xdf, 0x60, 0xeb, 0xe8, 0x37, 0xdf, 0x1d, 0x92, 0x01, 0x3d, 0x1c, 0x06, 0x8a, 0x9f, 0xbb, 0x45,
+	0x26, 0x5b, 0x71, 0x9a, 0x2d, 0xb5, 0xc3, 0x20, 0xa5, 0xa9, 0x48, 0xd4, 0x50, 0x2a, 0x51, 0x5d,
+	0x91, 0x68, 0xf9, 0x4c, 0xb8, 0x92, 0xd7, 0x04, 0x9d, 0x8c, 0x4b, 0xc9, 0xac, 0x0c, 0xb9, 0x93,
+	0x16, 0x42, 0x9e, 0x86, 0xe2, 0xb9, 0x32, 0xca, 0x9b, 0x71, 0xb3, 0x6e, 0x62, 0x2b, 0x33, 0xba,
+	0x0e, 0x84, 0x22, 0x4d, 0xd4, 0xb3, 0x75, 0xe3, 0x26, 0xbe, 0xf0, 0xbc, 0x19, 0xe0, 0xfb, 0x27,
+	0x0b, 0xa6, 0xb6, 0x71, 0x53, 0x2b, 0x03, 0x03, 0x13, 0x3d, 0xc2, 0x3a, 0x3c, 0xaf, 0x9a, 0xf7,
+	0xac, 0xad, 0x1b, 0x8b, 0x48, 0xd4, 0x26, 0x34, 0x03, 0xfc, 0x07, 0x48, 0x36, 0x98, 0x81, 0x71,
+	0xb6, 0x10, 0x0a, 0xef, 0xbd, 0xc7, 0xa6, 0x6d, 0x47, 0x23, 0xbc, 0xfc, 0x1c, 0x1b, 0x3e, 0x13,
+	0xf8, 0xa0, 0x1f, 0x04, 0xc5, 0x16, 0xf1, 0x71, 0x61, 0xe9, 0x13, 0xbd, 0xf7, 0xda, 0x1b, 0x17,
+	0x46, 0x50, 0x8e, 0x0b, 0xfb, 0x01, 0x92, 0x0d, 0xfa, 0x26, 0x88, 0xd4, 0xef, 0xde, 0x73, 0xa6,
+	0x6f, 0x82, 0xc8, 0x10, 0x0f, 0xb2, 0xbc, 0x2f, 0xe1, 0xe1, 0x8b, 0xb6, 0x12, 0x1e, 0xaa, 0xfb,
+	0xde, 0x11, 0x12, 0x1e, 0xe2, 0x6b, 0x9f, 0x2d, 0xda, 0xd8, 0xe3, 0x7a, 0xeb, 0xf7, 0x5b, 0x7b,
+	0xed, 0x53, 0xd1, 0x14, 0xaf, 0x7d, 0xaa, 0xdf, 0xa0, 0xf1, 0x9b, 0xff, 0x0e, 0x72, 0xa2, 0xef,
+	0x8e, 0x7a, 0xa8, 0x7c, 0x87, 0x8f, 0x98, 0x2f, 0x11, 0xdf, 0xe2, 0xd3, 0x33, 0x47, 0x59, 0x7f,
+	0x42, 0xf8, 0x15, 0x32, 0xd5, 0x68, 0xf7, 0x52, 0x16, 0x1b, 0x14, 0x77, 0xa5, 0x8f, 0x90, 0x5a,
+	0xe2, 0x2b, 0x5a, 0x19, 0x18, 0x98, 0xfe, 0x15, 0xe2, 0xf6, 0xbf, 0x31, 0x78, 0x24, 0x9b, 0xd4,
+	0x3f, 0x72, 0xc8, 0xb4, 0x21, 0x5c, 0x59, 0xb7, 0x97, 0xaf, 0x11, 0xb7, 0x13, 0x26, 0x49, 0x9c,
+	0x70, 0xd9, 0xf5, 0x3a, 0x9e, 0x0d, 0xa9, 0x48, 0xa3, 0xc7, 0x1c, 0x7d, 0xae, 0xf7, 0x95, 0x42,
+	0x49, 0x0d, 0xff, 0x8f, 0x46, 0x48, 0x1e, 0xed, 0xa7, 0xde, 0xb9, 0x71, 0x06, 0xbe, 0x73, 0xf3,
+	0x22, 0x19, 0xc7, 0x00, 0xda, 0xcd, 0xfc, 0x35, 0x1c, 0xf5, 0x2d, 0x5e, 0xad, 0x6f, 0xdc, 0x60,
+	0x98, 0x0a, 0x83, 0x61, 0xbf, 0xb1, 0x16, 0xb6, 0xb3, 0xfe, 0xe7, 0x52, 0x5e, 0x7d, 0x8d, 0xc3,
+	0x41, 0x61, 0x60, 0x2e, 0x03, 0x7a, 0x9b, 0x2a, 0x1b, 0x8b, 0xba, 0xce, 0x8b, 0xa7, 0x5b, 0x59,
+	0x19, 0x9a, 0xc6, 0x95, 0x7d, 0xa6, 0xf8, 0x22, 0xa6, 0x32, 0xe2, 0x40, 0x8e, 0xc3, 0x24, 0x67,
+	0xa1, 0xd3, 0x17, 0xba, 0xa6, 0xba, 0x8d, 0x7b, 0x5c, 0xc1, 0x4a, 0xc0, 0x8f, 0x4b, 0x09, 0x06,
+	0xc5, 0xb2, 0xcc, 0x67, 0x60, 0xe2, 0x58, 0x7c, 0x06, 0xb4, 0xd0, 0xd3, 0xda, 0xb0, 0xa1, 0xa7,
+	0xe6, 0xdc, 0x1e, 0x1f, 0xca, 0xa3, 0xf5, 0x22, 0x21, 0x22, 0x50, 0x17, 0xdf, 0xb5, 0x22, 0x66,
+	0x1d, 0x50, 0x25, 0xa0, 0x61, 0xe1, 0xe3, 0x0a, 0x63, 0xaf, 0xd3, 0x84, 0xd5, 0x7f, 0x81, 0x8c,
+	0xdd, 0xe6, 0xff, 0x16, 0x53, 0xa4, 0x08, 0x0c, 0x90, 0xe5, 0xf8, 0xad, 0xb7, 0x7b, 0x61, 0xbb,
+	0xb9, 0x9a, 0xaf, 0x7c, 0xf5, 0xad, 0x97, 0x65, 0x01, 0xe4, 0x38, 0x58, 0x61, 0x17, 0xaf, 0x4d,
+	0x1d, 0x74, 0x9b, 0x2e, 0xf8, 0x35, 0x5e, 0x96, 0x05, 0x90, 0xe3, 0xa0, 0xf5, 0x6c, 0x37, 0xcc,
+	0xb6, 0x82, 0xdd, 0xa2, 0xa1, 0xfa, 0x32, 0x83, 0x82, 0x28, 0x65, 0x56, 0xca, 0x30, 0xdb, 0x4a,
+	0x28, 0x53, 0x9b, 0xf7, 0xa5, 0xe3, 0xbb, 0xac, 0x95, 0x81, 0x81, 0xc9, 0x9a, 0x14, 0x8b, 0x9e,
+	0x79, 0xa3, 0x85, 0x26, 0xc9, 0x02, 0xc8, 0x71, 0x70, 0xcd, 0xa0, 0x3e, 0x37, 0x6c, 0x8b, 0x58,
+	0x13, 0x6d, 0xcd, 0xac, 0x08, 0x38, 0x28, 0x0c, 0xc4, 0xc6, 0x6d, 0x0f, 0xb7, 0x2c, 0x6f, 0xdc,
+	0xc4, 0xde, 0x14, 0x70, 0x50, 0x18, 0xfe, 0xeb, 0x64, 0x9a, 0xaf, 0xfe, 0x95, 0x76, 0x10, 0x76,
+	0x2e, 0xaf, 0xb8, 0x97, 0xfa, 0xe2, 0xdb, 0x5e, 0x28, 0x89, 0x6f, 0x3b, 0x6d, 0x54, 0xea, 0x8f,
+	0x73, 0xf3, 0xbf, 0x5e, 0x21, 0xe3, 0xd2, 0xfc, 0x6d, 0x98, 0xb7, 0x9d, 0x63, 0x31, 0x6f, 0x77,
+	0xc9, 0x48, 0xda, 0xa5, 0x0d, 0x61, 0x98, 0xb0, 0x19, 0x09, 0xde, 0xa5, 0x8d, 0x7c, 0xdb, 0xc3,
+	0x5f, 0xc0, 0x38, 0xb9, 0x77, 0x59, 0x88, 0x1a, 0xe6, 0x46, 0xaa, 0xda, 0x12, 0xb7, 0x15, 0x4f,
+	0x46, 0xd7, 0x88, 0x51, 0xc3, 0x2c, 0x48, 0x82, 0x1f, 0x26, 0x03, 0x3f, 0x23, 0x51, 0xe5, 0x45,
+	0xf9, 0xf2, 0x0a, 0x7b, 0x7c, 0xff, 0xf8, 0x07, 0x3a, 0x31, 0x06, 0x7a, 0xd3, 0xde, 0x55, 0xff,
+	0xf2, 0xca, 0xc0, 0xa1, 0x7e, 0xb3, 0x30, 0xd4, 0x60, 0x95, 0xeb, 0xc1, 0x83, 0xfd, 0xe7, 0x0e,
+	0x99, 0x2f, 0x1f, 0xec, 0xf5, 0x30, 0xc5, 0x0c, 0x25, 0xc5, 0x01, 0x5f, 0x1c, 0x32, 0x92, 0x33,
+	0x4c, 0xf9, 0x70, 0xab, 0xc5, 0x29, 0x21, 0xda, 0x60, 0x7f, 0x5a, 0x06, 0xe5, 0x56, 0x6c, 0xe5,
+	0xbe, 0x2b, 0xef, 0x4a, 0x7e, 0xb0, 0x1a, 0x0f, 0x21, 0xfc, 0x77, 0x87, 0x9c, 0x92, 0x15, 0xd8,
+	0x89, 0xbb, 0x1c, 0x46, 0xcc, 0x97, 0xea, 0xf8, 0xa7, 0xd9, 0x5b, 0xc6, 0x34, 0xfb, 0x88, 0xbd,
+	0x8e, 0xeb, 0xfd, 0x18, 0x34, 0xe1, 0xfc, 0xff, 0xe6, 0x10, 0xaf, 0xac, 0xc2, 0x63, 0xf8, 0xe4,
+	0x9f, 0x32, 0x3f, 0xf9, 0xeb, 0xc7, 0xd3, 0xf3, 0xc1, 0x1f, 0xdc, 0x1b, 0x34, 0x50, 0x6e, 0x5b,
+	0xca, 0x62, 0x8e, 0x2d, 0x83, 0x3f, 0x67, 0x51, 0x2e, 0xd4, 0xb5, 0xc9, 0x68, 0xca, 0x9c, 0x86,
+	0xbc, 0x8a, 0x2d, 0x25, 0x31, 0x77, 0x42, 0x12, 0x06, 0x0c, 0xf6, 0x3f, 0x08, 0x1e, 0xfe, 0x2f,
+	0x55, 0xc8, 0x59, 0xd9, 0x71, 0x66, 0x2f, 0xcd, 0xd7, 0x07, 0x7b, 0x87, 0x31, 0x50, 0x3f, 0xed,
+	0xbd, 0xc3, 0x98, 0xb3, 0xc8, 0xd7, 0x42, 0x0e, 0x03, 0x8d, 0x27, 0x66, 0xc9, 0x61, 0xef, 0x26,
+	0xae, 0x85, 0x51, 0xd0, 0x0e, 0xdf, 0xa4, 0x09, 0xd0, 0x4e, 0x7c, 0x3b, 0x68, 0x0b, 0xe9, 0x5e,
+	0x65, 0xc9, 0x59, 0x2b, 0x43, 0x82, 0xf2, 0xba, 0x7d, 0x8a, 0x8f, 0xea, 0xb0, 0x8a, 0x0f, 0xff,
+	0x0f, 0x1c, 0x32, 0xa5, 0x46, 0xeb, 0xf8, 0x97, 0x44, 0x6c, 0x2e, 0x89, 0x57, 0xed, 0x2d, 0x89,
+	0x01, 0xcb, 0xe0, 0x5e, 0x8d, 0xcc, 0x49, 0x14, 0xf5, 0x52, 0xc2, 0xf7, 0x39, 0xca, 0xad, 0x8a,
+	0xbb, 0xaf, 0x7e, 0xdc, 0x5e, 0x3b, 0x0e, 0xf3, 0x3a, 0x01, 0x86, 0x1c, 0x18, 0x1a, 0x8c, 0x8a,
+	0xad, 0x94, 0xb4, 0x7d, 0xad, 0x39, 0x82, 0x26, 0xe3, 0x2b, 0x0e, 0x21, 0xbc, 0x9d, 0xe2, 0x91,
+	0x2c, 0x6c, 0xdb, 0xf6, 0xb1, 0x8d, 0x14, 0x32, 0xe1, 0x4d, 0x53, 0x4b, 0x28, 0x2f, 0x00, 0xad,
+	0x25, 0x8f, 0xf0, 0x26, 0xc3, 0x23, 0x3f, 0x07, 0xf1, 0x25, 0x87, 0xcc, 0x16, 0x9a, 0x5b, 0x52,
+	0x7f, 0x47, 0xaf, 0x6f, 0x45, 0xb2, 0x32, 0x9f, 0x4e, 0xd2, 0x15, 0x2e, 0xff, 0xca, 0x21, 0xca,
+	0x37, 0x55, 0x58, 0x10, 0x98, 0x7f, 0xd1, 0x8b, 0x64, 0x3c, 0xc8, 0x50, 0x6d, 0x92, 0xc9, 0x2c,
+	0x5f, 0x6a, 0x5d, 0x2e, 0x09, 0x38, 0x28, 0x0c, 0xf7, 0x3b, 0xc9, 0x64, 0x84, 0x2a, 0x55, 0x24,
+	0xb0, 0x24, 0xf7, 0xe9, 0xc3, 0x64, 0xaa, 0x66, 0xa6, 0x88, 0x1b, 0x39, 0x09, 0xd0, 0xe9, 0xe9,
+	0xe9, 0x22, 0xaa, 0x07, 0xa7, 0x8b, 0xf0, 0x7f, 0xb4, 0x42, 0x4e, 0x17, 0xfa, 0x73, 0x7c, 0x11,
+	0xb3, 0x8f, 0x3d, 0x10, 0xb8, 0x70, 0x27, 0xaf, 0x0e, 0xa5, 0x6f, 0xfa, 0x8d, 0xf7, 0xe4, 0x7b,
+	0x34, 0x3b, 0xbe, 0x3f, 0x45, 0x26, 0xa4, 0x42, 0x4c, 0xee, 0x60, 0xaf, 0xda, 0xd3, 0x7a, 0xe6,
+	0x37, 0x58, 0x09, 0x49, 0x21, 0xe7, 0x57, 0x70, 0xcc, 0xad, 0x0c, 0xe5, 0x98, 0x6b, 0x3c, 0xa3,
+	0x55, 0x7d, 0xdc, 0xcf, 0x68, 0x95, 0x5b, 0x80, 0x46, 0x8e, 0xc5, 0x02, 0xf4, 0xb4, 0x75, 0x0b,
+	0xd0, 0x33, 0x8f, 0xd9, 0x02, 0xa4, 0x19, 0xd9, 0x6b, 0x8f, 0x60, 0x64, 0xff, 0x14, 0x39, 0x75,
+	0x3b, 0xd7, 0x2b, 0xa8, 0x99, 0x24, 0x42, 0xa4, 0x5f, 0x28, 0xb5, 0xfb, 0xd0, 0x24, 0x0d, 0xd3,
+	0x8c, 0x46, 0x99, 0xa6, 0x91, 0xc8, 0x7d, 0x82, 0x5f, 0x2f, 0x21, 0x07, 0xa5, 0x4c, 0x8a, 0xd6,
+	0xd2, 0xb1, 0x21, 0xac, 0xa5, 0xbf, 0x80, 0xf6, 0xe6, 0xbe, 0xb0, 0x5f, 0x54, 0xe8, 0x8d, 0xdb,
+	0x0a, 0x57, 0x5c, 0x2a, 0x23, 0x2f, 0xcc, 0xd2, 0x65, 0x45, 0x50, 0xde, 0x20, 0x0c, 0x70, 0x92,
+	0xae, 0x2b, 0xdc, 0x93, 0xbc, 0xdc, 0xcf, 0xe4, 0x6b, 0x45, 0x7f, 0x38, 0xc2, 0x86, 0xfe, 0x13,
+	0x76, 0x15, 0x2a, 0x16, 0x7c, 0xe2, 0x26, 0x1f, 0xc1, 0x27, 0xae, 0x60, 0xba, 0x9e, 0xb2, 0x64,
+	0xba, 0x8e, 0xc8, 0x5c, 0xd8, 0x09, 0x76, 0xe9, 0x66, 0xaf, 0xdd, 0xe6, 0x71, 0x7c, 0xa9, 0x37,
+	0x7d, 0xbe, 0x3a, 0x48, 0xb1, 0x8b, 0x5e, 0x0b, 0x6d, 0x91, 0x66, 0x4a, 0x79, 0xd1, 0xab, 0x78,
+	0xc5, 0xab, 0x05, 0x4a, 0xd0, 0x47, 0x1b, 0x27, 0x2c, 0x4b, 0x2c, 0x4e, 0x33, 0x1c, 0x6d, 0xe6,
+	0x78, 0x35, 0xbe, 0x3c, 0x2b, 0x6d, 0xaa, 0x02, 0x0c, 0x3a, 0x8e, 0x7b, 0x8d, 0x4c, 0x34, 0xa3,
+	0x54, 0xe4, 0x7a, 0x98, 0x65, 0x9b, 0xd9, 0xfb, 0x71, 0x0b, 0x5c, 0xbd, 0x51, 0x57, 0x59, 0x1e,
+	0x9e, 0x2e, 0x79, 0x45, 0x40, 0x95, 0x43, 0x5e, 0xdf, 0xbd, 0xce, 0x88, 0x89, 0x07, 0xdb, 0xb9,
+	0x3f, 0xd4, 0xf9, 0x01, 0xa6, 0xd9, 0xd5, 0x1b, 0xf2, 0xc9, 0xf9, 0x69, 0xc1, 0x8e, 0xff, 0x84,
+	0x9c, 0x02, 0x2a, 0x5e, 0x79, 0x66, 0x02, 0xef, 0x84, 0xa9, 0x78, 0xe5, 0xc9, 0x0b, 0x40, 0x94,
+	0xf2, 0xd7, 0x4c, 0xb2, 0xb6, 0x72, 0xaf, 0x38, 0x67, 0xed, 0x35, 0x93, 0xdc, 0xd3, 0x58, 0xbc,
+	0x66, 0x92, 0x03, 0x40, 0x67, 0xe9, 0x6e, 0x0c, 0x72, 0x33, 0x39, 0xc9, 0x36, 0x8d, 0xc3, 0x3b,
+	0x8d, 0xe8, 0xf1, 0x08, 0xa7, 0x0e, 0x8a, 0x47, 0xe8, 0xf7, 0x8f, 0x38, 0x7d, 0x08, 0xff, 0x88,
+	0x16, 0x7b, 0xbc, 0xe0, 0xf2, 0x8a, 0x77, 0xc6, 0xd6, 0x15, 0x9e, 0xa5, 0x39, 0xe3, 0x42, 0x12,
+	0xfb, 0x17, 0x38, 0x83, 0x81, 0x21, 0x1b, 0x67, 0x8f, 0x1c, 0xb2, 0x51, 0x70, 0x32, 0x78, 0xf2,
+	0xd8, 0x9c, 0x0c, 0xe6, 0x1f, 0x83, 0x93, 0xc1, 0x53, 0x43, 0x3b, 0x19, 0xdc, 0x25, 0x27, 0xbb,
+	0x71, 0x73, 0x35, 0x4c, 0x93, 0x1e, 0x8b, 0x52, 0x5e, 0xee, 0x35, 0x77, 0x69, 0x26, 0x5e, 0x46,
+	0x79, 0xbf, 0xde, 0xc8, 0x2e, 0x5b, 0x95, 0x72, 0xc1, 0x15, 0x2a, 0x20, 0x41, 0xee, 0x82, 0x5e,
+	0x52, 0x08, 0x65, 0x2c, 0x74, 0xf7, 0x86, 0xf3, 0x8f, 0xc7, 0xbd, 0xe1, 0x43, 0x64, 0x3c, 0x6d,
+	0xf5, 0xb2, 0x66, 0x7c, 0x27, 0x62, 0x3e, 0x2c, 0x13, 0xcb, 0xef, 0x51, 0xa6, 0x07, 0x01, 0x7f,
+	0x80, 0xb9, 0x93, 0xc4, 0xff, 0x9a, 0xd5, 0x41, 0x40, 0xdc, 0x9f, 0x19, 0x10, 0xee, 0xe7, 0x1f,
+	0x67, 0xb8, 0xdf, 0xd9, 0x43, 0x85, 0xfa, 0x95, 0xf9, 0x70, 0x3c, 0xfb, 0xae, 0xf3, 0xe1, 0xf8,
+	0xaa, 0x43, 0xa6, 0x6f, 0xeb, 0x26, 0x1e, 0xef, 0x3d, 0xb6, 0xbc, 0xd8, 0x0c, 0xcb, 0xd1, 0xb2,
+	0x8f, 0x9b, 0x96, 0x01, 0x7a, 0x50, 0x04, 0x80, 0xd9, 0x92, 0x12, 0x0f, 0xbb, 0xf7, 0xbe, 0x53,
+	0x1e, 0x76, 0x9f, 0x26, 0x93, 0xdd, 0xb8, 0x29, 0x95, 0x12, 0xcc, 0xf9, 0xc4, 0xae, 0x83, 0x3d,
+	0x97, 0x3f, 0x73, 0x16, 0xa0, 0xf3, 0x43, 0xe7, 0xf3, 0x39, 0x79, 0xc9, 0x12, 0x66, 0xdd, 0xd4,
+	0xfb, 0x46, 0x5b, 0x8d, 0x50, 0x77, 0x3b, 0xfe, 0x9a, 0x46, 0x81, 0x0f, 0xf4, 0x71, 0x46, 0x81,
+	0x44, 0x79, 0x64, 0xee, 0xa6, 0xde, 0xf3, 0xb9, 0x40, 0xb2, 0x94, 0x83, 0x41, 0xc7, 0x71, 0x7f,
+	0xd6, 0x21, 0xb5, 0x56, 0x1c, 0xef, 0xa5, 0xde, 0x0b, 0x6c, 0x43, 0xff, 0xb0, 0x65, 0x41, 0x13,
+	0xdf, 0x17, 0x14, 0xca, 0xab, 0x97, 0xa4, 0xae, 0x8f, 0xc1, 0x1e, 0xdc, 0x5b, 0x98, 0x31, 0x1e,
+	0x79, 0x4e, 0x3f, 0xff, 0xb6, 0x06, 0x11, 0xba, 0x68, 0xd6, 0x34, 0xf7, 0xcb, 0x0e, 0x99, 0xbb,
+	0x53, 0x50, 0x40, 0x79, 0xdf, 0x64, 0xcb, 0x14, 0x55, 0x54, 0x6d, 0xf1, 0xe1, 0x2e, 0x42, 0xa1,
+	0xaf, 0x05, 0xee, 0x17, 0x4c, 0xc5, 0x34, 0x77, 0xa6, 0xb6, 0x38, 0x80, 0x05, 0x45, 0x38, 0xf7,
+	0x21, 0x1a, 0xa0, 0xa1, 0xc6, 0x9b, 0xd0, 0x9d, 0x32, 0x0d, 0x8c, 0xf7, 0xa2, 0xad, 0x9b, 0x50,
+	0xa9, 0x82, 0x87, 0xcb, 0x5a, 0xa5, 0x45, 0x50, 0xde, 0x20, 0xf7, 0x13, 0xa4, 0x9a, 0xb6, 0x63,
+	0xe1, 0x65, 0x75, 0xc9, 0xc2, 0x9e, 0xbb, 0xbe, 0xc1, 0xc3, 0x04, 0xea, 0xeb, 0x1b, 0x80, 0xa4,
+	0x1f, 0xdd, 0xa1, 0x0a, 0xbf, 0x6c, 0x3e, 0x73, 0x4b, 0xaa, 0x52, 0x53, 0x59, 0x68, 0x61, 0xe7,
+	0x33, 0xd6, 0x82, 0xae, 0x2b, 0xfc, 0xc1, 0x79, 0x32, 0x63, 0x1a, 0xa6, 0xdd, 0x0f, 0x98, 0x6f,
+	0x6d, 0x9e, 0x2b, 0xbe, 0xc7, 0x37, 0x2d, 0xf1, 0x8d, 0x37, 0xf9, 0x8c, 0x47, 0xf3, 0x2a, 0xc7,
+	0xfa, 0x68, 0x5e, 0xf5, 0xf1, 0x3c, 0x9a, 0x37, 0x77, 0x1c, 0x8f, 0xe6, 0x9d, 0x38, 0xd4, 0xa3,
+	0x79, 0x3d, 0x72, 0x52, 0x91, 0xcb, 0x9b, 0xee, 0x79, 0x87, 0x1e, 0x00, 0x26, 0xc4, 0x5c, 0xea,
+	0x27, 0x05, 0x65, 0xf4, 0x75, 0xdd, 0xec, 0xc8, 0x43, 0x52, 0xf9, 0x2e, 0x91, 0x59, 0x19, 0x8c,
+	0x48, 0xc5, 0x1b, 0x5b, 0xdc, 0x55, 0x46, 0xe5, 0x81, 0x5b, 0x31, 0x8b, 0xa1, 0x88, 0x8f, 0x1b,
+	0x5d, 0x2d, 0xd2, 0x72, 0xe5, 0x7d, 0xd4, 0xb6, 0xab, 0x05, 0xd3, 0x47, 0x88, 0x63, 0x42, 0x86,
+	0x5f, 0xd4, 0x18, 0xec, 0x81, 0xfc, 0x07, 0x78, 0x0b, 0xf0, 0x49, 0x92, 0x78, 0x67, 0xa7, 0x1d,
+	0x07, 0xcd, 0xfc, 0x05, 0x3f, 0xe9, 0xcb, 0xc3, 0x3d, 0x9f, 0xd4, 0x93, 0x24, 0x1b, 0x03, 0xf0,
+	0x60, 0x20, 0x05, 0xdc, 0x46, 0x67, 0xd3, 0x2c, 0x4e, 0x68, 0x33, 0x57, 0x7e, 0x4d, 0xb0, 0x3e,
+	0x53, 0xeb, 0x7d, 0xae, 0x9b, 0x7c, 0x78, 0xef, 0xd5, 0x47, 0x29, 0x94, 0x42, 0xb1, 0x59, 0x6e,
+	0x42, 0xce, 0x74, 0xcb, 0x74, 0x6f, 0x32, 0x7f, 0xe0, 0x41, 0x1a, 0x40, 0xb9, 0x63, 0x9c, 0x29,
+	0xd5, 0xde, 0xa5, 0x30, 0x80, 0xb2, 0xfe, 0x92, 0xdc, 0xf8, 0xe3, 0x79, 0x49, 0xee, 0xb3, 0x98,
+	0x49, 0x49, 0xe4, 0x52, 0x95, 0xda, 0x9c, 0x6b, 0x56, 0x62, 0xfb, 0x38, 0x4d, 0x3d, 0x2d, 0x93,
+	0x64, 0x03, 0x1a, 0x4b, 0xf7, 0x7f, 0x97, 0x3e, 0x43, 0xc9, 0x55, 0x56, 0xbb, 0xd6, 0xe7, 0xc4,
+	0xbb, 0xee, 0x29, 0xca, 0x7f, 0xe8, 0x90, 0x79, 0x3e, 0xf3, 0x8a, 0x17, 0x2c, 0x14, 0xef, 0xbc,
+	0x99, 0x63, 0x71, 0xf7, 0xe2, 0x69, 0xf1, 0x0c, 0xae, 0x08, 0x87, 0x03, 0x5a, 0x82, 0x86, 0xcf,
+	0xbe, 0x6b, 0xdd, 0xac, 0x2d, 0xd1, 0xa7, 0xfc, 0xc1, 0xbc, 0x93, 0xf7, 0x87, 0xb9, 0xc9, 0xfd,
+	0xe3, 0x81, 0x3a, 0x6a, 0x97, 0x35, 0xef, 0x3b, 0x8f, 0x49, 0x47, 0xad, 0xbf, 0xea, 0x77, 0x28,
+	0x4d, 0xf5, 0x97, 0x1c, 0x32, 0x17, 0x14, 0xdc, 0xb3, 0xbc, 0x93, 0xb6, 0x94, 0x7c, 0x4b, 0x89,
+	0x22, 0xca, 0x05, 0xed, 0xa2, 0x27, 0x18, 0xf4, 0x31, 0x77, 0xbf, 0xee, 0x90, 0xa7, 0xf2, 0xa7,
+	0x03, 0xd3, 0x3c, 0x79, 0x80, 0x68, 0xdc, 0x29, 0xb6, 0x1a, 0xdf, 0xb0, 0xbe, 0x1a, 0xb7, 0x06,
+	0xf3, 0xe4, 0xeb, 0xf2, 0x59, 0xb1, 0x2e, 0x9f, 0x3a, 0x00, 0x13, 0x0e, 0x6a, 0xba, 0xfb, 0x96,
+	0x7c, 0x70, 0x5d, 0x86, 0xc8, 0xdd, 0xb4, 0x2e, 0xa8, 0xb3, 0xa1, 0x9e, 0xcc, 0xdf, 0x70, 0x4f,
+	0xe5, 0x1b, 0xee, 0xec, 0x51, 0xa3, 0x26, 0x6d, 0x84, 0x29, 0xdb, 0x5c, 0xcf, 0xd8, 0xb2, 0x49,
+	0xae, 0x0a, 0x92, 0xb9, 0xc8, 0x28, 0x21, 0x29, 0xe4, 0xfc, 0x4a, 0x5e, 0xb9, 0x3d, 0xfb, 0xb8,
+	0x5f, 0xb9, 0x9d, 0xff, 0x3e, 0x87, 0xbf, 0x42, 0x3e, 0x50, 0xd0, 0xdf, 0x36, 0x05, 0xfd, 0x75,
+	0x9b, 0x0f, 0x0f, 0xeb, 0x37, 0x8e, 0x1f, 0xc1, 0x14, 0xb6, 0x25, 0x02, 0x41, 0x49, 0x93, 0x3e,
+	0x61, 0x36, 0xc9, 0xa2, 0xa2, 0x41, 0x6f, 0x90, 0x95, 0x97, 0x39, 0xe7, 0x6f, 0x90, 0xf3, 0x0f,
+	0x5b, 0x44, 0x0f, 0xa3, 0x37, 0xae, 0x5f, 0x86, 0x7e, 0x7d, 0x4a, 0xb3, 0xaa, 0x67, 0xb4, 0x6b,
+	0x3d, 0x54, 0x25, 0xc2, 0xbc, 0x1b, 0x68, 0x19, 0xf0, 0xa6, 0x6d, 0x8f, 0xae, 0x7c, 0x9b, 0x17,
+	0xa9, 0x83, 0xe0, 0xf2, 0x0e, 0x1b, 0xd9, 0x8b, 0x0f, 0xd3, 0x8f, 0x3c, 0xfe, 0x87, 0xe9, 0xef,
+	0x90, 0x89, 0x3b, 0x61, 0xd6, 0xba, 0x2a, 0xde, 0xbe, 0xa9, 0xda, 0x89, 0x7b, 0x47, 0x72, 0x79,
+	0xdf, 0x6f, 0x49, 0x06, 0x90, 0xf3, 0xc2, 0x28, 0x00, 0xfc, 0xc1, 0x02, 0x54, 0x8a, 0x51, 0x00,
+	0xb7, 0x64, 0x01, 0xe4, 0x38, 0x38, 0x58, 0x53, 0xf8, 0x4b, 0x66, 0x11, 0xf4, 0xc6, 0x6c, 0xcd,
+	0x10, 0x49, 0x91, 0x67, 0x97, 0xb8, 0xa5, 0xf1, 0x00, 0x83, 0xa3, 0x7a, 0xf9, 0x63, 0x7c, 0xe0,
+	0xcb, 0x1f, 0x6f, 0x31, 0x79, 0x39, 0x0b, 0xa3, 0x1e, 0xdd, 0x88, 0xbc, 0x09, 0x5b, 0x9b, 0xd6,
+	0x8a, 0xa2, 0x29, 0x22, 0xd9, 0xd4, 0x6f, 0xd0, 0xf8, 0x69, 0x26, 0xc4, 0xc9, 0x03, 0x4d, 0x88,
+	0xb9, 0xd6, 0x71, 0xca, 0xba, 0xd6, 0x31, 0xa3, 0x5d, 0x3b, 0x5a, 0xc7, 0x82, 0x3f, 0xc2, 0xcc,
+	0x10, 0xfe, 0x08, 0xef, 0x23, 0x13, 0xdb, 0x68, 0x35, 0xaa, 0x63, 0xec, 0xe5, 0x09, 0x56, 0x81,
+	0xd9, 0x5b, 0x97, 0x25, 0x10, 0xf2, 0x72, 0xb7, 0x47, 0x4e, 0xc8, 0x60, 0xee, 0xad, 0x56, 0x42,
+	0x53, 0x4c, 0x94, 0xe9, 0xcd, 0x1e, 0xd1, 0x4d, 0x8a, 0x25, 0x25, 0x5e, 0x2b, 0x92, 0x83, 0x7e,
+	0x0e, 0x6e, 0xa2, 0x1e, 0xc7, 0xc8, 0xb9, 0xce, 0x1d, 0x91, 0xeb, 0x29, 0xed, 0x29, 0x8d, 0x9c,
+	0x69, 0x1f, 0xfd, 0x77, 0x95, 0x3e, 0xed, 0xcf, 0x1d, 0xe2, 0xaa, 0x1b, 0x84, 0x3a, 0x9b, 0x1e,
+	0x83, 0x4b, 0x3d, 0xfa, 0x31, 0x47, 0xec, 0x79, 0x80, 0x54, 0x3e, 0x46, 0x68, 0x4d, 0xa0, 0xe0,
+	0x34, 0xf3, 0x06, 0xe4, 0x30, 0xd0, 0x78, 0xfa, 0x7f, 0xea, 0x90, 0x33, 0xfd, 0x7d, 0x7f, 0x0c,
+	0x2e, 0xc4, 0xfb, 0xa6, 0x0b, 0xf1, 0x96, 0x45, 0x43, 0xa0, 0xea, 0xc6, 0x00, 0x67, 0xe2, 0x3f,
+	0xa9, 0x90, 0x59, 0x1d, 0xb9, 0x4e, 0x1f, 0xc7, 0xc7, 0xbe, 0x63, 0xc4, 0x4f, 0xdc, 0xb4, 0xdb,
+	0xdf, 0xba, 0xb0, 0x27, 0x97, 0xc5, 0xea, 0x7c, 0xb6, 0x10, 0xab, 0x73, 0xcb, 0x3e, 0xeb, 0x83,
+	0x03, 0x76, 0xfe, 0x93, 0xe6, 0xdb, 0x2a, 0x6a, 0x3c, 0x86, 0x09, 0x76, 0xdb, 0x9c, 0x60, 0xaf,
+	0x59, 0xef, 0xf5, 0x80, 0xd9, 0xf5, 0x73, 0x95, 0xbe, 0xde, 0x32, 0x75, 0xc4, 0xf7, 0x3a, 0xa4,
+	0x86, 0xf7, 0x3e, 0xe9, 0xea, 0xf9, 0x89, 0x63, 0x99, 0x01, 0xec, 0x86, 0x2a, 0x0e, 0x3a, 0xd5,
+	0x3e, 0x06, 0x03, 0xce, 0x7d, 0xfe, 0x7b, 0x1c, 0x42, 0x72, 0xa4, 0x77, 0xea, 0x36, 0xe1, 0xff,
+	0xa2, 0xe6, 0x20, 0x6c, 0x4c, 0x23, 0xf7, 0xfb, 0x95, 0x6e, 0xd9, 0xb1, 0xed, 0xab, 0x6e, 0x30,
+	0xd2, 0x55, 0xcc, 0xd3, 0x86, 0x8a, 0x59, 0x68, 0x96, 0xdf, 0xa9, 0xbb, 0xa0, 0xd8, 0xa6, 0xb5,
+	0xc1, 0xfa, 0x63, 0x27, 0x0f, 0x7f, 0x90, 0x83, 0xf9, 0x97, 0x31, 0x84, 0xd3, 0xff, 0x13, 0x2d,
+	0xbe, 0x4d, 0x76, 0xf4, 0x31, 0xec, 0x15, 0x77, 0xcc, 0xbd, 0x02, 0xec, 0x7b, 0xa5, 0x0c, 0xd8,
+	0x2c, 0xde, 0x20, 0x65, 0x6e, 0x2a, 0xc3, 0x65, 0x64, 0x36, 0x12, 0x28, 0x54, 0x86, 0x4e, 0xa0,
+	0x30, 0x4d, 0x26, 0x3f, 0x12, 0xaa, 0x6c, 0xde, 0xcb, 0x8b, 0xbf, 0xf9, 0x87, 0xe7, 0x9e, 0xf8,
+	0xed, 0x3f, 0x3c, 0xf7, 0xc4, 0xd7, 0xff, 0xf0, 0xdc, 0x13, 0x9f, 0xbb, 0x7f, 0xce, 0xf9, 0xcd,
+	0xfb, 0xe7, 0x9c, 0xdf, 0xbe, 0x7f, 0xce, 0xf9, 0xfa, 0xfd, 0x73, 0xce, 0xbf, 0xbd, 0x7f, 0xce,
+	0xf9, 0xd1, 0x3f, 0x3a, 0xf7, 0xc4, 0x47, 0xc6, 0x65, 0xc7, 0xfe, 0xef, 0x00, 0xd4, 0xe3, 0x09,
+	0x0d, 0x33, 0xf4, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -5428,6 +5880,16 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x7a
+	i -= len(m.ResultsKey)
+	copy(dAtA[i:], m.ResultsKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResultsKey)))
+	i--
+	dAtA[i] = 0x72
 	i--
 	if m.Deleted {
 		dAtA[i] = 1
@@ -6619,6 +7081,11 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Jitter)
+	copy(dAtA[i:], m.Jitter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Jitter)))
+	i--
+	dAtA[i] = 0x2a
 	i -= len(m.Cap)
 	copy(dAtA[i:], m.Cap)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cap)))
@@ -6731,6 +7198,51 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArtifactLocation != nil {
+		{
+			size, err := m.ArtifactLocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientCertAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6986,6 +7498,46 @@ func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerResourceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerResourceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerResourceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.IOWriteBytes))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.IOReadBytes))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MemoryPeakBytes))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CPUMilliseconds))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerSetRetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7490,6 +8042,46 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SuccessThreshold != nil {
+		{
+			size, err := m.SuccessThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FailFastThreshold != nil {
+		{
+			size, err := m.FailFastThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -7653,6 +8245,11 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TasksFrom)
+	copy(dAtA[i:], m.TasksFrom)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TasksFrom)))
+	i--
+	dAtA[i] = 0x22
 	if m.FailFast != nil {
 		i--
 		if *m.FailFast {
@@ -7767,6 +8364,97 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Decision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Decision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Decision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.NodeName)
+	copy(dAtA[i:], m.NodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.NodeID)
+	copy(dAtA[i:], m.NodeID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeID)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorMessageRetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorMessageRetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorMessageRetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RetryRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7823,6 +8511,46 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExitCodeRetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitCodeRetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitCodeRetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RetryRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExitCodes) > 0 {
+		for iNdEx := len(m.ExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.ExitCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9020,6 +9748,30 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyComponents) > 0 {
+		keysForKeyComponents := make([]string, 0, len(m.KeyComponents))
+		for k := range m.KeyComponents {
+			keysForKeyComponents = append(keysForKeyComponents, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForKeyComponents)
+		for iNdEx := len(keysForKeyComponents) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.KeyComponents[string(keysForKeyComponents[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForKeyComponents[iNdEx])
+			copy(dAtA[i:], keysForKeyComponents[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForKeyComponents[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -9061,6 +9813,14 @@ func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Auto {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
 	i -= len(m.MaxAge)
 	copy(dAtA[i:], m.MaxAge)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
@@ -9411,6 +10171,32 @@ func (m *NodeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProgressDetail != nil {
+		{
+			size, err := m.ProgressDetail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ResourceUsage) > 0 {
+		for iNdEx := len(m.ResourceUsage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceUsage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -9461,6 +10247,50 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProgressDetail != nil {
+		{
+			size, err := m.ProgressDetail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.ResourceUsage) > 0 {
+		for iNdEx := len(m.ResourceUsage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceUsage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
 	if m.TaskResultSynced != nil {
 		i--
 		if *m.TaskResultSynced {
@@ -10112,6 +10942,11 @@ func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ResultsFile)
+	copy(dAtA[i:], m.ResultsFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResultsFile)))
+	i--
+	dAtA[i] = 0x2a
 	if m.ExitCode != nil {
 		i -= len(*m.ExitCode)
 		copy(dAtA[i:], *m.ExitCode)
@@ -10214,6 +11049,18 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Description != nil {
 		i -= len(*m.Description)
 		copy(dAtA[i:], *m.Description)
@@ -10269,6 +11116,150 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterItemsSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterItemsSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterItemsSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Maximum != nil {
+		{
+			size, err := m.Maximum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Minimum != nil {
+		{
+			size, err := m.Minimum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParameterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Items != nil {
+		{
+			size, err := m.Items.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Maximum != nil {
+		{
+			size, err := m.Maximum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Minimum != nil {
+		{
+			size, err := m.Minimum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Plugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10347,6 +11338,82 @@ func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProgressDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgressDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgressDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgressStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgressStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgressStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10598,6 +11665,53 @@ func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10618,6 +11732,34 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OnErrorMessages) > 0 {
+		for iNdEx := len(m.OnErrorMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OnErrorMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OnExitCodes) > 0 {
+		for iNdEx := len(m.OnExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OnExitCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -10934,6 +12076,44 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SLO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SLO) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SLO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.CompleteBy)
+	copy(dAtA[i:], m.CompleteBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompleteBy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.MaxDuration)
+	copy(dAtA[i:], m.MaxDuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11646,6 +12826,20 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -12278,6 +13472,11 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ResultsKey)
+	copy(dAtA[i:], m.ResultsKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResultsKey)))
+	i--
+	dAtA[i] = 0x52
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -12914,6 +14113,101 @@ func (m *WorkflowMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowRetryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRetryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRetryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	if m.NextRetryAt != nil {
+		{
+			size, err := m.NextRetryAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12934,6 +14228,34 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SLO != nil {
+		{
+			size, err := m.SLO.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.WorkflowRetryStrategy != nil {
+		{
+			size, err := m.WorkflowRetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.ArtifactGC != nil {
 		{
 			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
@@ -13457,6 +14779,64 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EstimatedFinishedAt != nil {
+		{
+			size, err := m.EstimatedFinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.Retries != nil {
+		{
+			size, err := m.Retries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.TaskResultsCompletionStatus) > 0 {
 		keysForTaskResultsCompletionStatus := make([]string, 0, len(m.TaskResultsCompletionStatus))
 		for k := range m.TaskResultsCompletionStatus {
@@ -13733,6 +15113,44 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SuccessThreshold != nil {
+		{
+			size, err := m.SuccessThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.FailFastThreshold != nil {
+		{
+			size, err := m.FailFastThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -14429,6 +15847,10 @@ func (m *Artifact) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	l = len(m.ResultsKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Checksum)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14819,6 +16241,8 @@ func (m *Backoff) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Cap)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Jitter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14852,6 +16276,23 @@ func (m *Cache) Size() (n int) {
 	return n
 }
 
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ArtifactLocation != nil {
+		l = m.ArtifactLocation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ClientCertAuth) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14946,6 +16387,21 @@ func (m *ContainerNode) Size() (n int) {
 	return n
 }
 
+func (m *ContainerResourceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.CPUMilliseconds))
+	n += 1 + sovGenerated(uint64(m.MemoryPeakBytes))
+	n += 1 + sovGenerated(uint64(m.IOReadBytes))
+	n += 1 + sovGenerated(uint64(m.IOWriteBytes))
+	return n
+}
+
 func (m *ContainerSetRetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15179,6 +16635,20 @@ func (m *DAGTask) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Parallelism != nil {
+		n += 1 + sovGenerated(uint64(*m.Parallelism))
+	}
+	if m.FailFastThreshold != nil {
+		l = m.FailFastThreshold.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SuccessThreshold != nil {
+		l = m.SuccessThreshold.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.BatchSize != nil {
+		n += 2 + sovGenerated(uint64(*m.BatchSize))
+	}
 	return n
 }
 
@@ -15199,6 +16669,8 @@ func (m *DAGTemplate) Size() (n int) {
 	if m.FailFast != nil {
 		n += 2
 	}
+	l = len(m.TasksFrom)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15232,6 +16704,38 @@ func (m *DataSource) Size() (n int) {
 	return n
 }
 
+func (m *Decision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ErrorMessageRetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RetryRule.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15254,6 +16758,22 @@ func (m *ExecutorConfig) Size() (n int) {
 	return n
 }
 
+func (m *ExitCodeRetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExitCodes) > 0 {
+		for _, e := range m.ExitCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	l = m.RetryRule.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GCSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15702,6 +17222,14 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.KeyComponents) > 0 {
+		for k, v := range m.KeyComponents {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -15719,6 +17247,7 @@ func (m *Memoize) Size() (n int) {
 	}
 	l = len(m.MaxAge)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -15850,6 +17379,16 @@ func (m *NodeResult) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ResourceUsage) > 0 {
+		for _, e := range m.ResourceUsage {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ProgressDetail != nil {
+		l = m.ProgressDetail.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15938,6 +17477,20 @@ func (m *NodeStatus) Size() (n int) {
 	if m.TaskResultSynced != nil {
 		n += 3
 	}
+	if len(m.ResourceUsage) > 0 {
+		for _, e := range m.ResourceUsage {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ProgressDetail != nil {
+		l = m.ProgressDetail.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16112,6 +17665,8 @@ func (m *Outputs) Size() (n int) {
 		l = len(*m.ExitCode)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.ResultsFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16162,6 +17717,68 @@ func (m *Parameter) Size() (n int) {
 		l = len(*m.Description)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ParameterItemsSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Minimum != nil {
+		l = m.Minimum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maximum != nil {
+		l = m.Maximum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParameterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Minimum != nil {
+		l = m.Minimum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maximum != nil {
+		l = m.Maximum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Items != nil {
+		l = m.Items.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16193,6 +17810,36 @@ func (m *PodGC) Size() (n int) {
 	return n
 }
 
+func (m *ProgressDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Percent))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProgressStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Percent))
+	return n
+}
+
 func (m *Prometheus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16289,6 +17936,23 @@ func (m *RetryNodeAntiAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16311,6 +17975,18 @@ func (m *RetryStrategy) Size() (n int) {
 	}
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OnExitCodes) > 0 {
+		for _, e := range m.OnExitCodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OnErrorMessages) > 0 {
+		for _, e := range m.OnErrorMessages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16405,6 +18081,21 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *SLO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CompleteBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16831,6 +18522,10 @@ func (m *Template) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16903,6 +18598,8 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.ResultsKey)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17099,6 +18796,41 @@ func (m *WorkflowMetadata) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowRetryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	if m.NextRetryAt != nil {
+		l = m.NextRetryAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowRetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *WorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17267,6 +18999,14 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.ArtifactGC.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.WorkflowRetryStrategy != nil {
+		l = m.WorkflowRetryStrategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SLO != nil {
+		l = m.SLO.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17357,6 +19097,24 @@ func (m *WorkflowStatus) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Retries != nil {
+		l = m.Retries.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.EstimatedFinishedAt != nil {
+		l = m.EstimatedFinishedAt.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17409,6 +19167,20 @@ func (m *WorkflowStep) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Parallelism != nil {
+		n += 1 + sovGenerated(uint64(*m.Parallelism))
+	}
+	if m.FailFastThreshold != nil {
+		l = m.FailFastThreshold.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SuccessThreshold != nil {
+		l = m.SuccessThreshold.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.BatchSize != nil {
+		n += 2 + sovGenerated(uint64(*m.BatchSize))
+	}
 	return n
 }
 
@@ -17660,6 +19432,8 @@ func (this *Artifact) String() string {
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`ResultsKey:` + fmt.Sprintf("%v", this.ResultsKey) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17938,6 +19712,7 @@ func (this *Backoff) String() string {
 		`Factor:` + strings.Replace(fmt.Sprintf("%v", this.Factor), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
 		`Cap:` + fmt.Sprintf("%v", this.Cap) + `,`,
+		`Jitter:` + fmt.Sprintf("%v", this.Jitter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17963,6 +19738,18 @@ func (this *Cache) String() string {
 	}, "")
 	return s
 }
+func (this *Checkpoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Checkpoint{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`ArtifactLocation:` + strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClientCertAuth) String() string {
 	if this == nil {
 		return "nil"
@@ -18036,6 +19823,20 @@ func (this *ContainerNode) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerResourceUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerResourceUsage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CPUMilliseconds:` + fmt.Sprintf("%v", this.CPUMilliseconds) + `,`,
+		`MemoryPeakBytes:` + fmt.Sprintf("%v", this.MemoryPeakBytes) + `,`,
+		`IOReadBytes:` + fmt.Sprintf("%v", this.IOReadBytes) + `,`,
+		`IOWriteBytes:` + fmt.Sprintf("%v", this.IOWriteBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContainerSetRetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -18208,6 +20009,10 @@ func (this *DAGTask) String() string {
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`FailFastThreshold:` + strings.Replace(fmt.Sprintf("%v", this.FailFastThreshold), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`SuccessThreshold:` + strings.Replace(fmt.Sprintf("%v", this.SuccessThreshold), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`BatchSize:` + valueToStringGenerated(this.BatchSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18225,6 +20030,7 @@ func (this *DAGTemplate) String() string {
 		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
 		`Tasks:` + repeatedStringForTasks + `,`,
 		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
+		`TasksFrom:` + fmt.Sprintf("%v", this.TasksFrom) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18255,6 +20061,31 @@ func (this *DataSource) String() string {
 	}, "")
 	return s
 }
+func (this *Decision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Decision{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ErrorMessageRetryRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ErrorMessageRetryRule{`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`RetryRule:` + strings.Replace(strings.Replace(this.RetryRule.String(), "RetryRule", "RetryRule", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Event) String() string {
 	if this == nil {
 		return "nil"
@@ -18275,6 +20106,17 @@ func (this *ExecutorConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ExitCodeRetryRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExitCodeRetryRule{`,
+		`ExitCodes:` + fmt.Sprintf("%v", this.ExitCodes) + `,`,
+		`RetryRule:` + strings.Replace(strings.Replace(this.RetryRule.String(), "RetryRule", "RetryRule", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GCSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -18594,10 +20436,21 @@ func (this *MemoizationStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForKeyComponents := make([]string, 0, len(this.KeyComponents))
+	for k := range this.KeyComponents {
+		keysForKeyComponents = append(keysForKeyComponents, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKeyComponents)
+	mapStringForKeyComponents := "map[string]string{"
+	for _, k := range keysForKeyComponents {
+		mapStringForKeyComponents += fmt.Sprintf("%v: %v,", k, this.KeyComponents[k])
+	}
+	mapStringForKeyComponents += "}"
 	s := strings.Join([]string{`&MemoizationStatus{`,
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`KeyComponents:` + mapStringForKeyComponents + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18610,6 +20463,7 @@ func (this *Memoize) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
 		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`Auto:` + fmt.Sprintf("%v", this.Auto) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18730,11 +20584,18 @@ func (this *NodeResult) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForResourceUsage := "[]ContainerResourceUsage{"
+	for _, f := range this.ResourceUsage {
+		repeatedStringForResourceUsage += strings.Replace(strings.Replace(f.String(), "ContainerResourceUsage", "ContainerResourceUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourceUsage += "}"
 	s := strings.Join([]string{`&NodeResult{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`ResourceUsage:` + repeatedStringForResourceUsage + `,`,
+		`ProgressDetail:` + strings.Replace(this.ProgressDetail.String(), "ProgressDetail", "ProgressDetail", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18743,6 +20604,11 @@ func (this *NodeStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForResourceUsage := "[]ContainerResourceUsage{"
+	for _, f := range this.ResourceUsage {
+		repeatedStringForResourceUsage += strings.Replace(strings.Replace(f.String(), "ContainerResourceUsage", "ContainerResourceUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourceUsage += "}"
 	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
 	for k := range this.ResourcesDuration {
 		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
@@ -18780,6 +20646,9 @@ func (this *NodeStatus) String() string {
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`NodeFlag:` + strings.Replace(this.NodeFlag.String(), "NodeFlag", "NodeFlag", 1) + `,`,
 		`TaskResultSynced:` + valueToStringGenerated(this.TaskResultSynced) + `,`,
+		`ResourceUsage:` + repeatedStringForResourceUsage + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`ProgressDetail:` + strings.Replace(this.ProgressDetail.String(), "ProgressDetail", "ProgressDetail", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18912,6 +20781,7 @@ func (this *Outputs) String() string {
 		`Artifacts:` + repeatedStringForArtifacts + `,`,
 		`Result:` + valueToStringGenerated(this.Result) + `,`,
 		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
+		`ResultsFile:` + fmt.Sprintf("%v", this.ResultsFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18943,6 +20813,36 @@ func (this *Parameter) String() string {
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
+		`Schema:` + strings.Replace(this.Schema.String(), "ParameterSchema", "ParameterSchema", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterItemsSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterItemsSchema{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`Minimum:` + strings.Replace(this.Minimum.String(), "Amount", "Amount", 1) + `,`,
+		`Maximum:` + strings.Replace(this.Maximum.String(), "Amount", "Amount", 1) + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParameterSchema{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`Minimum:` + strings.Replace(this.Minimum.String(), "Amount", "Amount", 1) + `,`,
+		`Maximum:` + strings.Replace(this.Maximum.String(), "Amount", "Amount", 1) + `,`,
+		`Items:` + strings.Replace(this.Items.String(), "ParameterItemsSchema", "ParameterItemsSchema", 1) + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18969,6 +20869,34 @@ func (this *PodGC) String() string {
 	}, "")
 	return s
 }
+func (this *ProgressDetail) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStages := "[]ProgressStage{"
+	for _, f := range this.Stages {
+		repeatedStringForStages += strings.Replace(strings.Replace(f.String(), "ProgressStage", "ProgressStage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStages += "}"
+	s := strings.Join([]string{`&ProgressDetail{`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Stages:` + repeatedStringForStages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProgressStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProgressStage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Prometheus) String() string {
 	if this == nil {
 		return "nil"
@@ -19036,16 +20964,39 @@ func (this *RetryNodeAntiAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryRule{`,
+		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForOnExitCodes := "[]ExitCodeRetryRule{"
+	for _, f := range this.OnExitCodes {
+		repeatedStringForOnExitCodes += strings.Replace(strings.Replace(f.String(), "ExitCodeRetryRule", "ExitCodeRetryRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOnExitCodes += "}"
+	repeatedStringForOnErrorMessages := "[]ErrorMessageRetryRule{"
+	for _, f := range this.OnErrorMessages {
+		repeatedStringForOnErrorMessages += strings.Replace(strings.Replace(f.String(), "ErrorMessageRetryRule", "ErrorMessageRetryRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOnErrorMessages += "}"
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`OnExitCodes:` + repeatedStringForOnExitCodes + `,`,
+		`OnErrorMessages:` + repeatedStringForOnErrorMessages + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19107,6 +21058,18 @@ func (this *S3EncryptionOptions) String() string {
 	}, "")
 	return s
 }
+func (this *SLO) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SLO{`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`CompleteBy:` + fmt.Sprintf("%v", this.CompleteBy) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -19397,6 +21360,7 @@ func (this *Template) String() string {
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`Checkpoint:` + strings.Replace(this.Checkpoint.String(), "Checkpoint", "Checkpoint", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19448,6 +21412,7 @@ func (this *ValueFrom) String() string {
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ResultsKey:` + fmt.Sprintf("%v", this.ResultsKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19627,6 +21592,30 @@ func (this *WorkflowMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowRetryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowRetryStatus{`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`NextRetryAt:` + strings.Replace(fmt.Sprintf("%v", this.NextRetryAt), "Time", "v11.Time", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowRetryStrategy{`,
+		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -19723,6 +21712,8 @@ func (this *WorkflowSpec) String() string {
 		`Hooks:` + mapStringForHooks + `,`,
 		`WorkflowMetadata:` + strings.Replace(this.WorkflowMetadata.String(), "WorkflowMetadata", "WorkflowMetadata", 1) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "WorkflowLevelArtifactGC", "WorkflowLevelArtifactGC", 1) + `,`,
+		`WorkflowRetryStrategy:` + strings.Replace(this.WorkflowRetryStrategy.String(), "WorkflowRetryStrategy", "WorkflowRetryStrategy", 1) + `,`,
+		`SLO:` + strings.Replace(this.SLO.String(), "SLO", "SLO", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19741,6 +21732,11 @@ func (this *WorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForDecisions := "[]Decision{"
+	for _, f := range this.Decisions {
+		repeatedStringForDecisions += strings.Replace(strings.Replace(f.String(), "Decision", "Decision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDecisions += "}"
 	keysForNodes := make([]string, 0, len(this.Nodes))
 	for k := range this.Nodes {
 		keysForNodes = append(keysForNodes, k)
@@ -19801,6 +21797,10 @@ func (this *WorkflowStatus) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
 		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
 		`TaskResultsCompletionStatus:` + mapStringForTaskResultsCompletionStatus + `,`,
+		`Retries:` + strings.Replace(this.Retries.String(), "WorkflowRetryStatus", "WorkflowRetryStatus", 1) + `,`,
+		`Decisions:` + repeatedStringForDecisions + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "Amount", "Amount", 1) + `,`,
+		`EstimatedFinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.EstimatedFinishedAt), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19837,6 +21837,10 @@ func (this *WorkflowStep) String() string {
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`FailFastThreshold:` + strings.Replace(fmt.Sprintf("%v", this.FailFastThreshold), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`SuccessThreshold:` + strings.Replace(fmt.Sprintf("%v", this.SuccessThreshold), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`BatchSize:` + valueToStringGenerated(this.BatchSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21055,59 +23059,123 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Deleted = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultsKey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ResultsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24620,6 +26688,38 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			}
 			m.Cap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jitter = BackoffJitter(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24849,7 +26949,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24872,17 +26972,17 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24892,33 +26992,29 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientCertSecret == nil {
-				m.ClientCertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24928,81 +27024,27 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientKeySecret == nil {
-				m.ClientKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25029,40 +27071,10 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ArtifactLocation == nil {
+				m.ArtifactLocation = &ArtifactLocation{}
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25087,7 +27099,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25110,15 +27122,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25145,13 +27157,16 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientCertSecret == nil {
+				m.ClientCertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25178,8 +27193,10 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientKeySecret == nil {
+				m.ClientKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25204,7 +27221,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Column) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25227,17 +27244,17 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Column: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25247,29 +27264,30 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25279,55 +27297,24 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25350,7 +27337,7 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25373,17 +27360,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25393,29 +27380,30 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25425,55 +27413,25 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25496,7 +27454,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *Column) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25519,17 +27477,17 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Column: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25539,28 +27497,27 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25588,7 +27545,39 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25611,7 +27600,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25634,15 +27623,15 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25670,13 +27659,13 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25686,27 +27675,55 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retries == nil {
-				m.Retries = &intstr.IntOrString{}
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25729,7 +27746,7 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25752,49 +27769,15 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25821,16 +27804,15 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25840,27 +27822,453 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &ContainerSetRetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerResourceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerResourceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerResourceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUMilliseconds", wireType)
+			}
+			m.CPUMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUMilliseconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryPeakBytes", wireType)
+			}
+			m.MemoryPeakBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryPeakBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IOReadBytes", wireType)
+			}
+			m.IOReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IOReadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IOWriteBytes", wireType)
+			}
+			m.IOWriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IOWriteBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
+			}
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &ContainerSetRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27603,6 +30011,118 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFastThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailFastThreshold == nil {
+				m.FailFastThreshold = &intstr.IntOrString{}
+			}
+			if err := m.FailFastThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuccessThreshold == nil {
+				m.SuccessThreshold = &intstr.IntOrString{}
+			}
+			if err := m.SuccessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27740,6 +30260,38 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.FailFast = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TasksFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27964,7 +30516,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *Decision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27987,17 +30539,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Decision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Decision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28007,77 +30559,28 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28105,63 +30608,13 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.NodeID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28171,28 +30624,27 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28220,7 +30672,39 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Reason = DecisionReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28243,7 +30727,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ErrorMessageRetryRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28266,15 +30750,47 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ErrorMessageRetryRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ErrorMessageRetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28301,13 +30817,63 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28335,7 +30901,7 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28358,7 +30924,7 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28381,15 +30947,15 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28417,11 +30983,137 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitCodeRetryRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitCodeRetryRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitCodeRetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28448,10 +31140,7 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28476,7 +31165,7 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28499,17 +31188,17 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28519,48 +31208,28 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Realtime = &b
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28588,7 +31257,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = GaugeOperation(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28611,7 +31280,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28634,17 +31303,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28654,27 +31323,28 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28702,31 +31372,61 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Depth = &v
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28754,11 +31454,11 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28785,54 +31485,68 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28842,31 +31556,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -28883,12 +31593,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			b := bool(v != 0)
+			m.Realtime = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28898,23 +31609,349 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableSubmodules = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = GaugeOperation(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depth = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSubmodules = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -31847,6 +34884,133 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyComponents == nil {
+				m.KeyComponents = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.KeyComponents[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31997,6 +35161,26 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			}
 			m.MaxAge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Auto = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33137,61 +36321,11 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33201,29 +36335,31 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.ResourceUsage = append(m.ResourceUsage, ContainerResourceUsage{})
+			if err := m.ResourceUsage[len(m.ResourceUsage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDetail", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33233,59 +36369,177 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			if m.ProgressDetail == nil {
+				m.ProgressDetail = &ProgressDetail{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ProgressDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34091,61 +37345,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.TaskResultSynced = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34155,127 +37359,283 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.ResourceUsage = append(m.ResourceUsage, ContainerResourceUsage{})
+			if err := m.ResourceUsage[len(m.ResourceUsage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Auth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Auth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDetail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressDetail == nil {
+				m.ProgressDetail = &ProgressDetail{}
+			}
+			if err := m.ProgressDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Auth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Auth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34385,7 +37745,761 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointParams = append(m.EndpointParams, OAuth2EndpointParam{})
+			if err := m.EndpointParams[len(m.EndpointParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2EndpointParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2EndpointParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LifecycleRule == nil {
+				m.LifecycleRule = &OSSLifecycleRule{}
+			}
+			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			}
+			m.MarkInfrequentAccessAfterDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34395,29 +38509,16 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndpointParams", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
 			}
-			var msglen int
+			m.MarkDeletionAfterDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34427,26 +38528,11 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EndpointParams = append(m.EndpointParams, OAuth2EndpointParam{})
-			if err := m.EndpointParams[len(m.EndpointParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34468,7 +38554,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
+func (m *Object) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34491,49 +38577,17 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2EndpointParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2EndpointParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34543,23 +38597,25 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34582,7 +38638,7 @@ func (m *OAuth2EndpointParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+func (m *Outputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34605,15 +38661,15 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34640,13 +38696,48 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34674,7 +38765,73 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultsFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultsFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34697,7 +38854,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34720,15 +38877,15 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34755,42 +38912,11 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34812,7 +38938,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34835,15 +38961,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34871,11 +38997,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34903,13 +39029,14 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34919,31 +39046,28 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34970,18 +39094,18 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
 			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34991,15 +39115,27 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35027,13 +39163,13 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35043,33 +39179,30 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LifecycleRule == nil {
-				m.LifecycleRule = &OSSLifecycleRule{}
-			}
-			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35079,12 +39212,28 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseSDKCreds = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ParameterSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35106,7 +39255,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+func (m *ParameterItemsSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35129,17 +39278,17 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterItemsSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterItemsSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.MarkInfrequentAccessAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35149,16 +39298,29 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			m.MarkDeletionAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35168,66 +39330,65 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Object) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Minimum == nil {
+				m.Minimum = &Amount{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Minimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35237,25 +39398,59 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Maximum == nil {
+				m.Maximum = &Amount{}
+			}
+			if err := m.Maximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Required = append(m.Required, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35278,7 +39473,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *ParameterSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35301,15 +39496,79 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35336,14 +39595,16 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Minimum == nil {
+				m.Minimum = &Amount{}
+			}
+			if err := m.Minimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35370,16 +39631,18 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Maximum == nil {
+				m.Maximum = &Amount{}
+			}
+			if err := m.Maximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35389,28 +39652,31 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			if m.Items == nil {
+				m.Items = &ParameterItemsSchema{}
+			}
+			if err := m.Items.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35438,8 +39704,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
+			m.Required = append(m.Required, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35462,7 +39727,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *Plugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35485,15 +39750,15 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35520,8 +39785,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35546,7 +39810,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35569,15 +39833,15 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35605,77 +39869,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35702,80 +39900,16 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35803,8 +39937,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35827,7 +39960,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *ProgressDetail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35850,15 +39983,66 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProgressDetail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProgressDetail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35885,7 +40069,8 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stages = append(m.Stages, ProgressStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35910,7 +40095,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *ProgressStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35933,15 +40118,15 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProgressStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProgressStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35969,49 +40154,13 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
-			var stringLen uint64
+			m.Percent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36021,24 +40170,11 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Percent |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36864,6 +41000,128 @@ func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetryRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36922,16 +41180,120 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &intstr.IntOrString{}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36959,11 +41321,11 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExitCodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36990,16 +41352,14 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
-			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OnExitCodes = append(m.OnExitCodes, ExitCodeRetryRule{})
+			if err := m.OnExitCodes[len(m.OnExitCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnErrorMessages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37026,45 +41386,11 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OnErrorMessages = append(m.OnErrorMessages, ErrorMessageRetryRule{})
+			if err := m.OnErrorMessages[len(m.OnErrorMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37953,6 +42279,152 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SLO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SLO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SLO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompleteBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41495,6 +45967,42 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42162,6 +46670,38 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43775,109 +48315,497 @@ func (m *WorkflowMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelsFrom == nil {
+				m.LabelsFrom = make(map[string]LabelValueFrom)
+			}
+			var mapkey string
+			mapvalue := &LabelValueFrom{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LabelValueFrom{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LabelsFrom[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowRetryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowRetryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowRetryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRetryAt == nil {
+				m.NextRetryAt = &v11.Time{}
+			}
+			if err := m.NextRetryAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowRetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowRetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelsFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43887,120 +48815,23 @@ func (m *WorkflowMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelsFrom == nil {
-				m.LabelsFrom = make(map[string]LabelValueFrom)
-			}
-			var mapkey string
-			mapvalue := &LabelValueFrom{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LabelValueFrom{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.LabelsFrom[mapkey] = *mapvalue
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45558,6 +50389,78 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowRetryStrategy == nil {
+				m.WorkflowRetryStrategy = &WorkflowRetryStrategy{}
+			}
+			if err := m.WorkflowRetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SLO", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SLO == nil {
+				m.SLO = &SLO{}
+			}
+			if err := m.SLO.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46342,67 +51245,290 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &SynchronizationStatus{}
+			if m.Synchronization == nil {
+				m.Synchronization = &SynchronizationStatus{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
+			}
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGCStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGCStatus == nil {
+				m.ArtifactGCStatus = &ArtGCStatus{}
+			}
+			if err := m.ArtifactGCStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskResultsCompletionStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskResultsCompletionStatus == nil {
+				m.TaskResultsCompletionStatus = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TaskResultsCompletionStatus[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retries == nil {
+				m.Retries = &WorkflowRetryStatus{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
-			}
-			m.EstimatedDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46429,16 +51555,14 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactRepositoryRef == nil {
-				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
-			}
-			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Decisions = append(m.Decisions, Decision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGCStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46465,16 +51589,16 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactGCStatus == nil {
-				m.ArtifactGCStatus = &ArtGCStatus{}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &Amount{}
 			}
-			if err := m.ArtifactGCStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskResultsCompletionStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46501,91 +51625,12 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskResultsCompletionStatus == nil {
-				m.TaskResultsCompletionStatus = make(map[string]bool)
+			if m.EstimatedFinishedAt == nil {
+				m.EstimatedFinishedAt = &v11.Time{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.EstimatedFinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TaskResultsCompletionStatus[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47137,6 +52182,118 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFastThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailFastThreshold == nil {
+				m.FailFastThreshold = &intstr.IntOrString{}
+			}
+			if err := m.FailFastThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuccessThreshold == nil {
+				m.SuccessThreshold = &intstr.IntOrString{}
+			}
+			if err := m.SuccessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
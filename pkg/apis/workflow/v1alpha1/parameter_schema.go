@@ -0,0 +1,238 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ParameterSchema is a subset of JSON Schema that the value of a parameter must match.
+// Parameter values are strings, so for any type other than "string" the value is parsed first,
+// e.g. "3" is a valid integer and `{"a": 1}` is a valid object.
+type ParameterSchema struct {
+	// Type of the value, one of: string, integer, number, boolean, object, array
+	Type string `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+
+	// Pattern is a regular expression that a string value must match
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,2,opt,name=pattern"`
+
+	// Minimum is the inclusive minimum of an integer or number value
+	Minimum *Amount `json:"minimum,omitempty" protobuf:"bytes,3,opt,name=minimum"`
+
+	// Maximum is the inclusive maximum of an integer or number value
+	Maximum *Amount `json:"maximum,omitempty" protobuf:"bytes,4,opt,name=maximum"`
+
+	// Items is the schema of each item of an array value
+	Items *ParameterItemsSchema `json:"items,omitempty" protobuf:"bytes,5,opt,name=items"`
+
+	// Required lists the properties an object value must have
+	Required []string `json:"required,omitempty" protobuf:"bytes,6,rep,name=required"`
+}
+
+// ParameterItemsSchema is the schema of each item of an array parameter
+type ParameterItemsSchema struct {
+	// Type of the item, one of: string, integer, number, boolean, object, array
+	Type string `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+
+	// Pattern is a regular expression that a string item must match
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,2,opt,name=pattern"`
+
+	// Minimum is the inclusive minimum of an integer or number item
+	Minimum *Amount `json:"minimum,omitempty" protobuf:"bytes,3,opt,name=minimum"`
+
+	// Maximum is the inclusive maximum of an integer or number item
+	Maximum *Amount `json:"maximum,omitempty" protobuf:"bytes,4,opt,name=maximum"`
+
+	// Required lists the properties an object item must have
+	Required []string `json:"required,omitempty" protobuf:"bytes,5,rep,name=required"`
+}
+
+// ParameterSchemaTypes are the supported values of ParameterSchema.Type
+var ParameterSchemaTypes = []string{"string", "integer", "number", "boolean", "object", "array"}
+
+// Validate returns an error if the value does not match the schema
+func (s *ParameterSchema) Validate(value string) error {
+	v, err := decodeParameterValue(s.Type, value)
+	if err != nil {
+		return err
+	}
+	if err := checkSchema(s.Type, s.Pattern, s.Minimum, s.Maximum, s.Required, v); err != nil {
+		return err
+	}
+	if items, ok := v.([]any); ok && s.Items != nil {
+		for i, item := range items {
+			if err := checkSchema(s.Items.Type, s.Items.Pattern, s.Items.Minimum, s.Items.Maximum, s.Items.Required, item); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the schema itself is invalid, e.g. it has an unknown type or a pattern that does not compile
+func (s *ParameterSchema) Check() error {
+	if err := checkSchemaRules(s.Type, s.Pattern, s.Minimum, s.Maximum); err != nil {
+		return err
+	}
+	if s.Items != nil {
+		if s.Type != "array" {
+			return fmt.Errorf("items is only valid for the array type")
+		}
+		if err := checkSchemaRules(s.Items.Type, s.Items.Pattern, s.Items.Minimum, s.Items.Maximum); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+	return nil
+}
+
+func checkSchemaRules(typ, pattern string, minimum, maximum *Amount) error {
+	if typ != "" && !slices.Contains(ParameterSchemaTypes, typ) {
+		return fmt.Errorf("unknown type %q, must be one of: %s", typ, strings.Join(ParameterSchemaTypes, ", "))
+	}
+	if pattern != "" {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if minimum != nil {
+		if _, err := minimum.Float64(); err != nil {
+			return fmt.Errorf("minimum %q is not a number", minimum.Value)
+		}
+	}
+	if maximum != nil {
+		if _, err := maximum.Float64(); err != nil {
+			return fmt.Errorf("maximum %q is not a number", maximum.Value)
+		}
+	}
+	if minimum != nil && maximum != nil {
+		minValue, _ := minimum.Float64()
+		maxValue, _ := maximum.Float64()
+		if minValue > maxValue {
+			return fmt.Errorf("minimum %s is greater than maximum %s", minimum.Value, maximum.Value)
+		}
+	}
+	return nil
+}
+
+// decodeParameterValue parses a parameter value into the JSON value of the given type
+func decodeParameterValue(typ, value string) (any, error) {
+	switch typ {
+	case "", "string":
+		return value, nil
+	case "integer", "number":
+		if v, err := decodeJSON(value); err == nil {
+			if n, ok := v.(json.Number); ok {
+				return n, nil
+			}
+		}
+		return nil, fmt.Errorf("value %q is not %s", value, withArticle(typ))
+	case "boolean":
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("value %q is not a boolean", value)
+	case "object", "array":
+		v, err := decodeJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not %s", value, withArticle(typ))
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown schema type %q", typ)
+}
+
+func decodeJSON(value string) (any, error) {
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	if d.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+// checkSchema returns an error if the decoded value does not match the rules shared by ParameterSchema and ParameterItemsSchema
+func checkSchema(typ, pattern string, minimum, maximum *Amount, required []string, v any) error {
+	if err := checkType(typ, v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		if pattern != "" {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+			if !re.MatchString(x) {
+				return fmt.Errorf("value %q does not match pattern %q", x, pattern)
+			}
+		}
+	case json.Number:
+		f, err := x.Float64()
+		if err != nil {
+			return fmt.Errorf("value %s is not a number", x)
+		}
+		if minimum != nil {
+			if m, err := minimum.Float64(); err == nil && f < m {
+				return fmt.Errorf("value %s is less than the minimum %s", x, minimum.Value)
+			}
+		}
+		if maximum != nil {
+			if m, err := maximum.Float64(); err == nil && f > m {
+				return fmt.Errorf("value %s is greater than the maximum %s", x, maximum.Value)
+			}
+		}
+	case map[string]any:
+		for _, name := range required {
+			if _, ok := x[name]; !ok {
+				return fmt.Errorf("value is missing the required property %q", name)
+			}
+		}
+	}
+	return nil
+}
+
+func checkType(typ string, v any) error {
+	ok := true
+	switch typ {
+	case "string":
+		_, ok = v.(string)
+	case "integer":
+		n, isNumber := v.(json.Number)
+		_, err := n.Int64()
+		ok = isNumber && err == nil
+	case "number":
+		_, ok = v.(json.Number)
+	case "boolean":
+		_, ok = v.(bool)
+	case "object":
+		_, ok = v.(map[string]any)
+	case "array":
+		_, ok = v.([]any)
+	case "":
+	default:
+		return fmt.Errorf("unknown schema type %q", typ)
+	}
+	if !ok {
+		data, _ := json.Marshal(v)
+		return fmt.Errorf("value %s is not %s", data, withArticle(typ))
+	}
+	return nil
+}
+
+func withArticle(typ string) string {
+	switch typ {
+	case "integer", "object", "array":
+		return "an " + typ
+	}
+	return "a " + typ
+}
@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParameterSchema_Validate(t *testing.T) {
+	amount := func(v string) *Amount { return &Amount{Value: json.Number(v)} }
+	t.Run("String", func(t *testing.T) {
+		s := &ParameterSchema{Type: "string", Pattern: "^v[0-9]+$"}
+		require.NoError(t, s.Validate("v1"))
+		require.EqualError(t, s.Validate("1"), `value "1" does not match pattern "^v[0-9]+$"`)
+	})
+	t.Run("Integer", func(t *testing.T) {
+		s := &ParameterSchema{Type: "integer", Minimum: amount("1"), Maximum: amount("10")}
+		require.NoError(t, s.Validate("3"))
+		require.EqualError(t, s.Validate("3.5"), `value 3.5 is not an integer`)
+		require.EqualError(t, s.Validate("three"), `value "three" is not an integer`)
+		require.EqualError(t, s.Validate("0"), `value 0 is less than the minimum 1`)
+		require.EqualError(t, s.Validate("11"), `value 11 is greater than the maximum 10`)
+	})
+	t.Run("Number", func(t *testing.T) {
+		s := &ParameterSchema{Type: "number", Maximum: amount("0.5")}
+		require.NoError(t, s.Validate("0.25"))
+		require.EqualError(t, s.Validate("0.75"), `value 0.75 is greater than the maximum 0.5`)
+	})
+	t.Run("Boolean", func(t *testing.T) {
+		s := &ParameterSchema{Type: "boolean"}
+		require.NoError(t, s.Validate("false"))
+		require.EqualError(t, s.Validate("yes"), `value "yes" is not a boolean`)
+	})
+	t.Run("Object", func(t *testing.T) {
+		s := &ParameterSchema{Type: "object", Required: []string{"name"}}
+		require.NoError(t, s.Validate(`{"name": "foo"}`))
+		require.EqualError(t, s.Validate(`{"age": 1}`), `value is missing the required property "name"`)
+		require.EqualError(t, s.Validate(`[]`), `value [] is not an object`)
+	})
+	t.Run("Array", func(t *testing.T) {
+		s := &ParameterSchema{Type: "array", Items: &ParameterItemsSchema{Type: "integer", Minimum: amount("0")}}
+		require.NoError(t, s.Validate(`[1, 2]`))
+		require.EqualError(t, s.Validate(`[1, -2]`), `item 1: value -2 is less than the minimum 0`)
+		require.EqualError(t, s.Validate(`[1, "2"]`), `item 1: value "2" is not an integer`)
+	})
+}
+
+func TestParameterSchema_Check(t *testing.T) {
+	require.NoError(t, (&ParameterSchema{Type: "array", Items: &ParameterItemsSchema{Type: "string"}}).Check())
+	assert.EqualError(t, (&ParameterSchema{Type: "float"}).Check(), `unknown type "float", must be one of: string, integer, number, boolean, object, array`)
+	assert.EqualError(t, (&ParameterSchema{Pattern: "("}).Check(), "invalid pattern \"(\": error parsing regexp: missing closing ): `(`")
+	assert.EqualError(t, (&ParameterSchema{Type: "integer", Minimum: &Amount{Value: "2"}, Maximum: &Amount{Value: "1"}}).Check(), "minimum 2 is greater than maximum 1")
+	assert.EqualError(t, (&ParameterSchema{Type: "string", Items: &ParameterItemsSchema{}}).Check(), "items is only valid for the array type")
+}
@@ -33,7 +33,11 @@ func (in Progress) N() int64 {
 }
 
 func (in Progress) M() int64 {
-	return parseInt64(in.parts()[1])
+	parts := in.parts()
+	if len(parts) < 2 {
+		return 0
+	}
+	return parseInt64(parts[1])
 }
 
 func (in Progress) Add(x Progress) Progress {
@@ -52,3 +56,78 @@ func parseInt64(s string) int64 {
 	v, _ := strconv.ParseInt(s, 10, 64)
 	return v
 }
+
+// ProgressDetail is the progress self-reported by a pod in more detail than N/M.
+type ProgressDetail struct {
+	// Percent complete, from 0 to 100. If zero, it is the mean of the percentages of the stages.
+	Percent int32 `json:"percent,omitempty" protobuf:"varint,1,opt,name=percent"`
+	// Message is a free-text status, e.g. "loading shard 4 of 10"
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// Stages are the named stages of the work, in order
+	Stages []ProgressStage `json:"stages,omitempty" protobuf:"bytes,3,rep,name=stages"`
+}
+
+// ProgressStage is a named stage of the work of a pod.
+type ProgressStage struct {
+	// Name of the stage
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Percent of the stage complete, from 0 to 100
+	Percent int32 `json:"percent,omitempty" protobuf:"varint,2,opt,name=percent"`
+}
+
+func (in *ProgressDetail) IsValid() bool {
+	if in == nil || in.Percent < 0 || in.Percent > 100 {
+		return false
+	}
+	for _, s := range in.Stages {
+		if s.Name == "" || s.Percent < 0 || s.Percent > 100 {
+			return false
+		}
+	}
+	return in.Percent > 0 || in.Message != "" || len(in.Stages) > 0
+}
+
+// Fraction complete, from 0 to 1.
+func (in *ProgressDetail) Fraction() float64 {
+	if in == nil {
+		return 0
+	}
+	if in.Percent > 0 || len(in.Stages) == 0 {
+		return float64(in.Percent) / 100
+	}
+	var sum int32
+	for _, s := range in.Stages {
+		sum += s.Percent
+	}
+	return float64(sum) / float64(len(in.Stages)) / 100
+}
+
+// Progress is the fraction complete in N/M format, where M is 100.
+func (in *ProgressDetail) Progress() Progress {
+	return Progress(fmt.Sprintf("%v/100", int64(in.Fraction()*100)))
+}
+
+// CurrentStage returns the index of the first stage that is not complete, or -1 if there is none.
+func (in *ProgressDetail) CurrentStage() int {
+	for i, s := range in.Stages {
+		if s.Percent < 100 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Summary returns the progress in a human readable format, e.g. "40% train (2/3): loading shard 4".
+func (in *ProgressDetail) Summary() string {
+	if in == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%v%%", int64(in.Fraction()*100))
+	if i := in.CurrentStage(); i >= 0 {
+		s += fmt.Sprintf(" %s (%d/%d)", in.Stages[i].Name, i+1, len(in.Stages))
+	}
+	if in.Message != "" {
+		s += ": " + in.Message
+	}
+	return s
+}
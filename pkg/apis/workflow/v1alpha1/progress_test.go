@@ -16,6 +16,7 @@ func TestProgress(t *testing.T) {
 	})
 	t.Run("IsValid", func(t *testing.T) {
 		assert.False(t, Progress("").IsValid())
+		assert.False(t, Progress("1").IsValid())
 		assert.False(t, Progress("/0").IsValid())
 		assert.False(t, Progress("0/").IsValid())
 		assert.False(t, Progress("0/0").IsValid())
@@ -29,3 +30,32 @@ func TestProgress(t *testing.T) {
 		assert.Equal(t, Progress("100/100"), Progress("0/100").Complete())
 	})
 }
+
+func TestProgressDetail(t *testing.T) {
+	t.Run("IsValid", func(t *testing.T) {
+		assert.False(t, (*ProgressDetail)(nil).IsValid())
+		assert.False(t, (&ProgressDetail{}).IsValid())
+		assert.False(t, (&ProgressDetail{Percent: 101}).IsValid())
+		assert.False(t, (&ProgressDetail{Stages: []ProgressStage{{Percent: 50}}}).IsValid())
+		assert.True(t, (&ProgressDetail{Percent: 40}).IsValid())
+		assert.True(t, (&ProgressDetail{Message: "starting"}).IsValid())
+		assert.True(t, (&ProgressDetail{Stages: []ProgressStage{{Name: "download"}}}).IsValid())
+	})
+	t.Run("Fraction", func(t *testing.T) {
+		assert.InDelta(t, 0.4, (&ProgressDetail{Percent: 40}).Fraction(), 0.001)
+		assert.InDelta(t, 0.5, (&ProgressDetail{Stages: []ProgressStage{{Name: "download", Percent: 100}, {Name: "train"}}}).Fraction(), 0.001)
+		assert.InDelta(t, 0.4, (&ProgressDetail{Percent: 40, Stages: []ProgressStage{{Name: "download", Percent: 100}}}).Fraction(), 0.001)
+	})
+	t.Run("Progress", func(t *testing.T) {
+		assert.Equal(t, Progress("40/100"), (&ProgressDetail{Percent: 40}).Progress())
+	})
+	t.Run("Summary", func(t *testing.T) {
+		assert.Empty(t, (*ProgressDetail)(nil).Summary())
+		assert.Equal(t, "40%", (&ProgressDetail{Percent: 40}).Summary())
+		assert.Equal(t, "40% train (2/3): loading shard 4", (&ProgressDetail{
+			Percent: 40,
+			Message: "loading shard 4",
+			Stages:  []ProgressStage{{Name: "download", Percent: 100}, {Name: "train", Percent: 20}, {Name: "upload"}},
+		}).Summary())
+	})
+}
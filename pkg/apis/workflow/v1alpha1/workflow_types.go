@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -434,6 +435,12 @@ type WorkflowSpec struct {
 	// ArtifactGC describes the strategy to use when deleting artifacts from completed or deleted workflows (applies to all output Artifacts
 	// unless Artifact.ArtifactGC is specified, which overrides this)
 	ArtifactGC *WorkflowLevelArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,43,opt,name=artifactGC"`
+
+	// WorkflowRetryStrategy retries the workflow when it fails, resetting only the nodes that failed
+	WorkflowRetryStrategy *WorkflowRetryStrategy `json:"workflowRetryStrategy,omitempty" protobuf:"bytes,44,opt,name=workflowRetryStrategy"`
+
+	// SLO is when the workflow is expected to have completed. If it has not, it is marked with the SLOMissed condition.
+	SLO *SLO `json:"slo,omitempty" protobuf:"bytes,45,opt,name=slo"`
 }
 
 type LabelValueFrom struct {
@@ -760,6 +767,11 @@ type Template struct {
 
 	// Annotations is a list of annotations to add to the template at runtime
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,44,opt,name=annotations"`
+
+	// Checkpoint periodically saves a directory of the main container to an artifact repository, and restores it
+	// before the main container starts when the template is retried.
+	// This field is only applicable to container and script templates.
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty" protobuf:"bytes,45,opt,name=checkpoint"`
 }
 
 // SetType will set the template object based on template type.
@@ -907,6 +919,9 @@ type Parameter struct {
 
 	// Description is the parameter description
 	Description *AnyString `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+
+	// Schema the value of the parameter must match
+	Schema *ParameterSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -938,6 +953,10 @@ type ValueFrom struct {
 
 	// Expression, if defined, is evaluated to specify the value for the parameter
 	Expression string `json:"expression,omitempty" protobuf:"bytes,8,rep,name=expression"`
+
+	// ResultsKey is the key in the outputs results file to retrieve an output parameter value from in container
+	// templates, e.g. `metrics.accuracy`. Objects and arrays are JSON encoded.
+	ResultsKey string `json:"resultsKey,omitempty" protobuf:"bytes,10,opt,name=resultsKey"`
 }
 
 func (p *Parameter) HasValue() bool {
@@ -999,6 +1018,14 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// ResultsKey is the key in the outputs results file whose value is the path of an output artifact in container
+	// templates. It is used instead of Path.
+	ResultsKey string `json:"resultsKey,omitempty" protobuf:"bytes,14,opt,name=resultsKey"`
+
+	// Checksum is the checksum of the content of an output artifact, e.g. "sha256:2c26b46b...". Archive metadata such
+	// as modification times is not part of it. It is set by the executor and is part of automatic memoization keys.
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,15,opt,name=checksum"`
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1509,6 +1536,10 @@ type Outputs struct {
 
 	// ExitCode holds the exit code of a script template
 	ExitCode *string `json:"exitCode,omitempty" protobuf:"bytes,4,opt,name=exitCode"`
+
+	// ResultsFile is the path of a JSON or YAML file, written by the main container, that output parameters and
+	// artifacts can be retrieved from using `resultsKey`
+	ResultsFile string `json:"resultsFile,omitempty" protobuf:"bytes,5,opt,name=resultsFile"`
 }
 
 func (out *Outputs) GetArtifacts() Artifacts {
@@ -1569,6 +1600,21 @@ type WorkflowStep struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+
+	// Parallelism limits the number of items of a step expanded by withItems, withParam or withSequence that run at once
+	Parallelism *int64 `json:"parallelism,omitempty" protobuf:"varint,14,opt,name=parallelism"`
+
+	// BatchSize is the number of items of a step expanded by withItems, withParam or withSequence that are started
+	// together, each batch being started once all the items of the previous batch have completed
+	BatchSize *int64 `json:"batchSize,omitempty" protobuf:"varint,17,opt,name=batchSize"`
+
+	// FailFastThreshold is the number (e.g. 10) or percentage (e.g. "5%") of failed or errored items of a step expanded
+	// by withItems, withParam or withSequence after which no more items are started and the step fails
+	FailFastThreshold *intstr.IntOrString `json:"failFastThreshold,omitempty" protobuf:"bytes,15,opt,name=failFastThreshold"`
+
+	// SuccessThreshold is the number (e.g. 95) or percentage (e.g. "95%") of items of a step expanded by withItems,
+	// withParam or withSequence that must succeed for the step to succeed, even though other items failed or errored
+	SuccessThreshold *intstr.IntOrString `json:"successThreshold,omitempty" protobuf:"bytes,16,opt,name=successThreshold"`
 }
 
 func (s *WorkflowStep) GetName() string {
@@ -1975,6 +2021,10 @@ type WorkflowStatus struct {
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,17,opt,name=progress,casttype=Progress"`
 
+	// EstimatedFinishedAt is the time at which the running workflow is estimated to complete, from its estimated
+	// duration and the progress reported by its nodes
+	EstimatedFinishedAt *metav1.Time `json:"estimatedFinishedAt,omitempty" protobuf:"bytes,24,opt,name=estimatedFinishedAt"`
+
 	// A human readable message indicating details about why the workflow is in this condition.
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 
@@ -2018,6 +2068,17 @@ type WorkflowStatus struct {
 
 	// TaskResultsCompletionStatus tracks task result completion status (mapped by node ID). Used to prevent premature archiving and garbage collection.
 	TaskResultsCompletionStatus map[string]bool `json:"taskResultsCompletionStatus,omitempty" protobuf:"bytes,20,opt,name=taskResultsCompletionStatus"`
+
+	// Retries is the status of the automatic retries of the workflow by its workflowRetryStrategy
+	Retries *WorkflowRetryStatus `json:"retries,omitempty" protobuf:"bytes,21,opt,name=retries"`
+
+	// Decisions is the log of the latest decisions of the controller not to start or run nodes, e.g. because of
+	// parallelism or a synchronization lock. It is empty if the decision log is offloaded to the persistence DB.
+	Decisions Decisions `json:"decisions,omitempty" protobuf:"bytes,22,rep,name=decisions"`
+
+	// EstimatedCost is the estimated cost of the pods and persistent volume claims of the workflow, from the prices in
+	// the controller configuration
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,23,opt,name=estimatedCost"`
 }
 
 // MarkTaskResultIncomplete sets either the task results completion field
@@ -2132,8 +2193,20 @@ type Backoff struct {
 	// multiplication by the factor parameter would make the duration
 	// exceed the cap then the duration is set to the cap
 	Cap string `json:"cap,omitempty" protobuf:"varint,4,opt,name=cap"`
+	// Jitter randomizes the amount to back off, so that the retries of many steps are spread out. "Full" backs off
+	// between zero and the amount, "Equal" between half the amount and the amount, and "Decorrelated" between the
+	// duration and three times the previous amount, up to the cap and ignoring the factor.
+	Jitter BackoffJitter `json:"jitter,omitempty" protobuf:"bytes,5,opt,name=jitter,casttype=BackoffJitter"`
 }
 
+type BackoffJitter string
+
+const (
+	BackoffJitterFull         BackoffJitter = "Full"
+	BackoffJitterEqual        BackoffJitter = "Equal"
+	BackoffJitterDecorrelated BackoffJitter = "Decorrelated"
+)
+
 // RetryNodeAntiAffinity is a placeholder for future expansion, only empty nodeAntiAffinity is allowed.
 // In order to prevent running steps on the same host, it uses "kubernetes.io/hostname".
 type RetryNodeAntiAffinity struct{}
@@ -2161,6 +2234,152 @@ type RetryStrategy struct {
 	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
 	// be retried and the retry strategy will be ignored
 	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// OnExitCodes are the limits and backoffs of failures with particular exit codes. The first that matches the
+	// last failure is used instead of the limit and backoff of the strategy.
+	OnExitCodes []ExitCodeRetryRule `json:"onExitCodes,omitempty" protobuf:"bytes,6,rep,name=onExitCodes"`
+
+	// OnErrorMessages are the limits and backoffs of failures with messages that match regular expressions. The first
+	// that matches the last failure is used if none of OnExitCodes does.
+	OnErrorMessages []ErrorMessageRetryRule `json:"onErrorMessages,omitempty" protobuf:"bytes,7,rep,name=onErrorMessages"`
+}
+
+// RetryRule is the limit and backoff of the retries of a class of failures
+type RetryRule struct {
+	// Limit is the maximum number of retry attempts, which is zero to not retry the failures. Defaults to the limit
+	// of the strategy.
+	Limit *intstr.IntOrString `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+
+	// Backoff is the backoff of the retries. Defaults to the backoff of the strategy.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// ExitCodeRetryRule is the limit and backoff of failures with any of the exit codes
+type ExitCodeRetryRule struct {
+	// ExitCodes are the exit codes of the main container the rule applies to
+	ExitCodes []int32 `json:"exitCodes" protobuf:"varint,1,rep,name=exitCodes"`
+
+	RetryRule `json:",inline" protobuf:"bytes,2,opt,name=retryRule"`
+}
+
+// ErrorMessageRetryRule is the limit and backoff of failures with a message that matches a regular expression
+type ErrorMessageRetryRule struct {
+	// Pattern is the regular expression that the message of the failure matches
+	Pattern string `json:"pattern" protobuf:"bytes,1,opt,name=pattern"`
+
+	RetryRule `json:",inline" protobuf:"bytes,2,opt,name=retryRule"`
+}
+
+// ForFailure returns the strategy with the limit and backoff of the first rule that matches a failure with the exit
+// code, which is empty if it is not known, and the message
+func (s RetryStrategy) ForFailure(exitCode string, message string) (RetryStrategy, error) {
+	var rule *RetryRule
+	if code, err := strconv.ParseInt(exitCode, 10, 32); err == nil {
+		for i, r := range s.OnExitCodes {
+			if slices.Contains(r.ExitCodes, int32(code)) {
+				rule = &s.OnExitCodes[i].RetryRule
+				break
+			}
+		}
+	}
+	if rule == nil {
+		for i, r := range s.OnErrorMessages {
+			matched, err := regexp.MatchString(r.Pattern, message)
+			if err != nil {
+				return s, fmt.Errorf("invalid onErrorMessages pattern %q: %w", r.Pattern, err)
+			}
+			if matched {
+				rule = &s.OnErrorMessages[i].RetryRule
+				break
+			}
+		}
+	}
+	if rule == nil {
+		return s, nil
+	}
+	if rule.Limit != nil {
+		s.Limit = rule.Limit
+	}
+	if rule.Backoff != nil {
+		s.Backoff = rule.Backoff
+	}
+	return s, nil
+}
+
+// WorkflowRetryStrategy retries a workflow that failed or errored. Like `argo retry`, only the nodes that failed are
+// reset, so the outputs of the nodes that succeeded are kept.
+type WorkflowRetryStrategy struct {
+	// Limit is the maximum number of times the workflow is retried
+	Limit *intstr.IntOrString `json:"limit" protobuf:"varint,1,opt,name=limit"`
+
+	// Backoff is how long to wait before each retry. MaxDuration is not supported.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+
+	// Expression is a condition over the failure for whether the workflow is retried. Its variables are `retries`,
+	// `lastRetry.status`, `lastRetry.message`, `lastRetry.duration` and `failures`, the list of the failed nodes.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+}
+
+// WorkflowRetryStatus is the status of the automatic retries of a workflow
+type WorkflowRetryStatus struct {
+	// Attempts is the number of times the workflow has been retried
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,1,opt,name=attempts"`
+
+	// NextRetryAt is when the workflow is retried, if it is waiting to be retried
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty" protobuf:"bytes,2,opt,name=nextRetryAt"`
+
+	// Message is the message of the last failure that the workflow was retried for
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+// SLO is the service level objective of a workflow: when it is expected to have completed. Its deadline is the
+// earliest of MaxDuration and CompleteBy, from when the workflow was scheduled by its CronWorkflow, or otherwise
+// created.
+type SLO struct {
+	// MaxDuration is the longest the workflow is expected to take, e.g. "2h"
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,1,opt,name=maxDuration"`
+
+	// CompleteBy is the time of day by which the workflow is expected to have completed, in the format "15:04", e.g.
+	// "06:00" for a nightly workflow
+	CompleteBy string `json:"completeBy,omitempty" protobuf:"bytes,2,opt,name=completeBy"`
+
+	// Timezone is the timezone of CompleteBy, e.g. "America/Los_Angeles". Defaults to UTC.
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,3,opt,name=timezone"`
+}
+
+// Deadline returns when the workflow scheduled or created at the time is expected to have completed
+func (s *SLO) Deadline(from time.Time) (time.Time, error) {
+	if s.MaxDuration == "" && s.CompleteBy == "" {
+		return time.Time{}, fmt.Errorf("maxDuration or completeBy is required")
+	}
+	var deadline time.Time
+	if s.MaxDuration != "" {
+		maxDuration, err := ParseStringToDuration(s.MaxDuration)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("maxDuration is invalid: %w", err)
+		}
+		deadline = from.Add(maxDuration)
+	}
+	if s.CompleteBy != "" {
+		location, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("timezone is invalid: %w", err)
+		}
+		completeBy, err := time.Parse("15:04", s.CompleteBy)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("completeBy is invalid: %w", err)
+		}
+		local := from.In(location)
+		// the first time of day after the workflow was scheduled
+		at := time.Date(local.Year(), local.Month(), local.Day(), completeBy.Hour(), completeBy.Minute(), 0, 0, location)
+		if !at.After(local) {
+			at = at.AddDate(0, 0, 1)
+		}
+		if deadline.IsZero() || at.Before(deadline) {
+			deadline = at
+		}
+	}
+	return deadline, nil
 }
 
 // RetryPolicyActual gets the active retry policy for a strategy.
@@ -2303,6 +2522,8 @@ const (
 	ConditionTypeMetricsError ConditionType = "MetricsError"
 	// ConditionTypeArtifactGCError is an error on artifact garbage collection
 	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
+	// ConditionTypeSLOMissed signifies the workflow did not complete by the deadline of its SLO
+	ConditionTypeSLOMissed ConditionType = "SLOMissed"
 )
 
 type Condition struct {
@@ -2316,6 +2537,73 @@ type Condition struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
 }
 
+type DecisionReason string
+
+const (
+	// DecisionThrottled is a workflow or node that was not started because of parallelism
+	DecisionThrottled DecisionReason = "Throttled"
+	// DecisionWaitingForLock is a workflow or node that is waiting for a synchronization lock
+	DecisionWaitingForLock DecisionReason = "WaitingForLock"
+	// DecisionSkipped is a node that was skipped because its when expression evaluated false
+	DecisionSkipped DecisionReason = "Skipped"
+	// DecisionDeadlineExceeded is a node that was not started because the controller ran out of time to operate the workflow
+	DecisionDeadlineExceeded DecisionReason = "DeadlineExceeded"
+	// DecisionRateLimited is a node whose pod was not created because of the resource creation rate limit
+	DecisionRateLimited DecisionReason = "RateLimited"
+)
+
+// Decision is a decision of the controller not to start or run a node, or the workflow
+type Decision struct {
+	// Time is when the controller made the decision
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+
+	// NodeID is the ID of the node, or empty for the workflow
+	NodeID string `json:"nodeID,omitempty" protobuf:"bytes,2,opt,name=nodeID"`
+
+	// NodeName is the name of the node, or empty for the workflow
+	NodeName string `json:"nodeName,omitempty" protobuf:"bytes,3,opt,name=nodeName"`
+
+	// Reason is the reason of the decision
+	Reason DecisionReason `json:"reason" protobuf:"bytes,4,opt,name=reason,casttype=DecisionReason"`
+
+	// Message is a human readable explanation of the decision
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+type Decisions []Decision
+
+// Add adds the decision, keeping at most limit decisions. A decision that repeats the latest decision about the
+// same node is not added, so that a node that waits across many reconciliations only has one decision.
+// It returns whether the decision was added.
+func (ds *Decisions) Add(decision Decision, limit int) bool {
+	for i := len(*ds) - 1; i >= 0; i-- {
+		latest := (*ds)[i]
+		if latest.NodeID != decision.NodeID {
+			continue
+		}
+		if latest.Reason == decision.Reason && latest.Message == decision.Message {
+			return false
+		}
+		break
+	}
+	*ds = append(*ds, decision)
+	if limit > 0 && len(*ds) > limit {
+		*ds = (*ds)[len(*ds)-limit:]
+	}
+	return true
+}
+
+// ForNode returns the decisions about the node
+func (ds Decisions) ForNode(nodeID string) Decisions {
+	var decisions Decisions
+	for _, decision := range ds {
+		if decision.NodeID == nodeID {
+			decisions = append(decisions, decision)
+		}
+	}
+	return decisions
+}
+
 // NodeStatus contains status information about an individual node in the workflow
 type NodeStatus struct {
 	// ID is a unique identifier of a node within the worklow
@@ -2366,9 +2654,20 @@ type NodeStatus struct {
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,26,opt,name=progress,casttype=Progress"`
 
+	// ProgressDetail is the progress self-reported by the running pod, with named stages and a status message
+	ProgressDetail *ProgressDetail `json:"progressDetail,omitempty" protobuf:"bytes,31,opt,name=progressDetail"`
+
 	// ResourcesDuration is indicative, but not accurate, resource duration. This is populated when the nodes completes.
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,21,opt,name=resourcesDuration"`
 
+	// EstimatedCost is the estimated cost of the pod of the node, from its resources duration and the prices in the
+	// controller configuration. This is populated when the node completes.
+	EstimatedCost *Amount `json:"estimatedCost,omitempty" protobuf:"bytes,30,opt,name=estimatedCost"`
+
+	// ResourceUsage is the actual resource usage of each main container, measured by its cgroup. This is populated
+	// when the node completes, if the cgroup could be read.
+	ResourceUsage []ContainerResourceUsage `json:"resourceUsage,omitempty" protobuf:"bytes,29,rep,name=resourceUsage"`
+
 	// PodIP captures the IP of the pod for daemoned steps
 	PodIP string `json:"podIP,omitempty" protobuf:"bytes,12,opt,name=podIP"`
 
@@ -3278,10 +3577,10 @@ type DAGTemplate struct {
 	// Target are one or more names of targets to execute in a DAG
 	Target string `json:"target,omitempty" protobuf:"bytes,1,opt,name=target"`
 
-	// Tasks are a list of DAG tasks
+	// Tasks are a list of DAG tasks. They are required unless TasksFrom is set.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
-	Tasks []DAGTask `json:"tasks" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=tasks"`
+	Tasks []DAGTask `json:"tasks,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=tasks"`
 
 	// This flag is for DAG logic. The DAG logic has a built-in "fail fast" feature to stop scheduling new steps,
 	// as soon as it detects that one of the DAG nodes is failed. Then it waits until all DAG nodes are completed
@@ -3290,6 +3589,25 @@ type DAGTemplate struct {
 	// completion (either success or failure), regardless of the failed outcomes of branches in the DAG.
 	// More info and example about this feature at https://github.com/argoproj/argo-workflows/issues/1442
 	FailFast *bool `json:"failFast,omitempty" protobuf:"varint,3,opt,name=failFast"`
+
+	// TasksFrom is a JSON list of DAG tasks that are added to Tasks when the DAG starts, typically an output
+	// parameter of an earlier task passed in as an input parameter, e.g. "{{inputs.parameters.tasks}}".
+	// This allows the shape of a DAG to depend on data discovered at runtime.
+	TasksFrom string `json:"tasksFrom,omitempty" protobuf:"bytes,4,opt,name=tasksFrom"`
+}
+
+// ExpandTasks returns the tasks of the DAG followed by the tasks of TasksFrom
+func (d *DAGTemplate) ExpandTasks() ([]DAGTask, error) {
+	if d.TasksFrom == "" {
+		return d.Tasks, nil
+	}
+	var tasks []DAGTask
+	decoder := json.NewDecoder(strings.NewReader(d.TasksFrom))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&tasks); err != nil {
+		return nil, fmt.Errorf("tasksFrom is not a JSON list of DAG tasks: %w", err)
+	}
+	return append(slices.Clone(d.Tasks), tasks...), nil
 }
 
 // DAGTask represents a node in the graph during DAG execution
@@ -3348,6 +3666,22 @@ type DAGTask struct {
 	// Hooks hold the lifecycle hook which is invoked at lifecycle of
 	// task, irrespective of the success, failure, or error status of the primary task
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+
+	// Parallelism limits the number of items of a task expanded by withItems, withParam or withSequence that run at once
+	Parallelism *int64 `json:"parallelism,omitempty" protobuf:"varint,15,opt,name=parallelism"`
+
+	// BatchSize is the number of items of a task expanded by withItems, withParam or withSequence that are started
+	// together, each batch being started once all the items of the previous batch have completed
+	BatchSize *int64 `json:"batchSize,omitempty" protobuf:"varint,18,opt,name=batchSize"`
+
+	// FailFastThreshold is the number (e.g. 10) or percentage (e.g. "5%") of failed or errored items of a task expanded
+	// by withItems, withParam or withSequence after which no more items are started and the task fails
+	FailFastThreshold *intstr.IntOrString `json:"failFastThreshold,omitempty" protobuf:"bytes,16,opt,name=failFastThreshold"`
+
+	// SuccessThreshold is the number (e.g. 95) or percentage (e.g. "95%") of items of a task expanded by withItems,
+	// withParam or withSequence that must succeed for the task to succeed, even though other items failed or errored.
+	// Such a task is PartiallySucceeded in depends.
+	SuccessThreshold *intstr.IntOrString `json:"successThreshold,omitempty" protobuf:"bytes,17,opt,name=successThreshold"`
 }
 
 func (t *DAGTask) GetName() string {
@@ -3833,13 +4167,50 @@ type Counter struct {
 
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
-	// Key is the key to use as the caching key
-	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Key is the key to use as the caching key. It is required unless Auto is set.
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
 	// Cache sets and configures the kind of cache
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
 	// MaxAge is the maximum age (e.g. "180s", "24h") of an entry that is still considered valid. If an entry is older
 	// than the MaxAge, it will be ignored.
 	MaxAge string `json:"maxAge" protobuf:"bytes,3,opt,name=maxAge"`
+	// Auto derives the key from a hash of the resolved template, the digests of its container images, the values of
+	// its input parameters and the checksums of its input artifacts. It cannot be used together with Key.
+	Auto bool `json:"auto,omitempty" protobuf:"varint,4,opt,name=auto"`
+}
+
+// Checkpoint is a directory of the main container that is kept across retries
+type Checkpoint struct {
+	// Path is the directory the main container writes its checkpoints to
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Interval is how often (e.g. "5m") the directory is saved while the main container is running. It is always
+	// saved when the main container exits. Defaults to "1m".
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// ArtifactLocation is where the checkpoint is saved to. Defaults to the artifact repository, with a key that is
+	// unique to the step and the same for all of its retries.
+	ArtifactLocation *ArtifactLocation `json:"artifactLocation,omitempty" protobuf:"bytes,3,opt,name=artifactLocation"`
+}
+
+// GetInterval returns the interval at which the checkpoint is saved
+func (c *Checkpoint) GetInterval() (time.Duration, error) {
+	if c.Interval == "" {
+		return time.Minute, nil
+	}
+	return ParseStringToDuration(c.Interval)
+}
+
+// ContainerResourceUsage is the resource usage of a container, measured by its cgroup
+type ContainerResourceUsage struct {
+	// Name of the container
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// CPUMilliseconds is the CPU time used, in milliseconds
+	CPUMilliseconds int64 `json:"cpuMilliseconds,omitempty" protobuf:"varint,2,opt,name=cpuMilliseconds"`
+	// MemoryPeakBytes is the peak memory usage, in bytes
+	MemoryPeakBytes int64 `json:"memoryPeakBytes,omitempty" protobuf:"varint,3,opt,name=memoryPeakBytes"`
+	// IOReadBytes is the number of bytes read from block devices
+	IOReadBytes int64 `json:"ioReadBytes,omitempty" protobuf:"varint,4,opt,name=ioReadBytes"`
+	// IOWriteBytes is the number of bytes written to block devices
+	IOWriteBytes int64 `json:"ioWriteBytes,omitempty" protobuf:"varint,5,opt,name=ioWriteBytes"`
 }
 
 // MemoizationStatus is the status of this memoized node
@@ -3850,6 +4221,9 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// KeyComponents are the hashes an automatic key was computed from, by component, e.g. "template",
+	// "images.main", "inputs.parameters.message" or "inputs.artifacts.data"
+	KeyComponents map[string]string `json:"keyComponents,omitempty" protobuf:"bytes,4,rep,name=keyComponents"`
 }
 
 // Cache is the configuration for the type of cache to be used
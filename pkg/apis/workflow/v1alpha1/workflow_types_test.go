@@ -1537,6 +1537,40 @@ func TestTemplate_RetryStrategy(t *testing.T) {
 	assert.Equal(t, wait.Backoff{Steps: 1}, strategy)
 }
 
+func TestSLO_Deadline(t *testing.T) {
+	from := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	t.Run("MaxDuration", func(t *testing.T) {
+		deadline, err := (&SLO{MaxDuration: "2h"}).Deadline(from)
+		require.NoError(t, err)
+		assert.Equal(t, from.Add(2*time.Hour), deadline)
+	})
+	t.Run("CompleteBy", func(t *testing.T) {
+		deadline, err := (&SLO{CompleteBy: "06:00"}).Deadline(from)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC), deadline)
+	})
+	t.Run("CompleteByNextDay", func(t *testing.T) {
+		deadline, err := (&SLO{CompleteBy: "00:30"}).Deadline(from)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 2, 0, 30, 0, 0, time.UTC), deadline)
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		// 1am UTC is 5pm the day before in Los Angeles
+		deadline, err := (&SLO{CompleteBy: "18:00", Timezone: "America/Los_Angeles"}).Deadline(from)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC), deadline.UTC())
+	})
+	t.Run("Earliest", func(t *testing.T) {
+		deadline, err := (&SLO{MaxDuration: "2h", CompleteBy: "06:00"}).Deadline(from)
+		require.NoError(t, err)
+		assert.Equal(t, from.Add(2*time.Hour), deadline)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		_, err := (&SLO{}).Deadline(from)
+		require.EqualError(t, err, "maxDuration or completeBy is required")
+	})
+}
+
 func TestGetExecSpec(t *testing.T) {
 	wf := Workflow{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1681,3 +1715,17 @@ func TestInlineStore(t *testing.T) {
 		})
 	}
 }
+
+func TestDecisions_Add(t *testing.T) {
+	var decisions Decisions
+	assert.True(t, decisions.Add(Decision{NodeID: "a", Reason: DecisionThrottled, Message: "parallelism"}, 3))
+	assert.True(t, decisions.Add(Decision{NodeID: "b", Reason: DecisionThrottled, Message: "parallelism"}, 3))
+	// repeats the latest decision about the node
+	assert.False(t, decisions.Add(Decision{NodeID: "a", Reason: DecisionThrottled, Message: "parallelism"}, 3))
+	assert.True(t, decisions.Add(Decision{NodeID: "a", Reason: DecisionWaitingForLock, Message: "lock"}, 3))
+	assert.True(t, decisions.Add(Decision{NodeID: "a", Reason: DecisionThrottled, Message: "parallelism"}, 3))
+	// only the latest decisions are kept
+	require.Len(t, decisions, 3)
+	assert.Equal(t, "b", decisions[0].NodeID)
+	assert.Len(t, decisions.ForNode("a"), 2)
+}
@@ -500,6 +500,15 @@ func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeID, artif
 	return art, driver, nil
 }
 
+// OpenArtifact opens the output artifact of the node of the workflow, such as its archived logs
+func (a *ArtifactServer) OpenArtifact(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName string) (io.ReadCloser, error) {
+	art, driver, err := a.getArtifactAndDriver(ctx, nodeID, artifactName, false, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+	return driver.OpenStream(ctx, art)
+}
+
 func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, art *wfv1.Artifact, driver common.ArtifactDriver) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	stream, err := driver.OpenStream(ctx, art)
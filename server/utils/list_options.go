@@ -21,6 +21,7 @@ type ListOptions struct {
 	Limit, Offset                int
 	ShowRemainingItemCount       bool
 	StartedAtAscending           bool
+	Query                        Query
 }
 
 func (l ListOptions) WithLimit(limit int) ListOptions {
@@ -48,6 +49,11 @@ func (l ListOptions) WithMinStartedAt(minStartedAt time.Time) ListOptions {
 	return l
 }
 
+func (l ListOptions) WithQuery(query Query) ListOptions {
+	l.Query = query
+	return l
+}
+
 func (l ListOptions) WithStartedAtAscending(ascending bool) ListOptions {
 	l.StartedAtAscending = ascending
 	return l
@@ -0,0 +1,242 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// QueryTermKind is the kind of a term in a workflow search query.
+type QueryTermKind string
+
+const (
+	// QueryTermText matches workflows containing the text anywhere, e.g. `oom-killed`
+	QueryTermText QueryTermKind = "Text"
+	// QueryTermParameter matches workflows with an argument parameter value, e.g. `param.message=hello`
+	QueryTermParameter QueryTermKind = "Parameter"
+	// QueryTermTemplate matches workflows that ran a template, or were created from a workflow template, e.g. `template=main`
+	QueryTermTemplate QueryTermKind = "Template"
+	// QueryTermNodePhase matches workflows with a node in a phase, e.g. `node.phase=Failed`
+	QueryTermNodePhase QueryTermKind = "NodePhase"
+	// QueryTermNodeMessage matches workflows with a node message containing the text, e.g. `node.message~OOMKilled`
+	QueryTermNodeMessage QueryTermKind = "NodeMessage"
+	// QueryTermJSONPath matches workflows whose JSON has a value at the path, e.g. `$.status.phase=Failed`
+	QueryTermJSONPath QueryTermKind = "JSONPath"
+)
+
+// QueryOperator is how the value of a term is compared.
+type QueryOperator string
+
+const (
+	QueryOperatorEquals   QueryOperator = "="
+	QueryOperatorContains QueryOperator = "~"
+)
+
+// QueryTerm is a single condition of a Query.
+type QueryTerm struct {
+	Kind     QueryTermKind
+	Key      string
+	Operator QueryOperator
+	Value    string
+}
+
+// Query is a workflow search query, shared by live and archived workflows. All terms must match.
+//
+// A query is a space separated list of terms. Values containing spaces can be double quoted.
+//
+//	param.NAME=VALUE     argument parameter NAME equals VALUE
+//	template=NAME        a node ran template NAME, or the workflow was created from workflow template NAME
+//	node.phase=PHASE     a node is in PHASE
+//	node.message~TEXT    a node message contains TEXT
+//	$.PATH=VALUE         the value at JSONPath $.PATH equals VALUE, `~` can be used for contains
+//	TEXT                 TEXT appears anywhere in the workflow
+type Query []QueryTerm
+
+var (
+	jsonPathRegex        = regexp.MustCompile(`^\$(\.[a-zA-Z0-9_-]+|\[[0-9]+\])+$`)
+	jsonPathSegmentRegex = regexp.MustCompile(`\.[a-zA-Z0-9_-]+|\[[0-9]+\]`)
+)
+
+// ParseQuery parses a workflow search query.
+func ParseQuery(q string) (Query, error) {
+	tokens, err := splitQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	var query Query
+	for _, token := range tokens {
+		term, err := parseQueryTerm(token)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query = append(query, term)
+	}
+	return query, nil
+}
+
+func splitQuery(q string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes, hasToken := false, false
+	for _, r := range q {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			hasToken = true
+		case r == ' ' && !inQuotes:
+			if hasToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				hasToken = false
+			}
+		default:
+			current.WriteRune(r)
+			hasToken = true
+		}
+	}
+	if inQuotes {
+		return nil, status.Error(codes.InvalidArgument, "unterminated quote in query")
+	}
+	if hasToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+func parseQueryTerm(token string) (QueryTerm, error) {
+	i := strings.IndexAny(token, "=~")
+	if i < 0 {
+		return QueryTerm{Kind: QueryTermText, Operator: QueryOperatorContains, Value: token}, nil
+	}
+	key, operator, value := token[:i], QueryOperator(token[i:i+1]), token[i+1:]
+	switch {
+	case strings.HasPrefix(key, "param."):
+		name := strings.TrimPrefix(key, "param.")
+		if name == "" || operator != QueryOperatorEquals {
+			return QueryTerm{}, fmt.Errorf("invalid query term %q, expected param.NAME=VALUE", token)
+		}
+		return QueryTerm{Kind: QueryTermParameter, Key: name, Operator: operator, Value: value}, nil
+	case key == "template" && operator == QueryOperatorEquals:
+		return QueryTerm{Kind: QueryTermTemplate, Operator: operator, Value: value}, nil
+	case key == "node.phase" && operator == QueryOperatorEquals:
+		return QueryTerm{Kind: QueryTermNodePhase, Operator: operator, Value: value}, nil
+	case key == "node.message" && operator == QueryOperatorContains:
+		return QueryTerm{Kind: QueryTermNodeMessage, Operator: operator, Value: value}, nil
+	case strings.HasPrefix(key, "$"):
+		if !jsonPathRegex.MatchString(key) {
+			return QueryTerm{}, fmt.Errorf("invalid JSONPath %q, only $.field and $[index] selectors are supported", key)
+		}
+		return QueryTerm{Kind: QueryTermJSONPath, Key: key, Operator: operator, Value: value}, nil
+	default:
+		return QueryTerm{}, fmt.Errorf("invalid query term %q", token)
+	}
+}
+
+// JSONPathSegments returns the object keys and array indices of a JSONPath term, e.g. `$.a[0].b` is ["a", "0", "b"].
+func (t QueryTerm) JSONPathSegments() []string {
+	var segments []string
+	for _, s := range strings.Split(strings.NewReplacer("[", ".", "]", "").Replace(strings.TrimPrefix(t.Key, "$")), ".") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// QuotedJSONPath returns the JSONPath of the term with object keys double quoted, e.g. `$.a[0].b-c` is `$."a"[0]."b-c"`,
+// which is understood by SQLite, MySQL and PostgreSQL alike.
+func (t QueryTerm) QuotedJSONPath() string {
+	return "$" + jsonPathSegmentRegex.ReplaceAllStringFunc(strings.TrimPrefix(t.Key, "$"), func(s string) string {
+		if strings.HasPrefix(s, ".") {
+			return `."` + s[1:] + `"`
+		}
+		return s
+	})
+}
+
+// Matches evaluates the query against a workflow in memory, for stores that cannot evaluate it themselves.
+func (q Query) Matches(wf *wfv1.Workflow) bool {
+	for _, term := range q {
+		if !term.matches(wf) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t QueryTerm) matches(wf *wfv1.Workflow) bool {
+	switch t.Kind {
+	case QueryTermParameter:
+		for _, p := range wf.Spec.Arguments.Parameters {
+			if p.Name == t.Key && p.Value != nil && p.Value.String() == t.Value {
+				return true
+			}
+		}
+		return false
+	case QueryTermTemplate:
+		if wf.Spec.WorkflowTemplateRef != nil && wf.Spec.WorkflowTemplateRef.Name == t.Value {
+			return true
+		}
+		for _, n := range wf.Status.Nodes {
+			if n.TemplateName == t.Value {
+				return true
+			}
+		}
+		return false
+	case QueryTermNodePhase:
+		for _, n := range wf.Status.Nodes {
+			if string(n.Phase) == t.Value {
+				return true
+			}
+		}
+		return false
+	case QueryTermNodeMessage:
+		for _, n := range wf.Status.Nodes {
+			if strings.Contains(n.Message, t.Value) {
+				return true
+			}
+		}
+		return false
+	case QueryTermJSONPath:
+		data, err := json.Marshal(wf)
+		if err != nil {
+			return false
+		}
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return false
+		}
+		for _, s := range t.JSONPathSegments() {
+			switch x := v.(type) {
+			case map[string]any:
+				v = x[s]
+			case []any:
+				i, err := strconv.Atoi(s)
+				if err != nil || i >= len(x) {
+					return false
+				}
+				v = x[i]
+			default:
+				return false
+			}
+		}
+		if v == nil {
+			return false
+		}
+		value := fmt.Sprint(v)
+		if t.Operator == QueryOperatorContains {
+			return strings.Contains(value, t.Value)
+		}
+		return value == t.Value
+	default:
+		data, err := json.Marshal(wf)
+		return err == nil && strings.Contains(string(data), t.Value)
+	}
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		query, err := ParseQuery("")
+		require.NoError(t, err)
+		assert.Empty(t, query)
+	})
+	t.Run("Terms", func(t *testing.T) {
+		query, err := ParseQuery(`param.message=hello template=main node.phase=Failed node.message~"out of memory" $.status.phase=Failed $.spec.templates[0].name~ma oom`)
+		require.NoError(t, err)
+		assert.Equal(t, Query{
+			{Kind: QueryTermParameter, Key: "message", Operator: QueryOperatorEquals, Value: "hello"},
+			{Kind: QueryTermTemplate, Operator: QueryOperatorEquals, Value: "main"},
+			{Kind: QueryTermNodePhase, Operator: QueryOperatorEquals, Value: "Failed"},
+			{Kind: QueryTermNodeMessage, Operator: QueryOperatorContains, Value: "out of memory"},
+			{Kind: QueryTermJSONPath, Key: "$.status.phase", Operator: QueryOperatorEquals, Value: "Failed"},
+			{Kind: QueryTermJSONPath, Key: "$.spec.templates[0].name", Operator: QueryOperatorContains, Value: "ma"},
+			{Kind: QueryTermText, Operator: QueryOperatorContains, Value: "oom"},
+		}, query)
+	})
+	for _, q := range []string{`"unterminated`, "param.=x", "param.x~y", "template~main", "node.phase~Failed", "node.message=x", "$..x=y", "$.a b=c", "foo=bar"} {
+		t.Run("Invalid "+q, func(t *testing.T) {
+			_, err := ParseQuery(q)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestQueryTerm_QuotedJSONPath(t *testing.T) {
+	assert.Equal(t, `$."metadata"."labels"."my-label"`, QueryTerm{Key: "$.metadata.labels.my-label"}.QuotedJSONPath())
+	assert.Equal(t, `$."spec"."templates"[1]."name"`, QueryTerm{Key: "$.spec.templates[1].name"}.QuotedJSONPath())
+	assert.Equal(t, []string{"spec", "templates", "1", "name"}, QueryTerm{Key: "$.spec.templates[1].name"}.JSONPathSegments())
+}
+
+func TestQuery_Matches(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec: wfv1.WorkflowSpec{
+			Arguments:           wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+			WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-wftmpl"},
+			Templates:           []wfv1.Template{{Name: "main"}},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: wfv1.Nodes{"n": {TemplateName: "main", Phase: wfv1.NodeFailed, Message: "OOMKilled (exit code 137)"}},
+		},
+	}
+	for q, want := range map[string]bool{
+		"":                              true,
+		"param.message=hello":           true,
+		"param.message=bye":             false,
+		"template=main":                 true,
+		"template=my-wftmpl":            true,
+		"template=other":                false,
+		"node.phase=Failed":             true,
+		"node.phase=Succeeded":          false,
+		"node.message~OOMKilled":        true,
+		"node.message~Evicted":          false,
+		"$.status.phase=Failed":         true,
+		"$.spec.templates[0].name=main": true,
+		"$.spec.templates[1].name=main": false,
+		"$.metadata.name~my-":           true,
+		"exit code 138":                 false,
+		`"exit code 137"`:               true,
+		"param.message=hello my-wf":     true,
+		"param.message=hello other":     false,
+	} {
+		t.Run(q, func(t *testing.T) {
+			query, err := ParseQuery(q)
+			require.NoError(t, err)
+			assert.Equal(t, want, query.Matches(wf))
+		})
+	}
+}
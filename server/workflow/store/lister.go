@@ -2,16 +2,29 @@ package store
 
 import (
 	"context"
+	"sort"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 )
 
 type WorkflowLister interface {
-	ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error)
-	CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (int64, error)
+	ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error)
+	CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (int64, error)
+	// ListWorkflowsFrom lists at most listOptions.Limit workflows, or all of them when it is zero, from the offset in
+	// the MostRecentlyStartedFirst order. The continue token of listOptions is ignored.
+	ListWorkflowsFrom(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions, offset int) (*wfv1.WorkflowList, error)
+}
+
+// MostRecentlyStartedFirst is the order of ListWorkflowsFrom, which is also the order the workflow archive lists in
+func MostRecentlyStartedFirst(a, b wfv1.Workflow) bool {
+	if !a.Status.StartedAt.Equal(&b.Status.StartedAt) {
+		return a.Status.StartedAt.After(b.Status.StartedAt.Time)
+	}
+	return a.UID > b.UID
 }
 
 type kubeLister struct {
@@ -24,18 +37,58 @@ func NewKubeLister(wfClient versioned.Interface) WorkflowLister {
 	return &kubeLister{wfClient: wfClient}
 }
 
-func (k *kubeLister) ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error) {
-	wfList, err := k.wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, listOptions)
-	if err != nil {
-		return nil, err
+func (k *kubeLister) ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error) {
+	if len(search) == 0 {
+		return k.wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, listOptions)
 	}
-	return wfList, nil
+	// the Kubernetes API cannot evaluate the query, so we do it in memory, paging through the workflows until there
+	// are enough matches. No page is larger than the number of matches still needed, so the continue token never
+	// skips over a match.
+	limit := listOptions.Limit
+	result := &wfv1.WorkflowList{}
+	for {
+		wfList, err := k.wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, wfv1.Workflows(wfList.Items).Filter(func(wf wfv1.Workflow) bool { return search.Matches(&wf) })...)
+		result.ListMeta = wfList.ListMeta
+		if wfList.Continue == "" || (limit > 0 && int64(len(result.Items)) >= limit) {
+			break
+		}
+		listOptions.Continue = wfList.Continue
+		if limit > 0 {
+			listOptions.Limit = limit - int64(len(result.Items))
+		}
+	}
+	// the remaining item count is of all the workflows, not of the matching ones
+	result.RemainingItemCount = nil
+	return result, nil
 }
 
-func (k *kubeLister) CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (int64, error) {
-	wfList, err := k.wfClient.ArgoprojV1alpha1().Workflows(namespace).List(ctx, listOptions)
+func (k *kubeLister) CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (int64, error) {
+	listOptions.Limit = 0
+	listOptions.Continue = ""
+	wfList, err := k.ListWorkflows(ctx, namespace, nameFilter, createdAfter, finishedBefore, search, listOptions)
 	if err != nil {
 		return 0, err
 	}
 	return int64(len(wfList.Items)), nil
 }
+
+// ListWorkflowsFrom lists all the workflows, and sorts and slices them in memory, as the Kubernetes API can do neither.
+func (k *kubeLister) ListWorkflowsFrom(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions, offset int) (*wfv1.WorkflowList, error) {
+	limit := listOptions.Limit
+	listOptions.Limit = 0
+	listOptions.Continue = ""
+	wfList, err := k.ListWorkflows(ctx, namespace, nameFilter, createdAfter, finishedBefore, search, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(wfList.Items, func(i, j int) bool { return MostRecentlyStartedFirst(wfList.Items[i], wfList.Items[j]) })
+	wfList.Items = wfList.Items[min(offset, len(wfList.Items)):]
+	if limit > 0 && int64(len(wfList.Items)) > limit {
+		wfList.Items = wfList.Items[:limit]
+	}
+	return wfList, nil
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestKubeLister(t *testing.T) {
+	var wfs []wfv1.Workflow
+	for i, tmpl := range []string{"other", "match", "other", "other", "match", "match", "other"} {
+		wfs = append(wfs, wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: strconv.Itoa(i), Namespace: "workflows"},
+			Spec:       wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: tmpl}},
+		})
+	}
+	lister := NewKubeLister(&pagingClientset{Clientset: fake.NewSimpleClientset(), wfs: wfs})
+	ctx := logging.TestContext(t.Context())
+	search, err := sutils.ParseQuery("template=match")
+	require.NoError(t, err)
+	names := func(wfList *wfv1.WorkflowList) []string {
+		var out []string
+		for _, wf := range wfList.Items {
+			out = append(out, wf.Name)
+		}
+		return out
+	}
+
+	t.Run("Page", func(t *testing.T) {
+		wfList, err := lister.ListWorkflows(ctx, "workflows", "", "", "", search, metav1.ListOptions{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "4"}, names(wfList))
+		assert.Equal(t, "5", wfList.Continue)
+		assert.Nil(t, wfList.RemainingItemCount)
+
+		wfList, err = lister.ListWorkflows(ctx, "workflows", "", "", "", search, metav1.ListOptions{Limit: 2, Continue: wfList.Continue})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"5"}, names(wfList))
+		assert.Empty(t, wfList.Continue)
+	})
+	t.Run("All", func(t *testing.T) {
+		wfList, err := lister.ListWorkflows(ctx, "workflows", "", "", "", search, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "4", "5"}, names(wfList))
+	})
+	t.Run("Count", func(t *testing.T) {
+		count, err := lister.CountWorkflows(ctx, "workflows", "", "", "", search, metav1.ListOptions{Limit: 1, Continue: "5"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+}
+
+func TestKubeListerListWorkflowsFrom(t *testing.T) {
+	wf := func(name string, startedAt int) *wfv1.Workflow {
+		return &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "workflows", UID: types.UID(name)},
+			Status:     wfv1.WorkflowStatus{StartedAt: metav1.Unix(int64(startedAt), 0)},
+		}
+	}
+	// the Kubernetes API lists by name, not by most recently started
+	lister := NewKubeLister(fake.NewSimpleClientset(wf("a", 1), wf("b", 3), wf("c", 2)))
+	ctx := logging.TestContext(t.Context())
+	names := func(offset int, limit int64) []string {
+		wfList, err := lister.ListWorkflowsFrom(ctx, "workflows", "", "", "", nil, metav1.ListOptions{Limit: limit}, offset)
+		require.NoError(t, err)
+		var out []string
+		for _, wf := range wfList.Items {
+			out = append(out, wf.Name)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"b", "c", "a"}, names(0, 0))
+	assert.Equal(t, []string{"c"}, names(1, 1))
+	assert.Equal(t, []string{"a"}, names(2, 2))
+	assert.Empty(t, names(3, 1))
+}
+
+// pagingClientset lists workflows with the limit and continue token like the Kubernetes API does, which the fake
+// clientset ignores
+type pagingClientset struct {
+	*fake.Clientset
+	wfs []wfv1.Workflow
+}
+
+func (c *pagingClientset) ArgoprojV1alpha1() v1alpha1.ArgoprojV1alpha1Interface {
+	return &pagingArgoprojV1alpha1{ArgoprojV1alpha1Interface: c.Clientset.ArgoprojV1alpha1(), wfs: c.wfs}
+}
+
+type pagingArgoprojV1alpha1 struct {
+	v1alpha1.ArgoprojV1alpha1Interface
+	wfs []wfv1.Workflow
+}
+
+func (c *pagingArgoprojV1alpha1) Workflows(namespace string) v1alpha1.WorkflowInterface {
+	return &pagingWorkflows{WorkflowInterface: c.ArgoprojV1alpha1Interface.Workflows(namespace), wfs: c.wfs}
+}
+
+type pagingWorkflows struct {
+	v1alpha1.WorkflowInterface
+	wfs []wfv1.Workflow
+}
+
+func (c *pagingWorkflows) List(_ context.Context, opts metav1.ListOptions) (*wfv1.WorkflowList, error) {
+	start := 0
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+	}
+	end := len(c.wfs)
+	if opts.Limit > 0 {
+		end = min(start+int(opts.Limit), end)
+	}
+	wfList := &wfv1.WorkflowList{Items: c.wfs[start:end]}
+	if end < len(c.wfs) {
+		wfList.Continue = strconv.Itoa(end)
+		remaining := int64(len(c.wfs) - end)
+		wfList.RemainingItemCount = &remaining
+	}
+	return wfList, nil
+}
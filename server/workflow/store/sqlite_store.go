@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,10 +45,36 @@ create table if not exists argo_workflows_labels (
   foreign key (uid) references argo_workflows (uid) on delete cascade
 );
 create index if not exists idx_name_value on argo_workflows_labels (name, value);
+create table if not exists argo_workflows_parameters (
+  uid varchar(128) not null,
+  name varchar(256) not null,
+  value text,
+  primary key (uid, name),
+  foreign key (uid) references argo_workflows (uid) on delete cascade
+);
+create index if not exists idx_parameter_name_value on argo_workflows_parameters (name, value);
+create table if not exists argo_workflows_nodes (
+  uid varchar(128) not null,
+  id varchar(256) not null,
+  templatename varchar(256),
+  phase varchar(25),
+  message text,
+  primary key (uid, id),
+  foreign key (uid) references argo_workflows (uid) on delete cascade
+);
+create index if not exists idx_node_phase on argo_workflows_nodes (phase);
+create index if not exists idx_node_templatename on argo_workflows_nodes (templatename);
+-- the trigram tokenizer indexes substrings, so that text is matched anywhere, as it is in archived workflows
+create virtual table if not exists argo_workflows_fts using fts5(uid unindexed, content, tokenize="trigram case_sensitive 1");
 `
-	insertWorkflowQuery      = `insert into argo_workflows (uid, instanceid, name, namespace, phase, startedat, finishedat, workflow) values (?, ?, ?, ?, ?, ?, ?, ?)`
-	insertWorkflowLabelQuery = `insert into argo_workflows_labels (uid, name, value) values (?, ?, ?)`
-	deleteWorkflowQuery      = `delete from argo_workflows where uid = ?`
+	insertWorkflowQuery          = `insert into argo_workflows (uid, instanceid, name, namespace, phase, startedat, finishedat, workflow) values (?, ?, ?, ?, ?, ?, ?, ?)`
+	insertWorkflowLabelQuery     = `insert into argo_workflows_labels (uid, name, value) values (?, ?, ?)`
+	insertWorkflowParameterQuery = `insert into argo_workflows_parameters (uid, name, value) values (?, ?, ?)`
+	insertWorkflowNodeQuery      = `insert into argo_workflows_nodes (uid, id, templatename, phase, message) values (?, ?, ?, ?, ?)`
+	insertWorkflowFTSQuery       = `insert into argo_workflows_fts (uid, content) values (?, ?)`
+	deleteWorkflowQuery          = `delete from argo_workflows where uid = ?`
+	// argo_workflows_fts is a virtual table, so it cannot cascade deletes
+	deleteWorkflowFTSQuery = `delete from argo_workflows_fts where uid = ?`
 )
 
 func initDB() (*sqlite.Conn, error) {
@@ -89,7 +116,7 @@ func NewSQLiteStore(instanceService instanceid.Service) (*SQLiteStore, error) {
 	return &SQLiteStore{conn: conn, instanceService: instanceService}, nil
 }
 
-func (s *SQLiteStore) ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error) {
+func (s *SQLiteStore) ListWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (*wfv1.WorkflowList, error) {
 	options, err := sutils.BuildListOptions(listOptions, namespace, "", nameFilter, createdAfter, finishedBefore)
 	if err != nil {
 		return nil, err
@@ -98,6 +125,10 @@ func (s *SQLiteStore) ListWorkflows(ctx context.Context, namespace, nameFilter,
 where instanceid = ?
 `
 	args := []any{s.instanceService.InstanceID()}
+	query, args, err = withQueryClauses(query, args, search)
+	if err != nil {
+		return nil, err
+	}
 
 	query, args, err = persist.BuildWorkflowSelector(query, args, workflowTableName, workflowLabelsTableName, sqldb.SQLite, options, false)
 	if err != nil {
@@ -130,7 +161,14 @@ where instanceid = ?
 	}, nil
 }
 
-func (s *SQLiteStore) CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, listOptions metav1.ListOptions) (int64, error) {
+// ListWorkflowsFrom lists the workflows from the offset, which the store takes as the continue token. The store lists
+// in the MostRecentlyStartedFirst order.
+func (s *SQLiteStore) ListWorkflowsFrom(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions, offset int) (*wfv1.WorkflowList, error) {
+	listOptions.Continue = fmt.Sprint(offset)
+	return s.ListWorkflows(ctx, namespace, nameFilter, createdAfter, finishedBefore, search, listOptions)
+}
+
+func (s *SQLiteStore) CountWorkflows(ctx context.Context, namespace, nameFilter, createdAfter, finishedBefore string, search sutils.Query, listOptions metav1.ListOptions) (int64, error) {
 	options, err := sutils.BuildListOptions(listOptions, namespace, "", nameFilter, createdAfter, finishedBefore)
 	if err != nil {
 		return 0, err
@@ -139,6 +177,10 @@ func (s *SQLiteStore) CountWorkflows(ctx context.Context, namespace, nameFilter,
 where instanceid = ?
 `
 	args := []any{s.instanceService.InstanceID()}
+	query, args, err = withQueryClauses(query, args, search)
+	if err != nil {
+		return 0, err
+	}
 
 	options.Limit = 0
 	options.Offset = 0
@@ -196,7 +238,10 @@ func (s *SQLiteStore) Delete(obj interface{}) error {
 	}
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
-	return sqlitex.Execute(s.conn, deleteWorkflowQuery, &sqlitex.ExecOptions{Args: []any{string(wf.UID)}})
+	done := sqlitex.Transaction(s.conn)
+	err := s.deleteWorkflow(string(wf.UID))
+	defer done(&err)
+	return err
 }
 
 func (s *SQLiteStore) Replace(list []interface{}, resourceVersion string) error {
@@ -236,9 +281,18 @@ func (s *SQLiteStore) GetByKey(key string) (item interface{}, exists bool, err e
 	panic("not implemented")
 }
 
+func (s *SQLiteStore) deleteWorkflow(uid string) error {
+	// Called with the mutex
+	err := sqlitex.Execute(s.conn, deleteWorkflowQuery, &sqlitex.ExecOptions{Args: []any{uid}})
+	if err != nil {
+		return err
+	}
+	return sqlitex.Execute(s.conn, deleteWorkflowFTSQuery, &sqlitex.ExecOptions{Args: []any{uid}})
+}
+
 func (s *SQLiteStore) upsertWorkflow(wf *wfv1.Workflow) error {
 	// Called with the mutex
-	err := sqlitex.Execute(s.conn, deleteWorkflowQuery, &sqlitex.ExecOptions{Args: []any{string(wf.UID)}})
+	err := s.deleteWorkflow(string(wf.UID))
 	if err != nil {
 		return err
 	}
@@ -273,7 +327,48 @@ func (s *SQLiteStore) upsertWorkflow(wf *wfv1.Workflow) error {
 			return err
 		}
 	}
-	return nil
+	return s.insertSearchIndexes(wf, string(workflow))
+}
+
+// insertSearchIndexes populates the tables used to search workflows by parameter, node and full-text
+func (s *SQLiteStore) insertSearchIndexes(wf *wfv1.Workflow, workflow string) error {
+	// Called with the mutex
+	stmt, err := s.conn.Prepare(insertWorkflowParameterQuery)
+	if err != nil {
+		return err
+	}
+	for _, p := range wf.Spec.Arguments.Parameters {
+		if p.Value == nil {
+			continue
+		}
+		if err = stmt.Reset(); err != nil {
+			return err
+		}
+		stmt.BindText(1, string(wf.UID))
+		stmt.BindText(2, p.Name)
+		stmt.BindText(3, p.Value.String())
+		if _, err = stmt.Step(); err != nil {
+			return err
+		}
+	}
+	stmt, err = s.conn.Prepare(insertWorkflowNodeQuery)
+	if err != nil {
+		return err
+	}
+	for id, n := range wf.Status.Nodes {
+		if err = stmt.Reset(); err != nil {
+			return err
+		}
+		stmt.BindText(1, string(wf.UID))
+		stmt.BindText(2, id)
+		stmt.BindText(3, n.TemplateName)
+		stmt.BindText(4, string(n.Phase))
+		stmt.BindText(5, n.Message)
+		if _, err = stmt.Step(); err != nil {
+			return err
+		}
+	}
+	return sqlitex.Execute(s.conn, insertWorkflowFTSQuery, &sqlitex.ExecOptions{Args: []any{string(wf.UID), workflow}})
 }
 
 func (s *SQLiteStore) replaceWorkflows(workflows []*wfv1.Workflow) error {
@@ -281,6 +376,10 @@ func (s *SQLiteStore) replaceWorkflows(workflows []*wfv1.Workflow) error {
 	if err != nil {
 		return err
 	}
+	err = sqlitex.Execute(s.conn, `delete from argo_workflows_fts`, nil)
+	if err != nil {
+		return err
+	}
 	wfs := make([]*wfv1.Workflow, 0, len(workflows))
 	for _, wf := range workflows {
 		// if workflow is archived, we don't need to store it in the sqlite store, we get if from the archive store instead
@@ -293,6 +392,7 @@ func (s *SQLiteStore) replaceWorkflows(workflows []*wfv1.Workflow) error {
 	if err != nil {
 		return err
 	}
+	workflowJSON := make(map[*wfv1.Workflow]string, len(wfs))
 	for _, wf := range wfs {
 		if err = stmt.Reset(); err != nil {
 			return err
@@ -312,6 +412,7 @@ func (s *SQLiteStore) replaceWorkflows(workflows []*wfv1.Workflow) error {
 		if _, err = stmt.Step(); err != nil {
 			return err
 		}
+		workflowJSON[wf] = string(workflow)
 	}
 	stmt, err = s.conn.Prepare(insertWorkflowLabelQuery)
 	if err != nil {
@@ -330,5 +431,51 @@ func (s *SQLiteStore) replaceWorkflows(workflows []*wfv1.Workflow) error {
 			}
 		}
 	}
+	for _, wf := range wfs {
+		if err = s.insertSearchIndexes(wf, workflowJSON[wf]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// globEscaper escapes the glob wildcards, so that they match literally
+var globEscaper = strings.NewReplacer("[", "[[]", "*", "[*]", "?", "[?]")
+
+// withQueryClauses adds a condition for each term of the search query, using the search index tables where possible
+func withQueryClauses(query string, args []any, search sutils.Query) (string, []any, error) {
+	for _, term := range search {
+		switch term.Kind {
+		case sutils.QueryTermParameter:
+			query += " and uid in (select uid from argo_workflows_parameters where name = ? and value = ?)"
+			args = append(args, term.Key, term.Value)
+		case sutils.QueryTermTemplate:
+			query += " and (json_extract(workflow, '$.spec.workflowTemplateRef.name') = ? or uid in (select uid from argo_workflows_nodes where templatename = ?))"
+			args = append(args, term.Value, term.Value)
+		case sutils.QueryTermNodePhase:
+			query += " and uid in (select uid from argo_workflows_nodes where phase = ?)"
+			args = append(args, term.Value)
+		case sutils.QueryTermNodeMessage:
+			query += " and uid in (select uid from argo_workflows_nodes where instr(message, ?) > 0)"
+			args = append(args, term.Value)
+		case sutils.QueryTermJSONPath:
+			// json_extract returns booleans as integers, so render them the same way as the other databases do
+			value := "(case json_type(workflow, ?) when 'true' then 'true' when 'false' then 'false' else cast(json_extract(workflow, ?) as text) end)"
+			if term.Operator == sutils.QueryOperatorContains {
+				query += " and instr(" + value + ", ?) > 0"
+			} else {
+				query += " and " + value + " = ?"
+			}
+			path := term.QuotedJSONPath()
+			args = append(args, path, path, term.Value)
+		case sutils.QueryTermText:
+			// match the text as a case-sensitive substring, like the other stores do, which glob can look up in the
+			// trigram index
+			query += " and uid in (select uid from argo_workflows_fts where content glob ?)"
+			args = append(args, "*"+globEscaper.Replace(term.Value)+"*")
+		default:
+			return "", nil, fmt.Errorf("unsupported query term kind %q", term.Kind)
+		}
+	}
+	return query, args, nil
+}
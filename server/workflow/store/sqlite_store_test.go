@@ -3,6 +3,7 @@ package store
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"zombiezen.com/go/sqlite/sqlitex"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 )
 
@@ -27,7 +29,11 @@ func TestInitDB(t *testing.T) {
 		err = sqlitex.Execute(conn, `select name from sqlite_master where type='table'`, &sqlitex.ExecOptions{
 			ResultFunc: func(stmt *sqlite.Stmt) error {
 				name := stmt.ColumnText(0)
-				assert.Contains(t, []string{workflowTableName, workflowLabelsTableName}, name)
+				if strings.HasPrefix(name, "argo_workflows_fts") {
+					// the full-text search virtual table and its shadow tables
+					return nil
+				}
+				assert.Contains(t, []string{workflowTableName, workflowLabelsTableName, "argo_workflows_parameters", "argo_workflows_nodes"}, name)
 				return nil
 			},
 		})
@@ -54,6 +60,8 @@ func TestInitDB(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, indexes, "idx_instanceid")
 		assert.Contains(t, indexes, "idx_name_value")
+		assert.Contains(t, indexes, "idx_parameter_name_value")
+		assert.Contains(t, indexes, "idx_node_phase")
 	})
 	t.Run("TestForeignKeysAdded", func(t *testing.T) {
 		err = sqlitex.Execute(conn, `pragma foreign_key_list('argo_workflows_labels')`, &sqlitex.ExecOptions{
@@ -82,7 +90,7 @@ func TestStoreOperation(t *testing.T) {
 			require.NoError(t, store.Add(generateWorkflow(i)))
 		}
 		ctx := logging.TestContext(t.Context())
-		num, err := store.CountWorkflows(ctx, "argo", "", "", "", metav1.ListOptions{})
+		num, err := store.CountWorkflows(ctx, "argo", "", "", "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(10), num)
 		// Labels are also added
@@ -133,106 +141,178 @@ func TestStoreOperation(t *testing.T) {
 	})
 	t.Run("TestListWorkflows", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
-		wfList, err := store.ListWorkflows(ctx, "argo", "", "", "", metav1.ListOptions{Limit: 5})
+		wfList, err := store.ListWorkflows(ctx, "argo", "", "", "", nil, metav1.ListOptions{Limit: 5})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 5)
 	})
 	t.Run("TestListWorkflows name", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
-		wfList, err := store.ListWorkflows(ctx, "argo", "Exact", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
+		wfList, err := store.ListWorkflows(ctx, "argo", "Exact", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
 		require.NoError(t, err)
 		assert.Empty(t, wfList.Items)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "Exact", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "Exact", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 1)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 1)
 	})
 	t.Run("TestListWorkflows namePrefix", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
-		wfList, err := store.ListWorkflows(ctx, "argo", "Prefix", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
+		wfList, err := store.ListWorkflows(ctx, "argo", "Prefix", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
 		require.NoError(t, err)
 		assert.Empty(t, wfList.Items)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "Prefix", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "Prefix", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 5)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "Prefix", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "Prefix", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 1)
 	})
 	t.Run("TestListWorkflows namePattern", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
-		wfList, err := store.ListWorkflows(ctx, "argo", "Contains", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=non-existing-pattern"})
+		wfList, err := store.ListWorkflows(ctx, "argo", "Contains", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=non-existing-pattern"})
 		require.NoError(t, err)
 		assert.Empty(t, wfList.Items)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "Contains", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "Contains", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=flow"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 5)
 
-		wfList, err = store.ListWorkflows(ctx, "argo", "Contains", "", "", metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
+		wfList, err = store.ListWorkflows(ctx, "argo", "Contains", "", "", nil, metav1.ListOptions{Limit: 5, FieldSelector: "metadata.name=workflow-1"})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 1)
 	})
 	t.Run("TestListWorkflows finishedBefore", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
 		// Finished before today
-		wfList, err := store.ListWorkflows(ctx, "argo", "", "", time.Now().Format(time.RFC3339), metav1.ListOptions{})
+		wfList, err := store.ListWorkflows(ctx, "argo", "", "", time.Now().Format(time.RFC3339), nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 9)
 
 		// Finished before 1 day ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-24*time.Hour).Format(time.RFC3339), metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-24*time.Hour).Format(time.RFC3339), nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 8)
 
 		// Finished before 5 days ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-5*24*time.Hour).Format(time.RFC3339), metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-5*24*time.Hour).Format(time.RFC3339), nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 4)
 
 		// Finished before 10 days ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-24*10*time.Hour).Format(time.RFC3339), metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", "", time.Now().Add(-24*10*time.Hour).Format(time.RFC3339), nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, wfList.Items)
 	})
 	t.Run("TestListWorkflows createdAfter", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
 		// Created after today
-		wfList, err := store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Format(time.RFC3339), "", metav1.ListOptions{})
+		wfList, err := store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Format(time.RFC3339), "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Empty(t, wfList.Items)
 
 		// Created after 1 day ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-24*time.Hour).Format(time.RFC3339), "", metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-24*time.Hour).Format(time.RFC3339), "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 1)
 
 		// Created after 3 days ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-3*24*time.Hour).Format(time.RFC3339), "", metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-3*24*time.Hour).Format(time.RFC3339), "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 3)
 
 		// Created after 10 days ago
-		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-10*24*time.Hour).Format(time.RFC3339), "", metav1.ListOptions{})
+		wfList, err = store.ListWorkflows(ctx, "argo", "", time.Now().UTC().Add(-10*24*time.Hour).Format(time.RFC3339), "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Len(t, wfList.Items, 9)
 	})
+	t.Run("TestListWorkflowsFrom", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfList, err := store.ListWorkflowsFrom(ctx, "argo", "", "", "", nil, metav1.ListOptions{Limit: 3}, 2)
+		require.NoError(t, err)
+		var names []string
+		for _, wf := range wfList.Items {
+			names = append(names, wf.Name)
+		}
+		// none of the workflows have started, so they are ordered by UID
+		assert.Equal(t, []string{"workflow-7", "workflow-6", "workflow-5"}, names)
+	})
 	t.Run("TestCountWorkflows", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
-		num, err := store.CountWorkflows(ctx, "argo", "", "", "", metav1.ListOptions{})
+		num, err := store.CountWorkflows(ctx, "argo", "", "", "", nil, metav1.ListOptions{})
 		require.NoError(t, err)
 		assert.Equal(t, int64(9), num)
 	})
+	t.Run("TestSearchWorkflows", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		all, err := store.ListWorkflows(ctx, "argo", "", "", "", nil, metav1.ListOptions{})
+		require.NoError(t, err)
+		for _, tt := range []struct {
+			query string
+			want  []string
+		}{
+			{"param.message=hello-3", []string{"workflow-3"}},
+			{"param.message=hello", nil},
+			{"template=template-4", []string{"workflow-4"}},
+			{"template=my-wftmpl", []string{"workflow-5"}},
+			{"node.phase=Failed", []string{"workflow-5"}},
+			{"node.message~OOMKilled", []string{"workflow-5"}},
+			{"$.metadata.labels.test-label=label-6", []string{"workflow-6"}},
+			{"$.metadata.name=workflow-6", []string{"workflow-6"}},
+			{"$.metadata.name~kflow-7", []string{"workflow-7"}},
+			{"$.status.nodes.node-5.phase=Failed", []string{"workflow-5"}},
+			{"label-8", []string{"workflow-8"}},
+			{"OOMKill", []string{"workflow-5"}},
+			{"oomkill", nil},
+			{"bel-8", []string{"workflow-8"}},
+			{`"exit code 13"`, []string{"workflow-5"}},
+			{"[*?]", nil},
+			{"node.phase=Failed param.message=hello-4", nil},
+		} {
+			t.Run(tt.query, func(t *testing.T) {
+				search, err := sutils.ParseQuery(tt.query)
+				require.NoError(t, err)
+				wfList, err := store.ListWorkflows(ctx, "argo", "", "", "", search, metav1.ListOptions{})
+				require.NoError(t, err)
+				var names []string
+				for _, wf := range wfList.Items {
+					names = append(names, wf.Name)
+				}
+				assert.ElementsMatch(t, tt.want, names)
+				// the store must match the same workflows as the query evaluated in memory, as it is for other stores
+				var matched []string
+				for _, wf := range all.Items {
+					if search.Matches(&wf) {
+						matched = append(matched, wf.Name)
+					}
+				}
+				assert.ElementsMatch(t, matched, names)
+				num, err := store.CountWorkflows(ctx, "argo", "", "", "", search, metav1.ListOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, int64(len(tt.want)), num)
+			})
+		}
+	})
+	t.Run("TestSearchIndexesDeleted", func(t *testing.T) {
+		require.NoError(t, store.Delete(generateWorkflow(5)))
+		for _, table := range []string{"argo_workflows_parameters", "argo_workflows_nodes", "argo_workflows_fts"} {
+			require.NoError(t, sqlitex.Execute(conn, `select count(*) from `+table+` where uid = 'uid-5'`, &sqlitex.ExecOptions{
+				ResultFunc: func(stmt *sqlite.Stmt) error {
+					assert.Equal(t, 0, stmt.ColumnInt(0), table)
+					return nil
+				},
+			}))
+		}
+	})
 }
 
 func generateWorkflow(uid int) *wfv1.Workflow {
-	return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{
 		UID:               types.UID(fmt.Sprintf("uid-%d", uid)),
 		Name:              fmt.Sprintf("workflow-%d", uid),
 		Namespace:         "argo",
@@ -244,4 +324,13 @@ func generateWorkflow(uid int) *wfv1.Workflow {
 			"test-label": fmt.Sprintf("label-%d", uid),
 		},
 	}, Status: wfv1.WorkflowStatus{FinishedAt: metav1.NewTime(time.Now().Add(-24 * time.Duration(uid) * time.Hour))}}
+	wf.Spec.Arguments.Parameters = []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr(fmt.Sprintf("hello-%d", uid))}}
+	node := wfv1.NodeStatus{ID: fmt.Sprintf("node-%d", uid), TemplateName: fmt.Sprintf("template-%d", uid), Phase: wfv1.NodeSucceeded}
+	if uid == 5 {
+		wf.Spec.WorkflowTemplateRef = &wfv1.WorkflowTemplateRef{Name: "my-wftmpl"}
+		node.Phase = wfv1.NodeFailed
+		node.Message = "OOMKilled (exit code 137)"
+	}
+	wf.Status.Nodes = wfv1.Nodes{node.ID: node}
+	return wf
 }
@@ -2,13 +2,16 @@ package workflow
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"sync"
 	"time"
 
+	argotime "github.com/argoproj/pkg/time"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -46,6 +49,7 @@ const (
 	latestAlias                  = "@latest"
 	reSyncDuration               = 20 * time.Minute
 	workflowTemplateResyncPeriod = 20 * time.Minute
+	defaultBulkParallelism       = 10
 )
 
 type workflowServer struct {
@@ -53,26 +57,30 @@ type workflowServer struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	decisionLogRepo       sqldb.DecisionLogRepo
 	wfLister              store.WorkflowLister
 	wfReflector           *cache.Reflector
 	wftmplStore           servertypes.WorkflowTemplateStore
 	cwftmplStore          servertypes.ClusterWorkflowTemplateStore
 	wfDefaults            *wfv1.Workflow
+	artifacts             logs.ArtifactOpener
 }
 
 var _ workflowpkg.WorkflowServiceServer = &workflowServer{}
 
 // NewWorkflowServer returns a new WorkflowServer
-func NewWorkflowServer(ctx context.Context, instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, wfClientSet versioned.Interface, wfLister store.WorkflowLister, wfStore store.WorkflowStore, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *wfv1.Workflow, namespace *string) *workflowServer {
+func NewWorkflowServer(ctx context.Context, instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, decisionLogRepo sqldb.DecisionLogRepo, wfClientSet versioned.Interface, wfLister store.WorkflowLister, wfStore store.WorkflowStore, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore, wfDefaults *wfv1.Workflow, artifacts logs.ArtifactOpener, namespace *string) *workflowServer {
 	ws := &workflowServer{
 		instanceIDService:     instanceIDService,
 		offloadNodeStatusRepo: offloadNodeStatusRepo,
 		hydrator:              hydrator.New(offloadNodeStatusRepo),
 		wfArchive:             wfArchive,
+		decisionLogRepo:       decisionLogRepo,
 		wfLister:              wfLister,
 		wftmplStore:           wftmplStore,
 		cwftmplStore:          cwftmplStore,
 		wfDefaults:            wfDefaults,
+		artifacts:             artifacts,
 	}
 	if wfStore != nil && namespace != nil {
 		lw := &cache.ListWatch{
@@ -186,6 +194,11 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 	if err != nil {
 		return nil, err
 	}
+	search, err := sutils.ParseQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	options = options.WithQuery(search)
 
 	// verify if we have permission to list Workflows
 	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, options.Namespace, "")
@@ -197,7 +210,7 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 	}
 
 	var wfs wfv1.Workflows
-	liveWfCount, err := s.wfLister.CountWorkflows(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, listOption)
+	liveWfCount, err := s.wfLister.CountWorkflows(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, search, listOption)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -210,7 +223,7 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 	// first fetch live workflows
 	liveWfList := &wfv1.WorkflowList{}
 	if liveWfCount > 0 && (options.Limit == 0 || options.Offset < int(liveWfCount)) {
-		liveWfList, err = s.wfLister.ListWorkflows(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, listOption)
+		liveWfList, err = s.wfLister.ListWorkflows(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, search, listOption)
 		if err != nil {
 			return nil, sutils.ToStatusError(err, codes.Internal)
 		}
@@ -247,6 +260,146 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 		meta.RemainingItemCount = &remainCount
 	}
 
+	// we make no promises about the overall list sorting, we just sort each page
+	sort.Sort(wfs)
+
+	return s.workflowListResponse(ctx, req, meta, wfs)
+}
+
+// ListAllWorkflows merges the live and archived workflows in the same order as each source lists them, by most
+// recently started, so each page can be taken from the head of both without fetching the preceding pages.
+func (s *workflowServer) ListAllWorkflows(ctx context.Context, req *workflowpkg.WorkflowListRequest) (*wfv1.WorkflowList, error) {
+	listOption := metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOption = *req.ListOptions
+	}
+	token, err := parseAllWorkflowsContinueToken(listOption.Continue)
+	if err != nil {
+		return nil, err
+	}
+	listOption.Continue = ""
+	s.instanceIDService.With(&listOption)
+
+	options, err := sutils.BuildListOptions(listOption, req.Namespace, "", req.NameFilter, req.CreatedAfter, req.FinishedBefore)
+	if err != nil {
+		return nil, err
+	}
+	search, err := sutils.ParseQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	options = options.WithQuery(search)
+
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, options.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\". Maybe you want to specify a namespace with query parameter `.namespace=%s`?", options.Namespace, options.Namespace))
+	}
+
+	liveWfCount, err := s.wfLister.CountWorkflows(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, search, listOption)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	archivedCount, err := s.wfArchive.CountWorkflows(ctx, options)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+
+	// fetch a full page from each source, at most that many items of the merged page can come from either one
+	liveWfList := &wfv1.WorkflowList{}
+	if int64(token.Live) < liveWfCount {
+		liveWfList, err = s.wfLister.ListWorkflowsFrom(ctx, req.Namespace, req.NameFilter, req.CreatedAfter, req.FinishedBefore, search, listOption, token.Live)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	var archivedWfs wfv1.Workflows
+	if int64(token.Archived) < archivedCount {
+		archivedWfs, err = s.wfArchive.ListWorkflows(ctx, options.WithOffset(token.Archived))
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+
+	wfs, live, archived := mergeWorkflows(liveWfList.Items, archivedWfs, options.Limit)
+	next := allWorkflowsContinueToken{Live: token.Live + live, Archived: token.Archived + archived}
+
+	meta := metav1.ListMeta{ResourceVersion: liveWfList.ResourceVersion}
+	if s.wfReflector != nil {
+		meta.ResourceVersion = s.wfReflector.LastSyncResourceVersion()
+	}
+	remainCount := max(liveWfCount-int64(next.Live), 0) + max(archivedCount-int64(next.Archived), 0)
+	if remainCount > 0 {
+		meta.Continue = next.String()
+	}
+	if options.ShowRemainingItemCount {
+		meta.RemainingItemCount = &remainCount
+	}
+	return s.workflowListResponse(ctx, req, meta, wfs)
+}
+
+// mergeWorkflows merges two lists sorted by store.MostRecentlyStartedFirst into one, dropping workflows with the same
+// UID as one already merged, which happens when a live workflow has been archived but not yet labelled so. Live
+// workflows are preferred as they are the most up to date. It returns at most limit workflows, unless limit is zero, and how many
+// workflows were consumed from each list, including dropped ones.
+func mergeWorkflows(live, archived wfv1.Workflows, limit int) (wfv1.Workflows, int, int) {
+	wfs := wfv1.Workflows{}
+	seen := make(map[types.UID]bool)
+	i, j := 0, 0
+	for i < len(live) || j < len(archived) {
+		fromLive := j >= len(archived) || (i < len(live) && !store.MostRecentlyStartedFirst(archived[j], live[i]))
+		var wf wfv1.Workflow
+		if fromLive {
+			wf = live[i]
+		} else {
+			wf = archived[j]
+		}
+		if !seen[wf.UID] {
+			if limit > 0 && len(wfs) == limit {
+				break
+			}
+			seen[wf.UID] = true
+			wfs = append(wfs, wf)
+		}
+		if fromLive {
+			i++
+		} else {
+			j++
+		}
+	}
+	return wfs, i, j
+}
+
+// allWorkflowsContinueToken is the continue token of ListAllWorkflows, the offset into each source
+type allWorkflowsContinueToken struct {
+	Live     int `json:"live"`
+	Archived int `json:"archived"`
+}
+
+func parseAllWorkflowsContinueToken(s string) (allWorkflowsContinueToken, error) {
+	token := allWorkflowsContinueToken{}
+	if s == "" {
+		return token, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &token)
+	}
+	if err != nil || token.Live < 0 || token.Archived < 0 {
+		return token, status.Error(codes.InvalidArgument, "listOptions.continue is not a valid continue token")
+	}
+	return token, nil
+}
+
+func (t allWorkflowsContinueToken) String() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// workflowListResponse populates offloaded nodes and removes unrequested fields
+func (s *workflowServer) workflowListResponse(ctx context.Context, req *workflowpkg.WorkflowListRequest, meta metav1.ListMeta, wfs wfv1.Workflows) (*wfv1.WorkflowList, error) {
 	cleaner := fields.NewCleaner(req.Fields)
 	logger := logging.RequireLoggerFromContext(ctx)
 	if s.offloadNodeStatusRepo.IsEnabled() && !cleaner.WillExclude("items.status.nodes") {
@@ -265,9 +418,6 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 		}
 	}
 
-	// we make no promises about the overall list sorting, we just sort each page
-	sort.Sort(wfs)
-
 	res := &wfv1.WorkflowList{ListMeta: meta, Items: wfs}
 	newRes := &wfv1.WorkflowList{}
 	if ok, err := cleaner.Clean(res, &newRes); err != nil {
@@ -699,13 +849,18 @@ func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflo
 		return sutils.ToStatusError(err, codes.InvalidArgument)
 	}
 	req.Name = wf.Name
+	// the nodes are needed to find the archived logs of pods that no longer exist
+	err = s.hydrator.Hydrate(ctx, wf)
+	if err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
 
 	err = ws.SendHeader(metadata.MD{})
 	if err != nil {
 		return sutils.ToStatusError(err, codes.Internal)
 	}
 
-	err = logs.WorkflowLogs(ctx, wfClient, kubeClient, req, ws)
+	err = logs.WorkflowLogs(ctx, wfClient, kubeClient, s.artifacts, wf, req, ws)
 	return sutils.ToStatusError(err, codes.Internal)
 }
 
@@ -713,6 +868,47 @@ func (s *workflowServer) WorkflowLogs(req *workflowpkg.WorkflowLogRequest, ws wo
 	return sutils.ToStatusError(s.PodLogs(req, ws), codes.Internal)
 }
 
+func (s *workflowServer) ExplainWorkflow(ctx context.Context, req *workflowpkg.WorkflowExplainRequest) (*workflowpkg.WorkflowExplainResponse, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	err = s.validateWorkflow(wf)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	decisions := wf.Status.Decisions
+	// the decision log is only in the DB if it is offloaded
+	if len(decisions) == 0 && s.decisionLogRepo.IsEnabled() {
+		decisions, err = s.decisionLogRepo.Get(ctx, string(wf.UID))
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	if req.Node != "" {
+		err = s.hydrator.Hydrate(ctx, wf)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		// decisions can be about nodes that have not been created yet, whose ID is the hash of their name
+		nodeID := req.Node
+		if node := wf.Status.Nodes.Find(func(node wfv1.NodeStatus) bool {
+			return node.Name == req.Node || node.DisplayName == req.Node
+		}); node != nil {
+			nodeID = node.ID
+		} else if !wf.Status.Nodes.Has(req.Node) {
+			nodeID = wf.NodeID(req.Node)
+		}
+		decisions = decisions.ForNode(nodeID)
+	}
+	res := &workflowpkg.WorkflowExplainResponse{}
+	for _, decision := range decisions {
+		res.Decisions = append(res.Decisions, &decision)
+	}
+	return res, nil
+}
+
 func (s *workflowServer) getWorkflow(ctx context.Context, wfClient versioned.Interface, namespace string, name string, options metav1.GetOptions) (*wfv1.Workflow, error) {
 	logger := logging.RequireLoggerFromContext(ctx)
 	if name == latestAlias {
@@ -830,3 +1026,142 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 	}
 	return wf, nil
 }
+
+func (s *workflowServer) StopWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		stopped, err := s.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{Name: wf.Name, Namespace: wf.Namespace, NodeFieldSelector: req.NodeFieldSelector, Message: req.Message})
+		if err != nil {
+			return err
+		}
+		result.Phase = string(stopped.Status.Phase)
+		return nil
+	})
+}
+
+func (s *workflowServer) TerminateWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		terminated, err := s.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Name: wf.Name, Namespace: wf.Namespace})
+		if err != nil {
+			return err
+		}
+		result.Phase = string(terminated.Status.Phase)
+		return nil
+	})
+}
+
+func (s *workflowServer) RetryWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		retried, err := s.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: wf.Name, Namespace: wf.Namespace, RestartSuccessful: req.RestartSuccessful, NodeFieldSelector: req.NodeFieldSelector, Parameters: req.Parameters})
+		if err != nil {
+			return err
+		}
+		result.Phase = string(retried.Status.Phase)
+		return nil
+	})
+}
+
+func (s *workflowServer) ResubmitWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		created, err := s.ResubmitWorkflow(ctx, &workflowpkg.WorkflowResubmitRequest{Name: wf.Name, Namespace: wf.Namespace, Memoized: req.Memoized, Parameters: req.Parameters})
+		if err != nil {
+			return err
+		}
+		result.CreatedName = created.Name
+		return nil
+	})
+}
+
+func (s *workflowServer) SuspendWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		suspended, err := s.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{Name: wf.Name, Namespace: wf.Namespace})
+		if err != nil {
+			return err
+		}
+		result.Phase = string(suspended.Status.Phase)
+		return nil
+	})
+}
+
+func (s *workflowServer) ResumeWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (*workflowpkg.WorkflowBulkResponse, error) {
+	return s.bulkOperate(ctx, req, func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error {
+		resumed, err := s.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{Name: wf.Name, Namespace: wf.Namespace, NodeFieldSelector: req.NodeFieldSelector})
+		if err != nil {
+			return err
+		}
+		result.Phase = string(resumed.Status.Phase)
+		return nil
+	})
+}
+
+// bulkOperate selects the workflows matching the request and applies op to each of them, at most req.Parallelism
+// at a time. A failure for one workflow does not stop the others, it is reported in that workflow's result.
+func (s *workflowServer) bulkOperate(ctx context.Context, req *workflowpkg.WorkflowBulkRequest, op func(ctx context.Context, wf *wfv1.Workflow, result *workflowpkg.WorkflowBulkResult) error) (*workflowpkg.WorkflowBulkResponse, error) {
+	parallelism := int(req.Parallelism)
+	if parallelism < 0 {
+		return nil, status.Error(codes.InvalidArgument, "parallelism must not be negative")
+	}
+	if parallelism == 0 {
+		parallelism = defaultBulkParallelism
+	}
+	wfs, err := s.listBulkWorkflows(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	results := make([]*workflowpkg.WorkflowBulkResult, len(wfs))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range wfs {
+		wf := &wfs[i]
+		results[i] = &workflowpkg.WorkflowBulkResult{Name: wf.Name, Namespace: wf.Namespace, Phase: string(wf.Status.Phase)}
+		if req.DryRun {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *workflowpkg.WorkflowBulkResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := op(ctx, wf, result); err != nil {
+				logger.WithFields(logging.Fields{"namespace": wf.Namespace, "name": wf.Name}).WithError(err).Warn(ctx, "Bulk operation failed for workflow")
+				result.Error = err.Error()
+			}
+		}(results[i])
+	}
+	wg.Wait()
+	return &workflowpkg.WorkflowBulkResponse{Results: results, DryRun: req.DryRun}, nil
+}
+
+func (s *workflowServer) listBulkWorkflows(ctx context.Context, req *workflowpkg.WorkflowBulkRequest) (wfv1.Workflows, error) {
+	listOptions := metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOptions = *req.ListOptions
+	}
+	s.instanceIDService.With(&listOptions)
+	var olderThan *time.Time
+	if req.Older != "" {
+		t, err := argotime.ParseSince(req.Older)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+		}
+		olderThan = t
+	}
+	wfList, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(req.Namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	wfs := wfv1.Workflows(wfList.Items).Filter(func(wf wfv1.Workflow) bool {
+		if len(req.Phases) > 0 && !slices.Contains(req.Phases, string(wf.Status.Phase)) {
+			return false
+		}
+		if olderThan != nil {
+			if !wf.Status.FinishedAt.IsZero() {
+				return wf.Status.FinishedAt.Time.Before(*olderThan)
+			}
+			return wf.CreationTimestamp.Time.Before(*olderThan)
+		}
+		return true
+	})
+	sort.Sort(wfs)
+	return wfs, nil
+}
@@ -3,17 +3,21 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
@@ -617,6 +621,7 @@ func getWorkflowServer(t *testing.T) (workflowpkg.WorkflowServiceServer, context
 	}
 	archivedRepo.On("CountWorkflows", mock.Anything, sutils.ListOptions{Namespace: "workflows", LabelRequirements: r}).Return(int64(2), nil)
 	archivedRepo.On("ListWorkflows", mock.Anything, sutils.ListOptions{Namespace: "workflows", Limit: -2, LabelRequirements: r}).Return(v1alpha1.Workflows{wfObj2, failedWfObj}, nil)
+	archivedRepo.On("ListWorkflows", mock.Anything, sutils.ListOptions{Namespace: "workflows", LabelRequirements: r}).Return(v1alpha1.Workflows{wfObj2, failedWfObj}, nil)
 	archivedRepo.On("CountWorkflows", mock.Anything, sutils.ListOptions{Namespace: "test", LabelRequirements: r}).Return(int64(1), nil)
 	archivedRepo.On("ListWorkflows", mock.Anything, sutils.ListOptions{Namespace: "test", Limit: -1, LabelRequirements: r}).Return(v1alpha1.Workflows{wfObj4}, nil)
 
@@ -649,7 +654,7 @@ func getWorkflowServer(t *testing.T) (workflowpkg.WorkflowServiceServer, context
 	namespaceAll := metav1.NamespaceAll
 	wftmplStore := workflowtemplate.NewWorkflowTemplateClientStore()
 	cwftmplStore := clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore()
-	server := NewWorkflowServer(ctx, instanceIDSvc, offloadNodeStatusRepo, archivedRepo, wfClientset, wfStore, wfStore, wftmplStore, cwftmplStore, nil, &namespaceAll)
+	server := NewWorkflowServer(ctx, instanceIDSvc, offloadNodeStatusRepo, archivedRepo, sqldb.NullDecisionLogRepo, wfClientset, wfStore, wfStore, wftmplStore, cwftmplStore, nil, nil, &namespaceAll)
 	return server, ctx
 }
 
@@ -777,6 +782,64 @@ func TestListWorkflow(t *testing.T) {
 	assert.Len(t, wfl.Items, 2)
 }
 
+func TestListAllWorkflows(t *testing.T) {
+	server, ctx := getWorkflowServer(t)
+	wfl, err := server.ListAllWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "workflows"})
+	require.NoError(t, err)
+	assert.Len(t, wfl.Items, 4)
+	assert.Empty(t, wfl.Continue)
+	assert.True(t, sort.SliceIsSorted(wfl.Items, func(i, j int) bool { return store.MostRecentlyStartedFirst(wfl.Items[i], wfl.Items[j]) }))
+
+	_, err = server.ListAllWorkflows(ctx, &workflowpkg.WorkflowListRequest{Namespace: "workflows", ListOptions: &metav1.ListOptions{Continue: "1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_mergeWorkflows(t *testing.T) {
+	wf := func(uid string, startedAt int) v1alpha1.Workflow {
+		return v1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{UID: apitypes.UID(uid)},
+			Status:     v1alpha1.WorkflowStatus{StartedAt: metav1.Unix(int64(startedAt), 0)},
+		}
+	}
+	uids := func(wfs v1alpha1.Workflows) []string {
+		var out []string
+		for _, wf := range wfs {
+			out = append(out, string(wf.UID))
+		}
+		return out
+	}
+	liveC := wf("c", 5)
+	liveC.Name = "live"
+	live := v1alpha1.Workflows{wf("a", 9), liveC, wf("e", 1)}
+	archived := v1alpha1.Workflows{wf("b", 7), wf("c", 5), wf("d", 3)}
+	t.Run("All", func(t *testing.T) {
+		wfs, i, j := mergeWorkflows(live, archived, 0)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, uids(wfs))
+		assert.Equal(t, 3, i)
+		assert.Equal(t, 3, j)
+		// the live duplicate is preferred
+		assert.Equal(t, "live", wfs[2].Name)
+	})
+	t.Run("Paged", func(t *testing.T) {
+		wfs, i, j := mergeWorkflows(live, archived, 3)
+		assert.Equal(t, []string{"a", "b", "c"}, uids(wfs))
+		// the archived duplicate of "c" is consumed, so it is not on the next page
+		assert.Equal(t, 2, i)
+		assert.Equal(t, 2, j)
+		wfs, i, j = mergeWorkflows(live[i:], archived[j:], 3)
+		assert.Equal(t, []string{"d", "e"}, uids(wfs))
+		assert.Equal(t, 1, i)
+		assert.Equal(t, 1, j)
+	})
+	t.Run("ContinueToken", func(t *testing.T) {
+		token, err := parseAllWorkflowsContinueToken(allWorkflowsContinueToken{Live: 2, Archived: 3}.String())
+		require.NoError(t, err)
+		assert.Equal(t, allWorkflowsContinueToken{Live: 2, Archived: 3}, token)
+		_, err = parseAllWorkflowsContinueToken("not-a-token")
+		require.Error(t, err)
+	})
+}
+
 func TestDeleteWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer(t)
 	t.Run("Labelled", func(t *testing.T) {
@@ -888,6 +951,81 @@ func TestStopWorkflow(t *testing.T) {
 	assert.Equal(t, userEmailLabel, wf.Labels[common.LabelKeyActorEmail])
 }
 
+func TestExplainWorkflow(t *testing.T) {
+	server, ctx := getWorkflowServer(t)
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := wfClient.ArgoprojV1alpha1().Workflows("workflows").Get(ctx, "hello-world-9tql2-run", metav1.GetOptions{})
+	require.NoError(t, err)
+	wf.Status.Decisions = v1alpha1.Decisions{
+		{Reason: v1alpha1.DecisionWaitingForLock, Message: "Waiting for workflows/Mutex/my-mutex lock. Lock status: 0/1"},
+		{NodeID: "hello-world-9tql2", NodeName: "hello-world-9tql2", Reason: v1alpha1.DecisionThrottled, Message: "the workflow has reached its parallelism of 1"},
+	}
+	_, err = wfClient.ArgoprojV1alpha1().Workflows("workflows").Update(ctx, wf, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	res, err := server.ExplainWorkflow(ctx, &workflowpkg.WorkflowExplainRequest{Name: wf.Name, Namespace: wf.Namespace})
+	require.NoError(t, err)
+	assert.Len(t, res.Decisions, 2)
+
+	// by display name
+	res, err = server.ExplainWorkflow(ctx, &workflowpkg.WorkflowExplainRequest{Name: wf.Name, Namespace: wf.Namespace, Node: "hello-world-9tql2-run"})
+	require.NoError(t, err)
+	require.Len(t, res.Decisions, 1)
+	assert.Equal(t, v1alpha1.DecisionThrottled, res.Decisions[0].Reason)
+
+	res, err = server.ExplainWorkflow(ctx, &workflowpkg.WorkflowExplainRequest{Name: wf.Name, Namespace: wf.Namespace, Node: "not-a-node"})
+	require.NoError(t, err)
+	assert.Empty(t, res.Decisions)
+}
+
+func TestBulkWorkflows(t *testing.T) {
+	t.Run("DryRun", func(t *testing.T) {
+		server, ctx := getWorkflowServer(t)
+		res, err := server.TerminateWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Phases: []string{"Running"}, DryRun: true})
+		require.NoError(t, err)
+		assert.True(t, res.DryRun)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "hello-world-9tql2-run", res.Results[0].Name)
+		assert.Equal(t, "Running", res.Results[0].Phase)
+		wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
+		require.NoError(t, err)
+		assert.Empty(t, wf.Spec.Shutdown)
+	})
+	t.Run("Terminate", func(t *testing.T) {
+		server, ctx := getWorkflowServer(t)
+		res, err := server.TerminateWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Phases: []string{"Running"}, Parallelism: 2})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Empty(t, res.Results[0].Error)
+		wf, err := getWorkflow(ctx, server, "workflows", "hello-world-9tql2-run")
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+	})
+	t.Run("Older", func(t *testing.T) {
+		server, ctx := getWorkflowServer(t)
+		res, err := server.StopWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Older: "1h", DryRun: true})
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Results)
+		res, err = server.StopWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Older: "invalid"})
+		require.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("InvalidParallelism", func(t *testing.T) {
+		server, ctx := getWorkflowServer(t)
+		_, err := server.SuspendWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Parallelism: -1})
+		require.Error(t, err)
+	})
+	t.Run("PerWorkflowError", func(t *testing.T) {
+		server, ctx := getWorkflowServer(t)
+		res, err := server.RetryWorkflows(ctx, &workflowpkg.WorkflowBulkRequest{Namespace: "workflows", Phases: []string{"Succeeded"}})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Results)
+		for _, result := range res.Results {
+			assert.NotEmpty(t, result.Error, result.Name)
+		}
+	})
+}
+
 func TestResubmitWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer(t)
 	t.Run("Labelled", func(t *testing.T) {
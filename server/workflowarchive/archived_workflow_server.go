@@ -51,6 +51,11 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 	if err != nil {
 		return nil, err
 	}
+	search, err := sutils.ParseQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	options = options.WithQuery(search)
 
 	// verify if we have permission to list Workflows
 	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, options.Namespace, "")
@@ -194,6 +199,62 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	return labels, nil
 }
 
+func (w *archivedWorkflowServer) GetArchivedWorkflowStats(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowStatsRequest) (*workflowarchivepkg.ArchivedWorkflowStatsResponse, error) {
+	listOptions := metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOptions = *req.ListOptions
+	}
+	options, err := sutils.BuildListOptions(listOptions, req.Namespace, "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	groupBy := sqldb.WorkflowStatsGroupBy(req.GroupBy)
+	switch groupBy {
+	case "", sqldb.WorkflowStatsGroupByNamespace, sqldb.WorkflowStatsGroupByWorkflowTemplate, sqldb.WorkflowStatsGroupByCronWorkflow:
+	case sqldb.WorkflowStatsGroupByLabel:
+		if req.LabelKey == "" {
+			return nil, status.Error(codes.InvalidArgument, "labelKey is required to group by label")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "groupBy must be one of namespace, workflowTemplate, cronWorkflow or label, not %q", req.GroupBy)
+	}
+
+	// verify if we have permission to list Workflows
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, options.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\". Maybe you want to specify a namespace with query parameter `.namespace=%s`?", options.Namespace, options.Namespace))
+	}
+
+	stats, err := w.wfArchive.GetWorkflowStats(ctx, options, groupBy, req.LabelKey)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	res := &workflowarchivepkg.ArchivedWorkflowStatsResponse{}
+	for _, s := range stats {
+		item := &workflowarchivepkg.ArchivedWorkflowStats{
+			Group:             s.Group,
+			Total:             s.Total,
+			Succeeded:         s.Succeeded,
+			Failed:            s.Failed,
+			Errored:           s.Errored,
+			P50Duration:       s.P50Duration.Seconds(),
+			P95Duration:       s.P95Duration.Seconds(),
+			ResourcesDuration: make(map[string]int64, len(s.ResourcesDuration)),
+		}
+		if s.Total > 0 {
+			item.SuccessRate = float64(s.Succeeded) / float64(s.Total)
+		}
+		for name, d := range s.ResourcesDuration {
+			item.ResourcesDuration[string(name)] = int64(d)
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
 func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 
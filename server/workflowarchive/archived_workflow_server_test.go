@@ -117,6 +117,9 @@ func Test_archivedWorkflowServer(t *testing.T) {
 	repo.On("ListWorkflowsLabelValues", mock.Anything, "my-key").Return(&v1alpha1.LabelValues{
 		Items: []string{"my-key=foo", "my-key=bar"},
 	}, nil)
+	repo.On("GetWorkflowStats", mock.Anything, sutils.ListOptions{Namespace: "user-ns"}, sqldb.WorkflowStatsGroupByLabel, "team").Return([]sqldb.WorkflowStats{
+		{Group: "data", Total: 4, Succeeded: 3, Failed: 1, P50Duration: 90 * time.Second, P95Duration: 5 * time.Minute, ResourcesDuration: v1alpha1.ResourcesDuration{apiv1.ResourceCPU: 120}},
+	}, nil)
 	repo.On("RetryWorkflow", mock.Anything, "failed-uid").Return(&v1alpha1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "failed-wf"},
 	}, nil)
@@ -239,6 +242,25 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, resp.Items)
 	})
+	t.Run("GetArchivedWorkflowStats", func(t *testing.T) {
+		_, err := w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{Namespace: "user-ns", GroupBy: "phase"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{Namespace: "user-ns", GroupBy: "label"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		resp, err := w.GetArchivedWorkflowStats(ctx, &workflowarchivepkg.GetArchivedWorkflowStatsRequest{Namespace: "user-ns", GroupBy: "label", LabelKey: "team"})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, &workflowarchivepkg.ArchivedWorkflowStats{
+			Group:             "data",
+			Total:             4,
+			Succeeded:         3,
+			Failed:            1,
+			SuccessRate:       0.75,
+			P50Duration:       90,
+			P95Duration:       300,
+			ResourcesDuration: map[string]int64{"cpu": 120},
+		}, resp.Items[0])
+	})
 	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
 		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
 		assert.Equal(t, err, status.Error(codes.AlreadyExists, "Workflow already exists on cluster, use argo retry {name} instead"))
@@ -0,0 +1,141 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"maps"
+	"regexp"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// ArtifactOpener opens the artifacts of the nodes of workflows, so that the logs archived by `archiveLogs` can be
+// read once their pods are gone.
+type ArtifactOpener interface {
+	OpenArtifact(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName string) (io.ReadCloser, error)
+}
+
+type archivedPod struct {
+	name         string
+	node         wfv1.NodeStatus
+	artifactName string
+}
+
+// archivedPods returns the pods of the workflow that no longer exist, but whose logs of the container were archived,
+// by start time. As the pods are gone, the selector is matched against the labels the controller gave them from the
+// pod metadata of the workflow and the metadata of their templates.
+func archivedPods(wf *wfv1.Workflow, req request, selector labels.Selector, container string, livePods map[string]bool, since *time.Time) []archivedPod {
+	var pods []archivedPod
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || !node.Fulfilled() || node.Outputs == nil {
+			continue
+		}
+		podName := util.GeneratePodName(wf.Name, node.Name, util.GetTemplateFromNode(node), node.ID, util.GetWorkflowPodNameVersion(wf))
+		if livePods[podName] || (req.GetPodName() != "" && req.GetPodName() != podName) {
+			continue
+		}
+		if since != nil && node.FinishedAt.Time.Before(*since) {
+			continue
+		}
+		if !selector.Matches(archivedPodLabels(wf, node)) {
+			continue
+		}
+		artifactName := container + "-logs"
+		if node.Outputs.GetArtifactByName(artifactName) == nil {
+			continue
+		}
+		pods = append(pods, archivedPod{name: podName, node: node, artifactName: artifactName})
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].node.StartedAt.Before(&pods[j].node.StartedAt)
+	})
+	return pods
+}
+
+// archivedPodLabels returns the labels the controller gave the pod of the node
+func archivedPodLabels(wf *wfv1.Workflow, node wfv1.NodeStatus) labels.Set {
+	podLabels := labels.Set{common.LabelKeyWorkflow: wf.Name, common.LabelKeyCompleted: "true"}
+	if podMetadata := wf.GetExecSpec().PodMetadata; podMetadata != nil {
+		maps.Copy(podLabels, podMetadata.Labels)
+	}
+	var tmpl *wfv1.Template
+	if node.TemplateRef != nil {
+		tmpl = wf.GetStoredTemplate(wfv1.ResourceScopeLocal, "", &node)
+	} else {
+		tmpl = wf.GetTemplateByName(node.TemplateName)
+	}
+	if tmpl != nil {
+		maps.Copy(podLabels, tmpl.Metadata.Labels)
+	}
+	return podLabels
+}
+
+// sendArchivedLogs sends the lines of the archived logs of the pod that match the grep to the entries. The lines have
+// no timestamps, so they are never prefixed with one. They are all sorted as if logged when the pod started, which
+// keeps them in order after the lines of the pods that started before.
+func sendArchivedLogs(ctx context.Context, artifacts ArtifactOpener, wf *wfv1.Workflow, pod archivedPod, logOptions *corev1.PodLogOptions, rx *regexp.Regexp, entries chan<- logEntry) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	stream, err := artifacts.OpenArtifact(ctx, wf, pod.node.ID, pod.artifactName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			logger.WithError(err).Warn(ctx, "Failed to close archived logs")
+		}
+	}()
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, startBufSize), maxTokenLength)
+	scanner.Split(scanLinesOrGiveLong)
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if logOptions.TailLines != nil && int64(len(lines)) > *logOptions.TailLines {
+			lines = lines[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if !rx.MatchString(line) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entries <- logEntry{podName: pod.name, content: line, timestamp: pod.node.StartedAt.Time}:
+		}
+	}
+	return nil
+}
+
+// logsSince returns the time since which logs are requested, if any
+func logsSince(logOptions *corev1.PodLogOptions) *time.Time {
+	switch {
+	case logOptions.SinceTime != nil:
+		return &logOptions.SinceTime.Time
+	case logOptions.SinceSeconds != nil:
+		since := time.Now().Add(-time.Duration(*logOptions.SinceSeconds) * time.Second)
+		return &since
+	default:
+		return nil
+	}
+}
+
+// logsContainer returns the container whose logs are requested
+func logsContainer(logOptions *corev1.PodLogOptions) string {
+	if logOptions.Container != "" {
+		return logOptions.Container
+	}
+	return common.MainContainerName
+}
@@ -0,0 +1,182 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var archivedLogsWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  podMetadata:
+    labels:
+      team: ml
+  templates:
+    - name: a
+    - name: b
+      metadata:
+        labels:
+          foo: bar
+status:
+  phase: Succeeded
+  nodes:
+    my-wf-4289984342:
+      id: my-wf-4289984342
+      name: my-wf[0].a
+      type: Pod
+      templateName: a
+      phase: Succeeded
+      startedAt: "2025-01-01T00:00:00Z"
+      finishedAt: "2025-01-01T00:01:00Z"
+      outputs:
+        artifacts:
+          - name: main-logs
+            s3:
+              key: my-wf/my-wf-a-4289984342/main.log
+    my-wf-1205448578:
+      id: my-wf-1205448578
+      name: my-wf[1].b
+      type: Pod
+      templateName: b
+      phase: Succeeded
+      startedAt: "2025-01-01T00:02:00Z"
+      finishedAt: "2025-01-01T00:03:00Z"
+      outputs:
+        artifacts:
+          - name: main-logs
+            s3:
+              key: my-wf/my-wf-b-1205448578/main.log
+          - name: sidecar-logs
+            s3:
+              key: my-wf/my-wf-b-1205448578/sidecar.log`
+
+type testArtifactOpener map[string]string
+
+func (o testArtifactOpener) OpenArtifact(_ context.Context, _ *wfv1.Workflow, nodeID, artifactName string) (io.ReadCloser, error) {
+	logs, ok := o[nodeID+"/"+artifactName]
+	if !ok {
+		return nil, fmt.Errorf("artifact %s of node %s not found", artifactName, nodeID)
+	}
+	return io.NopCloser(strings.NewReader(logs)), nil
+}
+
+type testSender struct {
+	entries []string
+}
+
+func (s *testSender) Send(entry *workflowpkg.LogEntry) error {
+	s.entries = append(s.entries, entry.PodName+": "+entry.Content)
+	return nil
+}
+
+func TestWorkflowLogsArchived(t *testing.T) {
+	artifacts := testArtifactOpener{
+		"my-wf-4289984342/main-logs":    "a1\na2\na3\n",
+		"my-wf-1205448578/main-logs":    "b1\nb2\n",
+		"my-wf-1205448578/sidecar-logs": "s1\n",
+	}
+	tail := int64(1)
+	tests := []struct {
+		name     string
+		req      *workflowpkg.WorkflowLogRequest
+		pods     []corev1.Pod
+		expected []string
+	}{
+		{
+			name:     "All",
+			req:      &workflowpkg.WorkflowLogRequest{},
+			expected: []string{"my-wf-a-4289984342: a1", "my-wf-a-4289984342: a2", "my-wf-a-4289984342: a3", "my-wf-b-1205448578: b1", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "Grep",
+			req:      &workflowpkg.WorkflowLogRequest{Grep: "2"},
+			expected: []string{"my-wf-a-4289984342: a2", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "Tail",
+			req:      &workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{TailLines: &tail}},
+			expected: []string{"my-wf-a-4289984342: a3", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "Since",
+			req:      &workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{SinceTime: &metav1.Time{Time: time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)}}},
+			expected: []string{"my-wf-b-1205448578: b1", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "Timestamps",
+			req:      &workflowpkg.WorkflowLogRequest{PodName: "my-wf-b-1205448578", LogOptions: &corev1.PodLogOptions{Timestamps: true}},
+			expected: []string{"my-wf-b-1205448578: b1", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "Container",
+			req:      &workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{Container: "sidecar"}},
+			expected: []string{"my-wf-b-1205448578: s1"},
+		},
+		{
+			name:     "PodName",
+			req:      &workflowpkg.WorkflowLogRequest{PodName: "my-wf-a-4289984342"},
+			expected: []string{"my-wf-a-4289984342: a1", "my-wf-a-4289984342: a2", "my-wf-a-4289984342: a3"},
+		},
+		{
+			name:     "Selector",
+			req:      &workflowpkg.WorkflowLogRequest{Selector: "foo=bar"},
+			expected: []string{"my-wf-b-1205448578: b1", "my-wf-b-1205448578: b2"},
+		},
+		{
+			name:     "WorkflowSelector",
+			req:      &workflowpkg.WorkflowLogRequest{Selector: "team=ml,foo!=bar"},
+			expected: []string{"my-wf-a-4289984342: a1", "my-wf-a-4289984342: a2", "my-wf-a-4289984342: a3"},
+		},
+		{
+			name:     "NoMatchingSelector",
+			req:      &workflowpkg.WorkflowLogRequest{Selector: "foo=baz"},
+			expected: nil,
+		},
+		{
+			name: "LivePod",
+			req:  &workflowpkg.WorkflowLogRequest{PodName: "my-wf-a-4289984342"},
+			pods: []corev1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-wf-a-4289984342", Namespace: "my-ns", Labels: map[string]string{"workflows.argoproj.io/workflow": "my-wf"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+			}},
+			// the fake client returns "fake logs" for every pod
+			expected: []string{"my-wf-a-4289984342: fake logs"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			wf := wfv1.MustUnmarshalWorkflow(archivedLogsWorkflow)
+			kubeClient := kubefake.NewSimpleClientset()
+			for _, pod := range tt.pods {
+				_, err := kubeClient.CoreV1().Pods("my-ns").Create(ctx, &pod, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			tt.req.Namespace = "my-ns"
+			tt.req.Name = "my-wf"
+			sender := &testSender{}
+			err := WorkflowLogs(ctx, fake.NewSimpleClientset(), kubeClient, artifacts, wf, tt.req, sender)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sender.entries)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
@@ -27,6 +28,7 @@ import (
 // The goal of this class is to stream the logs of the workflow you want.
 // * If you request "follow" and the workflow is not completed: logs will be tailed until the workflow is completed or context done.
 // * Otherwise, it will print recent logs and exit.
+// * The logs of pods that no longer exist are read from their archived logs, if the workflow archived them.
 
 type request interface {
 	GetNamespace() string
@@ -62,18 +64,21 @@ func scanLinesOrGiveLong(data []byte, atEOF bool) (advance int, token []byte, er
 	return maxTokenLength, data[0:maxTokenLength], nil
 }
 
-func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, req request, sender sender) error {
+// WorkflowLogs streams the logs of the pods of the workflow, which may be live or archived. The logs of pods that no
+// longer exist are read from their archived logs using the artifacts, unless it is nil.
+func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, artifacts ArtifactOpener, wf *wfv1.Workflow, req request, sender sender) error {
 	wfInterface := wfClient.ArgoprojV1alpha1().Workflows(req.GetNamespace())
-	_, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
-	if err != nil {
-		return err
-	}
 
 	rx, err := regexp.Compile(req.GetGrep())
 	if err != nil {
 		return fmt.Errorf("failed to compile %q: %w", req.GetGrep(), err)
 	}
 
+	selector, err := labels.Parse(req.GetSelector())
+	if err != nil {
+		return fmt.Errorf("failed to parse selector %q: %w", req.GetSelector(), err)
+	}
+
 	podInterface := kubeClient.CoreV1().Pods(req.GetNamespace())
 
 	ctx, logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"workflow": req.GetName(), "namespace": req.GetNamespace()}).InContext(ctx)
@@ -164,7 +169,7 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 						}
 						// You might ask - why don't we let the client do this? Well, it is because
 						// this is the same as how this works for `kubectl logs`
-						if req.GetLogOptions().Timestamps {
+						if logOptions.Timestamps {
 							content = line
 						}
 						if rx.MatchString(content) { // this means we filter the lines in the server, but will still incur the cost of retrieving them from Kubernetes
@@ -197,11 +202,28 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		return list.Items[i].Status.StartTime.Before(list.Items[j].Status.StartTime)
 	})
 
+	livePods := make(map[string]bool)
 	for _, pod := range list.Items {
+		livePods[pod.Name] = true
 		ensureWeAreStreaming(&pod)
 	}
 
-	if logOptions.Follow {
+	if artifacts != nil {
+		for _, pod := range archivedPods(wf, req, selector, logsContainer(logOptions), livePods, logsSince(logOptions)) {
+			wg.Add(1)
+			go func(pod archivedPod) {
+				defer wg.Done()
+				ctx, logger := logger.WithField("podName", pod.name).InContext(ctx)
+				logger.Debug(ctx, "Reading archived pod logs")
+				if err := sendArchivedLogs(ctx, artifacts, wf, pod, logOptions, rx, unsortedEntries); err != nil {
+					logger.WithError(err).Error(ctx, "Failed to read archived pod logs")
+				}
+			}(pod)
+		}
+	}
+
+	// an archived workflow no longer exists, so it cannot be watched
+	if logOptions.Follow && !wf.Status.Fulfilled() {
 		wfListOptions := metav1.ListOptions{FieldSelector: "metadata.name=" + req.GetName(), ResourceVersion: "0"}
 		wfWatch, err := wfInterface.Watch(ctx, wfListOptions)
 		if err != nil {
@@ -296,7 +318,8 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		entries := logEntries{}
 		// Ugly to have this func, but we use it in two places (normal operation and finishing up).
 		send := func() error {
-			sort.Sort(entries)
+			// stable, so that the lines of an archived pod, which are all sorted as if logged when it started, stay in order
+			sort.Stable(entries)
 			for len(entries) > 0 {
 				// head
 				var e logEntry
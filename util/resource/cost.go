@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const gi = 1 << 30
+
+// priceUnit is the quantity of a resource that prices are given for
+func priceUnit(name corev1.ResourceName) float64 {
+	switch name {
+	case corev1.ResourceMemory, corev1.ResourceStorage, corev1.ResourceEphemeralStorage:
+		return gi
+	default:
+		return 1
+	}
+}
+
+// Cost estimates the cost of a resources duration from the prices of the resources. Resources without a price are free.
+func Cost(d wfv1.ResourcesDuration, prices config.Prices) float64 {
+	cost := 0.0
+	for name, duration := range d {
+		units := wfv1.ResourceQuantityDenominator(name).AsApproximateFloat64() / priceUnit(name)
+		cost += duration.Duration().Hours() * units * prices[name]
+	}
+	return cost
+}
+
+// StorageCost estimates the cost of the requested storage of a persistent volume claim for a duration, from the price
+// of one Gi per hour
+func StorageCost(claim corev1.PersistentVolumeClaim, d time.Duration, price float64) float64 {
+	size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	return size.AsApproximateFloat64() / gi * d.Hours() * price
+}
+
+// NewCost returns a cost as an amount, rounded to six decimal places
+func NewCost(cost float64) *wfv1.Amount {
+	return wfv1.NewAmount(math.Round(cost*1e6) / 1e6)
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestCost(t *testing.T) {
+	prices := config.Prices{corev1.ResourceCPU: 0.04, corev1.ResourceMemory: 0.005, "nvidia.com/gpu": 2.5}
+	t.Run("Empty", func(t *testing.T) {
+		assert.Zero(t, Cost(wfv1.ResourcesDuration{}, prices))
+	})
+	t.Run("Resources", func(t *testing.T) {
+		cost := Cost(wfv1.ResourcesDuration{
+			// 2 CPUs for an hour
+			corev1.ResourceCPU: wfv1.NewResourceDuration(2 * time.Hour),
+			// 100Mi for an hour
+			corev1.ResourceMemory: wfv1.NewResourceDuration(time.Hour),
+			"nvidia.com/gpu":      wfv1.NewResourceDuration(time.Hour),
+			// no price
+			corev1.ResourceEphemeralStorage: wfv1.NewResourceDuration(time.Hour),
+		}, prices)
+		assert.InDelta(t, 0.08+0.00048828125+2.5, cost, 1e-9)
+	})
+}
+
+func TestStorageCost(t *testing.T) {
+	claim := corev1.PersistentVolumeClaim{Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+	}}}
+	assert.InDelta(t, 0.002, StorageCost(claim, 2*time.Hour, 0.0001), 1e-9)
+	assert.Zero(t, StorageCost(corev1.PersistentVolumeClaim{}, time.Hour, 0.0001))
+}
+
+func TestNewCost(t *testing.T) {
+	assert.Equal(t, "2.580488", string(NewCost(0.08+0.00048828125+2.5).Value))
+	assert.Equal(t, "0", string(NewCost(0).Value))
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	md "github.com/nao1215/markdown"
@@ -98,7 +99,7 @@ func metricsDocsLines(metrics *metricsList, attribs *attributesList) string {
 				if i != 0 {
 					buckets = fmt.Sprintf("%s, ", buckets)
 				}
-				buckets = fmt.Sprintf("%s%g", buckets, bucket)
+				buckets = fmt.Sprintf("%s%s", buckets, strconv.FormatFloat(bucket, 'f', -1, 64))
 			}
 			markdown.PlainTextf("Default bucket sizes: %s", buckets)
 		}
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/metric"
 	metricsdk "go.opentelemetry.io/otel/sdk/metric"
@@ -27,9 +28,15 @@ type Config struct {
 	Secure       bool
 	Modifiers    map[string]Modifier
 	Temporality  metricsdk.TemporalitySelector
+	// ShortLived is for processes that push their metrics when they exit, such as the executor, which neither record
+	// Go runtime metrics nor set the global meter provider
+	ShortLived bool
+	// ResourceAttributes are added to the resource of the metrics, e.g. to identify the pod that pushed them
+	ResourceAttributes []attribute.KeyValue
 }
 
 type Metrics struct {
+	provider  *metricsdk.MeterProvider
 	otelMeter *metric.Meter
 	config    *Config
 
@@ -66,7 +73,7 @@ func (m *Metrics) IterateROInstruments(fn func(i *Instrument)) {
 func NewMetrics(ctx context.Context, serviceName, prometheusName string, config *Config, extraOpts ...metricsdk.Option) (*Metrics, error) {
 	res := resource.NewWithAttributes(
 		semconv.SchemaURL,
-		semconv.ServiceName(serviceName),
+		append([]attribute.KeyValue{semconv.ServiceName(serviceName)}, config.ResourceAttributes...)...,
 	)
 
 	options := make([]metricsdk.Option, 0)
@@ -95,16 +102,19 @@ func NewMetrics(ctx context.Context, serviceName, prometheusName string, config
 	options = append(options, view(config))
 
 	provider := metricsdk.NewMeterProvider(options...)
-	otel.SetMeterProvider(provider)
+	if !config.ShortLived {
+		otel.SetMeterProvider(provider)
 
-	// Add runtime metrics
-	err := runtime.Start(runtime.WithMinimumReadMemStatsInterval(time.Second))
-	if err != nil {
-		return nil, err
+		// Add runtime metrics
+		err := runtime.Start(runtime.WithMinimumReadMemStatsInterval(time.Second))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	meter := provider.Meter(serviceName)
 	metrics := &Metrics{
+		provider:    provider,
 		otelMeter:   &meter,
 		config:      config,
 		instruments: make(map[string]*Instrument),
@@ -113,6 +123,12 @@ func NewMetrics(ctx context.Context, serviceName, prometheusName string, config
 	return metrics, nil
 }
 
+// Shutdown exports the metrics that have not been exported yet and stops the metrics, for short-lived processes that
+// push their metrics
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	return m.provider.Shutdown(ctx)
+}
+
 type AddMetric func(context.Context, *Metrics) error
 
 func (m *Metrics) Populate(ctx context.Context, adders ...AddMetric) error {
@@ -34,6 +34,54 @@ var InstrumentCronworkflowsTriggeredTotal = BuiltinInstrument{
 	},
 }
 
+var InstrumentCustomMetricLabelsDroppedTotal = BuiltinInstrument{
+	name:        "custom_metric_labels_dropped_total",
+	description: "A counter of the labels dropped from custom metrics because of the `allowedLabels` or `deniedLabels` of `customMetrics` in the metrics config",
+	unit:        "{label}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribCustomMetricName,
+		},
+		{
+			name: AttribCustomMetricLabel,
+		},
+	},
+}
+
+var InstrumentCustomMetricSeries = BuiltinInstrument{
+	name:        "custom_metric_series",
+	description: "A gauge of the number of series of custom metrics, by the namespace of the workflow that created them",
+	unit:        "{series}",
+	instType:    Int64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+}
+
+var InstrumentCustomMetricSeriesLimitedTotal = BuiltinInstrument{
+	name:        "custom_metric_series_limited_total",
+	description: "A counter of the custom metrics that would have created a series beyond the `maxSeriesPerNamespace` of `customMetrics` in the metrics config",
+	unit:        "{metric}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribCustomMetricName,
+		},
+		{
+			name: AttribCustomMetricLimitAction,
+		},
+	},
+}
+
 var InstrumentDeprecatedFeature = BuiltinInstrument{
 	name:        "deprecated_feature",
 	description: "Incidents of deprecated feature being used",
@@ -62,6 +110,90 @@ var InstrumentErrorCount = BuiltinInstrument{
 	},
 }
 
+var InstrumentExecutorArtifactBytes = BuiltinInstrument{
+	name:        "executor_artifact_bytes",
+	description: "A counter of the bytes of artifacts uploaded and downloaded by the executor",
+	unit:        "By",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribArtifactDriver,
+		},
+		{
+			name: AttribArtifactDirection,
+		},
+	},
+}
+
+var InstrumentExecutorArtifactDuration = BuiltinInstrument{
+	name:        "executor_artifact_duration",
+	description: "A histogram of the time taken by the executor to upload or download each artifact",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribArtifactDriver,
+		},
+		{
+			name: AttribArtifactDirection,
+		},
+	},
+	defaultBuckets: []float64{
+		0.100000,
+		0.500000,
+		1.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+		300.000000,
+		600.000000,
+	},
+}
+
+var InstrumentExecutorParameterSaveErrorsTotal = BuiltinInstrument{
+	name:        "executor_parameter_save_errors_total",
+	description: "A counter of the output parameters the executor failed to save",
+	unit:        "{parameter}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+}
+
+var InstrumentExecutorPodOverhead = BuiltinInstrument{
+	name:        "executor_pod_overhead",
+	description: "A histogram of the time the executor spends before and after the main containers of a pod",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribExecutorStage,
+		},
+	},
+	defaultBuckets: []float64{
+		1.000000,
+		2.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+		120.000000,
+		300.000000,
+	},
+}
+
 var InstrumentGauge = BuiltinInstrument{
 	name:        "gauge",
 	description: "A gauge of the number of workflows currently in the cluster in each phase",
@@ -141,6 +273,63 @@ var InstrumentLogMessages = BuiltinInstrument{
 	},
 }
 
+var InstrumentNodeCpuUsage = BuiltinInstrument{
+	name:        "node_cpu_usage",
+	description: "A counter of the CPU time used by the main containers of nodes",
+	unit:        "ms",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribNodeTemplate,
+		},
+	},
+}
+
+var InstrumentNodeIoBytes = BuiltinInstrument{
+	name:        "node_io_bytes",
+	description: "A counter of the bytes read from and written to block devices by the main containers of nodes",
+	unit:        "By",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribNodeTemplate,
+		},
+		{
+			name: AttribIODirection,
+		},
+	},
+}
+
+var InstrumentNodeMemoryPeak = BuiltinInstrument{
+	name:        "node_memory_peak",
+	description: "A histogram of the peak memory usage of the main containers of nodes",
+	unit:        "By",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name: AttribNodeTemplate,
+		},
+	},
+	defaultBuckets: []float64{
+		16777216.000000,
+		67108864.000000,
+		268435456.000000,
+		1073741824.000000,
+		4294967296.000000,
+		17179869184.000000,
+		68719476736.000000,
+	},
+}
+
 var InstrumentOperationDurationSeconds = BuiltinInstrument{
 	name:        "operation_duration_seconds",
 	description: "A histogram of durations of operations",
@@ -308,6 +497,22 @@ var InstrumentQueueUnfinishedWork = BuiltinInstrument{
 	},
 }
 
+var InstrumentSloMissedTotal = BuiltinInstrument{
+	name:        "slo_missed_total",
+	description: "A counter of the workflows that did not complete by the deadline of their `slo`",
+	unit:        "{workflow}",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+		{
+			name:     AttribCronWFName,
+			optional: true,
+		},
+	},
+}
+
 var InstrumentTotalCount = BuiltinInstrument{
 	name:        "total_count",
 	description: "A counter of workflows that have entered each phase for tracking them through their life-cycle, by namespace",
@@ -383,6 +588,26 @@ var InstrumentWorkflowCondition = BuiltinInstrument{
 	},
 }
 
+var InstrumentWorkflowEstimatedCost = BuiltinInstrument{
+	name:        "workflow_estimated_cost",
+	description: "A histogram of the estimated cost of completed workflows",
+	unit:        "{cost}",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+	defaultBuckets: []float64{
+		0.010000,
+		0.100000,
+		1.000000,
+		10.000000,
+		100.000000,
+		1000.000000,
+	},
+}
+
 var InstrumentWorkflowtemplateRuntime = BuiltinInstrument{
 	name:        "workflowtemplate_runtime",
 	description: "A histogram of the runtime of workflows using `workflowTemplateRef` only",
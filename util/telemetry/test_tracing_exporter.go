@@ -0,0 +1,19 @@
+package telemetry
+
+import (
+	"context"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// NewTestTracing creates a tracing that records its spans synchronously in memory, purely for use within tests.
+// This is public as it is used outside of this module also.
+func NewTestTracing(ctx context.Context) (*Tracing, *tracetest.InMemoryExporter, error) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracing, err := NewTracing(ctx, TestScopeName, &TracingConfig{}, tracesdk.WithSyncer(exporter))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tracing, exporter, nil
+}
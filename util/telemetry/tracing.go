@@ -0,0 +1,222 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// EnvVarTraceparent is the environment variable holding the W3C trace context of the span of a node, so that the code
+// running in its pod can record child spans
+const EnvVarTraceparent = "TRACEPARENT"
+
+type TracingConfig struct {
+	Enabled  bool
+	Endpoint string
+	Insecure bool
+	Headers  map[string]string
+}
+
+// Tracing records OpenTelemetry spans. A nil or disabled Tracing records nothing.
+type Tracing struct {
+	provider *tracesdk.TracerProvider
+	tracer   trace.Tracer
+}
+
+// NewTracing creates the tracing of a service, which exports spans with OTLP over gRPC if enabled by the config, or
+// by the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variable. extraOpts are for
+// tests to record spans in memory, which also enables the tracing.
+func NewTracing(ctx context.Context, serviceName string, config *TracingConfig, extraOpts ...tracesdk.TracerProviderOption) (*Tracing, error) {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpTracesEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_TRACES_ENDPOINT`)
+	if !config.Enabled && !otlpEnabled && !otlpTracesEnabled && len(extraOpts) == 0 {
+		return &Tracing{}, nil
+	}
+
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	)
+	options := []tracesdk.TracerProviderOption{
+		tracesdk.WithResource(res),
+		tracesdk.WithIDGenerator(idGenerator{}),
+	}
+	if config.Enabled || otlpEnabled || otlpTracesEnabled {
+		logging.RequireLoggerFromContext(ctx).Info(ctx, "Starting OTLP trace exporter")
+		exporterOpts := []otlptracegrpc.Option{}
+		if config.Endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpoint(config.Endpoint))
+		}
+		if config.Insecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+		if len(config.Headers) > 0 {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithHeaders(config.Headers))
+		}
+		exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, tracesdk.WithBatcher(exporter))
+	}
+	options = append(options, extraOpts...)
+
+	provider := tracesdk.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return &Tracing{
+		provider: provider,
+		tracer:   provider.Tracer(serviceName),
+	}, nil
+}
+
+// Enabled returns whether spans are recorded
+func (t *Tracing) Enabled() bool {
+	return t != nil && t.provider != nil
+}
+
+// TracerProvider returns the provider of the tracers of the tracing, for instrumentation libraries
+func (t *Tracing) TracerProvider() trace.TracerProvider {
+	if !t.Enabled() {
+		return noop.NewTracerProvider()
+	}
+	return t.provider
+}
+
+// Start starts a span as the child of the span in the context, if any
+func (t *Tracing) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !t.Enabled() {
+		return noop.NewTracerProvider().Tracer("").Start(ctx, name, opts...)
+	}
+	return t.tracer.Start(ctx, name, opts...)
+}
+
+// Span is a span that has already completed, with IDs that can be derived before it is recorded
+type Span struct {
+	Name       string
+	TraceID    trace.TraceID
+	SpanID     trace.SpanID
+	ParentID   trace.SpanID
+	StartTime  time.Time
+	EndTime    time.Time
+	Error      string
+	Failed     bool
+	Attributes []attribute.KeyValue
+}
+
+// Record records a span that has already completed
+func (t *Tracing) Record(ctx context.Context, span Span) {
+	if !t.Enabled() {
+		return
+	}
+	ctx = context.WithValue(ctx, spanIDKey{}, span.SpanID)
+	opts := []trace.SpanStartOption{trace.WithTimestamp(span.StartTime), trace.WithAttributes(span.Attributes...)}
+	if span.ParentID.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, spanContext(span.TraceID, span.ParentID))
+	} else {
+		ctx = context.WithValue(ctx, traceIDKey{}, span.TraceID)
+		opts = append(opts, trace.WithNewRoot())
+	}
+	_, s := t.tracer.Start(ctx, span.Name, opts...)
+	if span.Failed {
+		s.SetStatus(codes.Error, span.Error)
+	}
+	s.End(trace.WithTimestamp(span.EndTime))
+}
+
+// Shutdown exports the spans that have not been exported yet and stops the tracing
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if !t.Enabled() {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+// TraceID returns the trace ID derived from the key, such as the UID of a workflow
+func TraceID(key string) trace.TraceID {
+	sum := sha256.Sum256([]byte(key))
+	var id trace.TraceID
+	copy(id[:], sum[:])
+	return id
+}
+
+// SpanID returns the span ID derived from the key, such as the ID of a node
+func SpanID(key string) trace.SpanID {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	var id trace.SpanID
+	binary.BigEndian.PutUint64(id[:], max(h.Sum64(), 1))
+	return id
+}
+
+// Traceparent returns the W3C traceparent header of the span
+func Traceparent(traceID trace.TraceID, spanID trace.SpanID) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(trace.ContextWithSpanContext(context.Background(), spanContext(traceID, spanID)), carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceparent returns the context with the span of the W3C traceparent header as the parent of new spans.
+// An invalid traceparent is ignored.
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
+
+func spanContext(traceID trace.TraceID, spanID trace.SpanID) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+type (
+	traceIDKey struct{}
+	spanIDKey  struct{}
+)
+
+// idGenerator generates the IDs set in the context by Record, and random IDs otherwise
+type idGenerator struct{}
+
+func (idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	traceID, ok := ctx.Value(traceIDKey{}).(trace.TraceID)
+	if !ok {
+		for !traceID.IsValid() {
+			binary.NativeEndian.PutUint64(traceID[:8], rand.Uint64())
+			binary.NativeEndian.PutUint64(traceID[8:], rand.Uint64())
+		}
+	}
+	return traceID, idGenerator{}.NewSpanID(ctx, traceID)
+}
+
+func (idGenerator) NewSpanID(ctx context.Context, _ trace.TraceID) trace.SpanID {
+	spanID, ok := ctx.Value(spanIDKey{}).(trace.SpanID)
+	if !ok {
+		for !spanID.IsValid() {
+			binary.NativeEndian.PutUint64(spanID[:], rand.Uint64())
+		}
+	}
+	return spanID
+}
@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestTracingRecord(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tracing, exporter, err := NewTestTracing(ctx)
+	require.NoError(t, err)
+	require.True(t, tracing.Enabled())
+
+	traceID := TraceID("my-uid")
+	start := time.Now().Add(-time.Minute)
+	end := time.Now()
+	tracing.Record(ctx, Span{Name: "root", TraceID: traceID, SpanID: SpanID("root"), StartTime: start, EndTime: end})
+	tracing.Record(ctx, Span{Name: "child", TraceID: traceID, SpanID: SpanID("child"), ParentID: SpanID("root"), StartTime: start, EndTime: end, Failed: true, Error: "failed"})
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "root", spans[0].Name)
+	assert.Equal(t, traceID, spans[0].SpanContext.TraceID())
+	assert.Equal(t, SpanID("root"), spans[0].SpanContext.SpanID())
+	assert.False(t, spans[0].Parent.IsValid())
+	assert.Equal(t, start, spans[0].StartTime)
+	assert.Equal(t, end, spans[0].EndTime)
+	assert.Equal(t, "child", spans[1].Name)
+	assert.Equal(t, traceID, spans[1].SpanContext.TraceID())
+	assert.Equal(t, SpanID("child"), spans[1].SpanContext.SpanID())
+	assert.Equal(t, SpanID("root"), spans[1].Parent.SpanID())
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Equal(t, "failed", spans[1].Status.Description)
+}
+
+func TestTracingDisabled(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tracing, err := NewTracing(ctx, TestScopeName, &TracingConfig{})
+	require.NoError(t, err)
+	assert.False(t, tracing.Enabled())
+	tracing.Record(ctx, Span{Name: "root"})
+	_, span := tracing.Start(ctx, "span")
+	assert.False(t, span.SpanContext().IsValid())
+	require.NoError(t, tracing.Shutdown(ctx))
+}
+
+func TestTraceparent(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	traceID := TraceID("my-uid")
+	spanID := SpanID("my-node")
+	traceparent := Traceparent(traceID, spanID)
+	assert.Equal(t, "00-"+traceID.String()+"-"+spanID.String()+"-01", traceparent)
+
+	parent := trace.SpanContextFromContext(ContextWithTraceparent(ctx, traceparent))
+	assert.Equal(t, traceID, parent.TraceID())
+	assert.Equal(t, spanID, parent.SpanID())
+	assert.False(t, trace.SpanContextFromContext(ContextWithTraceparent(ctx, "")).IsValid())
+}
@@ -20,14 +20,15 @@ type DagContext interface {
 type TaskResult string
 
 const (
-	TaskResultSucceeded    TaskResult = "Succeeded"
-	TaskResultFailed       TaskResult = "Failed"
-	TaskResultErrored      TaskResult = "Errored"
-	TaskResultSkipped      TaskResult = "Skipped"
-	TaskResultOmitted      TaskResult = "Omitted"
-	TaskResultDaemoned     TaskResult = "Daemoned"
-	TaskResultAnySucceeded TaskResult = "AnySucceeded"
-	TaskResultAllFailed    TaskResult = "AllFailed"
+	TaskResultSucceeded          TaskResult = "Succeeded"
+	TaskResultFailed             TaskResult = "Failed"
+	TaskResultErrored            TaskResult = "Errored"
+	TaskResultSkipped            TaskResult = "Skipped"
+	TaskResultOmitted            TaskResult = "Omitted"
+	TaskResultDaemoned           TaskResult = "Daemoned"
+	TaskResultAnySucceeded       TaskResult = "AnySucceeded"
+	TaskResultAllFailed          TaskResult = "AllFailed"
+	TaskResultPartiallySucceeded TaskResult = "PartiallySucceeded"
 )
 
 var (
@@ -59,7 +60,7 @@ func GetTaskDependencies(ctx context.Context, task *wfv1.DAGTask, dctx DagContex
 		if matchGroup[2] != -1 {
 			match := depends[matchGroup[2]:matchGroup[3]]
 			split := strings.Split(match, ".")
-			if split[1] == string(TaskResultAnySucceeded) || split[1] == string(TaskResultAllFailed) {
+			if split[1] == string(TaskResultAnySucceeded) || split[1] == string(TaskResultAllFailed) || split[1] == string(TaskResultPartiallySucceeded) {
 				dependencies[split[0]] = DependencyTypeItems
 			} else if _, ok := dependencies[split[0]]; !ok { // DependencyTypeItems takes precedence
 				dependencies[split[0]] = DependencyTypeTask
@@ -98,7 +99,7 @@ func ValidateTaskResults(dagTask *wfv1.DAGTask) error {
 		split := strings.Split(matchGroup[1], ".")
 		taskName, taskResult := split[0], TaskResult(split[1])
 		switch taskResult {
-		case TaskResultSucceeded, TaskResultFailed, TaskResultSkipped, TaskResultOmitted, TaskResultErrored, TaskResultDaemoned, TaskResultAnySucceeded, TaskResultAllFailed:
+		case TaskResultSucceeded, TaskResultFailed, TaskResultSkipped, TaskResultOmitted, TaskResultErrored, TaskResultDaemoned, TaskResultAnySucceeded, TaskResultAllFailed, TaskResultPartiallySucceeded:
 			// Do nothing
 		default:
 			return fmt.Errorf("task result '%s' for task '%s' is invalid", taskResult, taskName)
@@ -144,8 +144,18 @@ const (
 	EnvVarWorkflowName = "ARGO_WORKFLOW_NAME"
 	// EnvVarWorkflowUID is the workflow UUID
 	EnvVarWorkflowUID = "ARGO_WORKFLOW_UID"
+	// EnvVarWorkflowNamespace is the namespace of the workflow
+	EnvVarWorkflowNamespace = "ARGO_WORKFLOW_NAMESPACE"
 	// EnvVarNodeID is the node ID of the node.
 	EnvVarNodeID = "ARGO_NODE_ID"
+	// EnvVarNodeName is the name of the node
+	EnvVarNodeName = "ARGO_NODE_NAME"
+	// EnvVarTemplateName is the name of the template of the node
+	EnvVarTemplateName = "ARGO_TEMPLATE_NAME"
+	// EnvVarRetryAttempt is the retry attempt of the node, starting from 0
+	EnvVarRetryAttempt = "ARGO_RETRY_ATTEMPT"
+	// EnvVarContainerLogFormat is the format the emissary writes the logs of the main containers in, "text" or "json"
+	EnvVarContainerLogFormat = "ARGO_CONTAINER_LOG_FORMAT"
 	// EnvVarPluginAddresses is a list of plugin addresses
 	EnvVarPluginAddresses = "ARGO_PLUGIN_ADDRESSES"
 	// EnvVarPluginNames is a list of plugin names
@@ -170,6 +180,9 @@ const (
 	EnvVarProgressFileTickDuration = "ARGO_PROGRESS_FILE_TICK_DURATION"
 	// EnvVarProgressFile is the file watched for reporting progress
 	EnvVarProgressFile = "ARGO_PROGRESS_FILE"
+	// EnvVarProgressPort is the port of localhost on which the wait container serves the endpoint for reporting progress.
+	// The endpoint is disabled if it is not set.
+	EnvVarProgressPort = "ARGO_PROGRESS_PORT"
 	// EnvVarDefaultRequeueTime is the default requeue time for Workflow Informers. For more info, see rate_limiters.go
 	EnvVarDefaultRequeueTime = "DEFAULT_REQUEUE_TIME"
 	// EnvVarPodStatusCaptureFinalizer is used to prevent pod garbage collected before argo captures its exit status
@@ -241,6 +254,8 @@ const (
 	LocalVarStatus = "status"
 	// LocalVarResourcesDuration is a step level variable (currently only available in metric emission) that tracks the resources duration of the step
 	LocalVarResourcesDuration = "resourcesDuration"
+	// LocalVarResourceUsage is a step level variable (currently only available in metric emission) that tracks the resource usage of the step's main containers
+	LocalVarResourceUsage = "resourceUsage"
 	// LocalVarExitCode is a step level variable (currently only available in metric emission) that tracks the step's exit code
 	LocalVarExitCode = "exitCode"
 
@@ -270,6 +285,14 @@ const (
 	// ArgoProgressPath defines the path to a file used for self reporting progress
 	ArgoProgressPath = VarRunArgoPath + "/progress"
 
+	// ArgoCheckpointPath is the tarball of the checkpoint directory, written by the main container and uploaded by
+	// the wait container
+	ArgoCheckpointPath = VarRunArgoPath + "/outputs/checkpoint.tgz"
+
+	// ArgoCheckpointRestorePath is the tarball of the last checkpoint, downloaded by the init container and restored
+	// by the main container
+	ArgoCheckpointRestorePath = VarRunArgoPath + "/inputs/checkpoint.tgz"
+
 	ConfigMapName = "workflow-controller-configmap"
 )
 
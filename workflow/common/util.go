@@ -205,7 +205,19 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 		}
 	}
 
-	return SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	newTmpl, err := SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	if err != nil || validateOnly {
+		return newTmpl, err
+	}
+	for _, inParam := range newTmpl.Inputs.Parameters {
+		if inParam.Schema == nil || inParam.Value == nil {
+			continue
+		}
+		if err := inParam.Schema.Validate(inParam.Value.String()); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s %s", inParam.Name, err.Error())
+		}
+	}
+	return newTmpl, nil
 }
 
 // substituteConfigMapKeyRefParam performs template substitution for ConfigMapKeyRef
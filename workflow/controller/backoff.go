@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// jitterBackoff randomizes the time to wait before a retry by the jitter of the backoff. The randomness is seeded by
+// the key, so that every reconciliation of the same retry waits the same time, while the retries of different nodes
+// are spread out. previous is the time waited before the previous retry, which is zero if there was none.
+func jitterBackoff(backoff *wfv1.Backoff, timeToWait, base, previous time.Duration, key string) (time.Duration, error) {
+	if backoff == nil || backoff.Jitter == "" {
+		return timeToWait, nil
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	r := rand.New(rand.NewPCG(h.Sum64(), 0))
+	between := func(lower, upper time.Duration) time.Duration {
+		if upper <= lower {
+			return lower
+		}
+		return lower + time.Duration(r.Int64N(int64(upper-lower)+1))
+	}
+	switch backoff.Jitter {
+	case wfv1.BackoffJitterFull:
+		return between(0, timeToWait), nil
+	case wfv1.BackoffJitterEqual:
+		return between(timeToWait/2, timeToWait), nil
+	case wfv1.BackoffJitterDecorrelated:
+		timeToWait = between(base, max(previous, base)*3)
+		if backoff.Cap != "" {
+			capDuration, err := wfv1.ParseStringToDuration(backoff.Cap)
+			if err != nil {
+				return 0, err
+			}
+			timeToWait = min(timeToWait, capDuration)
+		}
+		return timeToWait, nil
+	default:
+		return 0, fmt.Errorf("%s is not a valid backoff jitter", backoff.Jitter)
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestJitterBackoff(t *testing.T) {
+	for _, tt := range []struct {
+		jitter       wfv1.BackoffJitter
+		lower, upper time.Duration
+	}{
+		{"", 4 * time.Minute, 4 * time.Minute},
+		{wfv1.BackoffJitterFull, 0, 4 * time.Minute},
+		{wfv1.BackoffJitterEqual, 2 * time.Minute, 4 * time.Minute},
+		// between the duration and three times the previous wait, up to the cap
+		{wfv1.BackoffJitterDecorrelated, time.Minute, 5 * time.Minute},
+	} {
+		t.Run(string(tt.jitter), func(t *testing.T) {
+			backoff := &wfv1.Backoff{Duration: "1m", Cap: "5m", Jitter: tt.jitter}
+			waits := make(map[time.Duration]bool)
+			for _, key := range []string{"a", "b", "c", "d"} {
+				d, err := jitterBackoff(backoff, 4*time.Minute, time.Minute, 2*time.Minute, key)
+				require.NoError(t, err)
+				assert.GreaterOrEqual(t, d, tt.lower)
+				assert.LessOrEqual(t, d, tt.upper)
+				again, err := jitterBackoff(backoff, 4*time.Minute, time.Minute, 2*time.Minute, key)
+				require.NoError(t, err)
+				assert.Equal(t, d, again, "the same key must wait the same time")
+				waits[d] = true
+			}
+			if tt.jitter != "" {
+				assert.Greater(t, len(waits), 1, "different keys should wait different times")
+			}
+		})
+	}
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := jitterBackoff(&wfv1.Backoff{Jitter: "Some"}, time.Minute, time.Minute, 0, "a")
+		require.EqualError(t, err, "Some is not a valid backoff jitter")
+	})
+}
@@ -27,11 +27,15 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 	logger.WithField("config", string(bytes)).Info(ctx, "Configuration")
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = persist.ExplosiveOffloadNodeStatusRepo
+	wfc.decisionLogRepo = persist.NullDecisionLogRepo
 	wfc.wfArchive = persist.NullWorkflowArchive
 	wfc.archiveLabelSelector = labels.Everything()
 	if wfc.throttler != nil {
 		wfc.throttler.UpdateParallelism(wfc.Config.Parallelism)
 	}
+	if wfc.metrics != nil {
+		wfc.metrics.SetCustomMetricsPolicy(wfc.getCustomMetricsPolicy())
+	}
 
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
@@ -58,6 +62,12 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 		} else {
 			logger.Info(ctx, "Node status offloading is disabled")
 		}
+		if persistence.DecisionLogOffload {
+			wfc.decisionLogRepo = persist.NewDecisionLogRepo(logger, wfc.session, persistence.GetClusterName())
+			logger.Info(ctx, "Decision log offloading is enabled")
+		} else {
+			logger.Info(ctx, "Decision log offloading is disabled")
+		}
 		if persistence.Archive {
 			instanceIDService := instanceid.NewService(wfc.Config.InstanceID)
 
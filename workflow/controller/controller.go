@@ -60,6 +60,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/informer"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
 	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/gccontroller"
@@ -95,6 +96,8 @@ type WorkflowController struct {
 	artifactRepositories artifactrepositories.Interface
 	// get images
 	entrypoint entrypoint.Interface
+	// get image digests for memoization keys, which expire so that moved tags are seen
+	imageDigests entrypoint.Interface
 
 	// cliExecutorImage is the executor image as specified from the command line
 	cliExecutorImage string
@@ -130,11 +133,15 @@ type WorkflowController struct {
 	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
 	session               db.Session
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
+	decisionLogRepo       sqldb.DecisionLogRepo
+	decisionLogs          decisionLogCache
+	reconcileStats        *stats.Tracker
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
+	tracing               *telemetry.Tracing
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
@@ -148,7 +155,10 @@ type WorkflowController struct {
 	// progressFileTickDuration defines how often the progress file is read.
 	// Default is 3s and can be configured using the env var ARGO_PROGRESS_FILE_TICK_DURATION
 	progressFileTickDuration time.Duration
-	executorPlugins          map[string]map[string]*spec.Plugin // namespace -> name -> plugin
+	// progressPort is the port on which the wait container serves the endpoint for reporting progress.
+	// It is disabled by default and can be configured using the env var ARGO_PROGRESS_PORT.
+	progressPort    string
+	executorPlugins map[string]map[string]*spec.Plugin // namespace -> name -> plugin
 
 	recentCompletions recentCompletions
 	// lastUnreconciledWorkflows is a map of workflows that have been recently unreconciled
@@ -204,8 +214,10 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		workflowKeyLock:            syncpkg.NewKeyLock(),
 		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
 		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
+		reconcileStats:             stats.NewTracker(),
 		progressPatchTickDuration:  env.LookupEnvDurationOr(ctx, common.EnvVarProgressPatchTickDuration, 1*time.Minute),
 		progressFileTickDuration:   env.LookupEnvDurationOr(ctx, common.EnvVarProgressFileTickDuration, 3*time.Second),
+		progressPort:               env.LookupEnvStringOr(common.EnvVarProgressPort, ""),
 	}
 
 	if executorPlugins {
@@ -227,9 +239,16 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 	if err != nil {
 		return nil, err
 	}
+	wfc.metrics.SetCustomMetricsPolicy(wfc.getCustomMetricsPolicy())
+
+	wfc.tracing, err = telemetry.NewTracing(ctx, `workflows-controller`, wfc.getTracingConfig())
+	if err != nil {
+		return nil, err
+	}
 
 	deprecation.Initialize(wfc.metrics.DeprecatedFeature)
 	wfc.entrypoint = entrypoint.New(kubeclientset, wfc.Config.Images)
+	wfc.imageDigests = entrypoint.NewExpiring(kubeclientset, wfc.Config.Images, imageDigestTTL)
 
 	workqueue.SetProvider(wfc.metrics) // must execute SetProvider before we create the queues
 	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(ctx, &fixedItemIntervalRateLimiter{}, "workflow_queue")
@@ -393,6 +412,11 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 		go wait.JitterUntilWithContext(ctx, wfc.syncAllCacheForGC, cacheGCPeriod, 0.0, true)
 	}
 	<-ctx.Done()
+
+	// export the spans of the last reconciliations
+	if err := wfc.tracing.Shutdown(logger.NewBackgroundContext()); err != nil {
+		logger.WithError(err).Warn(ctx, "Failed to shut down tracing")
+	}
 }
 
 func (wfc *WorkflowController) RunPrometheusServer(ctx context.Context, isDummy bool) {
@@ -590,8 +614,34 @@ func (wfc *WorkflowController) workflowGarbageCollector(ctx context.Context) {
 				}
 				logger.Info(ctx, "Workflow GC finished")
 			}
+			if wfc.decisionLogRepo.IsEnabled() {
+				if err := wfc.deleteDecisionLogsOfDeletedWorkflows(ctx); err != nil {
+					logger.WithError(err).Error(ctx, "Failed to delete the decision logs of deleted workflows")
+				}
+			}
+		}
+	}
+}
+
+// deleteDecisionLogsOfDeletedWorkflows deletes the offloaded decision logs of workflows that no longer exist
+func (wfc *WorkflowController) deleteDecisionLogsOfDeletedWorkflows(ctx context.Context) error {
+	uids, err := wfc.decisionLogRepo.ListUIDs(ctx, wfc.GetManagedNamespace())
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		workflows, err := wfc.wfInformer.GetIndexer().ByIndex(indexes.UIDIndex, uid)
+		if err != nil {
+			return err
+		}
+		if len(workflows) > 0 {
+			continue
+		}
+		if err := wfc.decisionLogRepo.Delete(ctx, uid); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 func (wfc *WorkflowController) deleteOffloadedNodesForWorkflow(ctx context.Context, uid string, versions []string) error {
@@ -753,10 +803,12 @@ func (wfc *WorkflowController) processNextItem(ctx context.Context) bool {
 
 	if (!woc.GetShutdownStrategy().Enabled() || woc.GetShutdownStrategy() != wfv1.ShutdownStrategyTerminate) && !wfc.throttler.Admit(key) {
 		woc.log.WithField("key", key).Info(ctx, "Workflow processing has been postponed due to max parallelism limit")
+		woc.recordDecision(ctx, "", wfv1.DecisionThrottled, "too many workflows are already running in the cluster or namespace")
 		if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 			woc.markWorkflowPhase(ctx, wfv1.WorkflowPending, "Workflow processing has been postponed because too many workflows are already running")
-			woc.persistUpdates(ctx)
 		}
+		woc.persistUpdates(ctx)
+		woc.saveDecisions(ctx)
 		return true
 	}
 
@@ -1036,6 +1088,8 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 			wf, ok := obj.(*unstructured.Unstructured)
 			if ok { // maybe cache.DeletedFinalStateUnknown
 				wfc.metrics.DeleteRealtimeMetricsForWfUID(string(wf.GetUID()))
+				wfc.decisionLogs.delete(wf.GetUID())
+				wfc.reconcileStats.Delete(wf.GetNamespace(), wf.GetName())
 			}
 		},
 	})
@@ -1239,6 +1293,28 @@ func (wfc *WorkflowController) getMetricsServerConfig() *telemetry.Config {
 	return &metricsConfig
 }
 
+func (wfc *WorkflowController) getCustomMetricsPolicy() metrics.CustomMetricsPolicy {
+	policy := wfc.Config.MetricsConfig.CustomMetrics
+	if policy == nil {
+		return metrics.CustomMetricsPolicy{}
+	}
+	return metrics.CustomMetricsPolicy{
+		AllowedLabels:         policy.AllowedLabels,
+		DeniedLabels:          policy.DeniedLabels,
+		MaxSeriesPerNamespace: policy.MaxSeriesPerNamespace,
+		AggregateLabels:       policy.AggregateLabels,
+	}
+}
+
+func (wfc *WorkflowController) getTracingConfig() *telemetry.TracingConfig {
+	return &telemetry.TracingConfig{
+		Enabled:  wfc.Config.TracingConfig.Enabled,
+		Endpoint: wfc.Config.TracingConfig.Endpoint,
+		Insecure: wfc.Config.TracingConfig.Insecure,
+		Headers:  wfc.Config.TracingConfig.Headers,
+	}
+}
+
 func (wfc *WorkflowController) releaseAllWorkflowLocks(ctx context.Context, obj interface{}) {
 	un, ok := obj.(*unstructured.Unstructured)
 	logger := logging.RequireLoggerFromContext(ctx)
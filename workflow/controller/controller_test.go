@@ -40,6 +40,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
@@ -292,9 +293,11 @@ func newController(ctx context.Context, options ...interface{}) (context.CancelF
 		wfclientset:               wfclientset,
 		workflowKeyLock:           sync.NewKeyLock(),
 		wfArchive:                 sqldb.NullWorkflowArchive,
+		decisionLogRepo:           sqldb.NullDecisionLogRepo,
 		hydrator:                  hydratorfake.Noop,
 		estimatorFactory:          estimation.DummyEstimatorFactory,
 		eventRecorderManager:      &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(64)},
+		reconcileStats:            stats.NewTracker(),
 		archiveLabelSelector:      labels.Everything(),
 		cacheFactory:              controllercache.NewCacheFactory(kube, "default"),
 		progressPatchTickDuration: envutil.LookupEnvDurationOr(ctx, common.EnvVarProgressPatchTickDuration, 1*time.Minute),
@@ -314,6 +317,7 @@ func newController(ctx context.Context, options ...interface{}) (context.CancelF
 	{
 		wfc.metrics, testExporter, _ = metrics.CreateDefaultTestMetrics(ctx)
 		wfc.entrypoint = entrypoint.New(kube, wfc.Config.Images)
+		wfc.imageDigests = entrypoint.NewExpiring(kube, wfc.Config.Images, imageDigestTTL)
 		wfc.wfQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
 		wfc.throttler = wfc.newThrottler()
 		wfc.rateLimiter = wfc.newRateLimiter()
@@ -485,6 +489,42 @@ func withOutputs(ctx context.Context, outputs wfv1.Outputs) with {
 	}
 }
 
+// withNodeResult creates the task result of the pod, and adds it to the informer store, so that it is seen by the
+// next operation
+func withNodeResult(ctx context.Context, result wfv1.NodeResult) with {
+	return func(pod *apiv1.Pod, woc *wfOperationCtx) {
+		nodeID := woc.nodeID(pod)
+		taskResult := &wfv1.WorkflowTaskResult{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: workflow.APIVersion,
+				Kind:       workflow.WorkflowTaskResultKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeID,
+				Labels: map[string]string{
+					common.LabelKeyWorkflow:               woc.wf.Name,
+					common.LabelKeyReportOutputsCompleted: "true",
+				},
+			},
+			NodeResult: result,
+		}
+		createdTaskResult, err := woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskResults(woc.wf.Namespace).
+			Create(
+				ctx,
+				taskResult,
+				metav1.CreateOptions{},
+			)
+		if err != nil {
+			panic(err)
+		}
+		// don't wait for the informer to see it
+		err = woc.controller.taskResultInformer.GetStore().Add(createdTaskResult)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
 func withExitCode(v int32) with {
 	return func(pod *apiv1.Pod, _ *wfOperationCtx) {
 		for _, c := range pod.Spec.Containers {
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/resource"
+)
+
+// estimatePodCost estimates the cost of the pod of a node from its resources duration, or returns nil if pricing is
+// not configured
+func (woc *wfOperationCtx) estimatePodCost(pod *apiv1.Pod, resourcesDuration wfv1.ResourcesDuration) *wfv1.Amount {
+	pricing := woc.controller.Config.Pricing
+	if pricing == nil {
+		return nil
+	}
+	return resource.NewCost(resource.Cost(resourcesDuration, pricing.PodPrices(pod)))
+}
+
+// updateEstimatedCost sets the estimated cost of the workflow, and returns it. It returns false if pricing is not
+// configured.
+func (woc *wfOperationCtx) updateEstimatedCost(ctx context.Context) (float64, bool) {
+	pricing := woc.controller.Config.Pricing
+	if pricing == nil {
+		return 0, false
+	}
+	cost := woc.estimateWorkflowCost(ctx, pricing)
+	woc.wf.Status.EstimatedCost = resource.NewCost(cost)
+	return cost, true
+}
+
+// estimateWorkflowCost returns the total of the estimated costs of the pods of the workflow and the cost of its
+// persistent volume claims, which are assumed to exist from the start of the workflow until it finishes
+func (woc *wfOperationCtx) estimateWorkflowCost(ctx context.Context, pricing *config.PricingConfig) float64 {
+	cost := 0.0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.EstimatedCost == nil {
+			continue
+		}
+		nodeCost, err := node.EstimatedCost.Float64()
+		if err != nil {
+			woc.log.WithField("nodeID", node.ID).WithError(err).Warn(ctx, "invalid estimated cost")
+			continue
+		}
+		cost += nodeCost
+	}
+	if !woc.wf.Status.StartedAt.IsZero() {
+		finishedAt := woc.wf.Status.FinishedAt.Time
+		if finishedAt.IsZero() {
+			finishedAt = time.Now()
+		}
+		for _, claim := range woc.execWf.Spec.VolumeClaimTemplates {
+			if price, ok := pricing.StorageClassPrice(claim.Spec.StorageClassName); ok {
+				cost += resource.StorageCost(claim, finishedAt.Sub(woc.wf.Status.StartedAt.Time), price)
+			}
+		}
+	}
+	return cost
+}
+
+// recordEstimatedCost records the estimated cost of a completed workflow in the metrics
+func (woc *wfOperationCtx) recordEstimatedCost(ctx context.Context) {
+	if cost, ok := woc.updateEstimatedCost(ctx); ok {
+		woc.controller.metrics.RecordWorkflowCost(ctx, cost, woc.wf.Namespace)
+	}
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+var costWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: cost
+  namespace: cost
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      priorityClassName: spot
+      container:
+        image: argoproj/argosay:v2
+        resources:
+          requests:
+            cpu: "2"`
+
+func TestEstimatedCost(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// a CPU second costs 1, or 0.5 for pods of the spot priority class
+	pricing := &config.PricingConfig{
+		Resources: config.Prices{apiv1.ResourceCPU: 3600},
+		Rules: []config.PricingRule{
+			{PriorityClassName: "spot", Resources: config.Prices{apiv1.ResourceCPU: 1800}},
+		},
+		StorageClasses: map[string]float64{"fast": 0.5},
+	}
+
+	t.Run("Pods", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(costWorkflow)
+		cancel, controller := newController(ctx, wf, func(controller *WorkflowController) {
+			controller.Config.Pricing = pricing
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		waitForPodInformer(t, woc, 1)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withTerminatedContainers(0))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		require.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("cost")
+		require.NotNil(t, node)
+		require.NotNil(t, node.EstimatedCost)
+		// 2 CPUs for a second, at the spot price
+		assert.Equal(t, "1", string(node.EstimatedCost.Value))
+		require.NotNil(t, woc.wf.Status.EstimatedCost)
+		assert.Equal(t, "1", string(woc.wf.Status.EstimatedCost.Value))
+
+		attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, "cost"))
+		data, err := testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentWorkflowEstimatedCost.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), data.Count)
+		assert.InDelta(t, 1.0, data.Sum, 0.001)
+	})
+
+	t.Run("PersistentVolumeClaims", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(costWorkflow)
+		wf.Spec.VolumeClaimTemplates = []apiv1.PersistentVolumeClaim{
+			{Spec: apiv1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To("fast"),
+				Resources:        apiv1.VolumeResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceStorage: resource.MustParse("10Gi")}},
+			}},
+			// without a price
+			{Spec: apiv1.PersistentVolumeClaimSpec{
+				Resources: apiv1.VolumeResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceStorage: resource.MustParse("10Gi")}},
+			}},
+		}
+		now := time.Now()
+		wf.Status.StartedAt = metav1.NewTime(now.Add(-2 * time.Hour))
+		wf.Status.FinishedAt = metav1.NewTime(now)
+		cancel, controller := newController(ctx, wf, func(controller *WorkflowController) {
+			controller.Config.Pricing = pricing
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		cost, ok := woc.updateEstimatedCost(ctx)
+		require.True(t, ok)
+		assert.InDelta(t, 10.0, cost, 0.001)
+		assert.Equal(t, "10", string(woc.wf.Status.EstimatedCost.Value))
+	})
+
+	t.Run("NoPricing", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(costWorkflow)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		_, ok := woc.updateEstimatedCost(ctx)
+		assert.False(t, ok)
+		assert.Nil(t, woc.wf.Status.EstimatedCost)
+	})
+}
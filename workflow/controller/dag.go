@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 // dagContext holds context information about this context's DAG
@@ -228,10 +229,29 @@ func (d *dagContext) assessDAGPhase(ctx context.Context, targetTasks []string, n
 func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmplCtx *templateresolution.TemplateContext, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
 
 	node, err := woc.wf.GetNodeByName(nodeName)
-	if err != nil {
+	notStarted := err != nil
+	if notStarted {
 		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeDAG, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodeRunning, opts.nodeFlag, true)
 	}
 
+	if tmpl.DAG.TasksFrom != "" {
+		tasks, err := tmpl.DAG.ExpandTasks()
+		if err != nil {
+			woc.markNodeError(ctx, node.Name, err)
+			return node, err
+		}
+		tmpl = tmpl.DeepCopy()
+		tmpl.DAG.Tasks = tasks
+		tmpl.DAG.TasksFrom = ""
+		// the tasks cannot change once the DAG has started, so they only need validating once
+		if notStarted {
+			if err := validate.ValidateExpandedDAG(ctx, tmplCtx, woc.wf, tmpl, woc.globalParams); err != nil {
+				woc.markNodeError(ctx, node.Name, err)
+				return node, err
+			}
+		}
+	}
+
 	defer func() {
 		node, err := woc.wf.Status.Nodes.Get(node.ID)
 		if err != nil {
@@ -585,9 +605,22 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	var items *fanOut
+	if taskGroupNode != nil && hasFanOut(task.Parallelism, task.BatchSize, task.FailFastThreshold, task.SuccessThreshold) {
+		items, err = dagCtx.fanOut(ctx, newTask, expandedTasks)
+		if err != nil {
+			woc.markNodeError(ctx, taskGroupNode.Name, err)
+			return
+		}
+	}
+
 	for _, t := range expandedTasks {
 		taskNodeName := dagCtx.taskNodeName(t.Name)
 		node = dagCtx.getTaskNode(ctx, t.Name)
+		notStarted := node == nil
+		if notStarted && items != nil && !items.canStart() {
+			continue
+		}
 		if node == nil {
 			woc.log.WithFields(logging.Fields{"nodeName": taskNodeName, "dependencies": taskDependencies}).Info(ctx, "All of node dependencies completed")
 			// Add the child relationship from our dependency's outbound nodes to this node.
@@ -601,6 +634,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			}
 			if !proceed {
 				skipReason := fmt.Sprintf("when '%s' evaluated false", t.When)
+				woc.recordDecision(ctx, taskNodeName, wfv1.DecisionSkipped, skipReason)
 				woc.initializeNode(ctx, taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, skipReason)
 				continue
 			}
@@ -626,6 +660,9 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		if node == nil {
 			return
 		}
+		if notStarted && items != nil {
+			items.observe(node)
+		}
 		if node.Completed() {
 			scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 			if err != nil {
@@ -642,10 +679,18 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 
 	if taskGroupNode != nil {
+		if items != nil {
+			// the items may have changed phase while they were executed
+			items, _ = dagCtx.fanOut(ctx, newTask, expandedTasks)
+		}
 		groupPhase := wfv1.NodeSucceeded
 		for _, t := range expandedTasks {
 			// Add the child relationship from our dependency's outbound nodes to this node.
 			node := dagCtx.getTaskNode(ctx, t.Name)
+			if node == nil && items != nil && items.failFast() {
+				// the item will never be started
+				continue
+			}
 			if node == nil || !node.Fulfilled() {
 				return
 			}
@@ -653,10 +698,30 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 				groupPhase = node.Phase
 			}
 		}
+		if items != nil && items.failFast() {
+			woc.markNodePhase(ctx, taskGroupNode.Name, wfv1.NodeFailed, items.failFastMessage())
+			return
+		}
+		if items != nil && items.partiallySucceeded() {
+			woc.markNodePhase(ctx, taskGroupNode.Name, wfv1.NodeSucceeded, items.partiallySucceededMessage())
+			return
+		}
 		woc.markNodePhase(ctx, taskGroupNode.Name, groupPhase)
 	}
 }
 
+// fanOut returns the counts of the items a task was expanded into
+func (d *dagContext) fanOut(ctx context.Context, task *wfv1.DAGTask, expandedTasks []wfv1.DAGTask) (*fanOut, error) {
+	f, err := newFanOut(task.Parallelism, task.BatchSize, task.FailFastThreshold, task.SuccessThreshold, len(expandedTasks))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range expandedTasks {
+		f.observe(d.getTaskNode(ctx, t.Name))
+	}
+	return f, nil
+}
+
 func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
 	// build up the scope
 	scope := createScope(dagCtx.tmpl)
@@ -847,14 +912,15 @@ func expandTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error)
 }
 
 type TaskResults struct {
-	Succeeded    bool `json:"Succeeded"`
-	Failed       bool `json:"Failed"`
-	Errored      bool `json:"Errored"`
-	Skipped      bool `json:"Skipped"`
-	Omitted      bool `json:"Omitted"`
-	Daemoned     bool `json:"Daemoned"`
-	AnySucceeded bool `json:"AnySucceeded"`
-	AllFailed    bool `json:"AllFailed"`
+	Succeeded          bool `json:"Succeeded"`
+	Failed             bool `json:"Failed"`
+	Errored            bool `json:"Errored"`
+	Skipped            bool `json:"Skipped"`
+	Omitted            bool `json:"Omitted"`
+	Daemoned           bool `json:"Daemoned"`
+	AnySucceeded       bool `json:"AnySucceeded"`
+	AllFailed          bool `json:"AllFailed"`
+	PartiallySucceeded bool `json:"PartiallySucceeded"`
 }
 
 // evaluateDependsLogic returns whether a node should execute and proceed. proceed means that all of its dependencies are
@@ -882,6 +948,7 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 
 		anySucceeded := false
 		allFailed := false
+		anyFailed := false
 
 		if depNode.Type == wfv1.NodeTypeTaskGroup {
 
@@ -896,6 +963,7 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 				}
 				anySucceeded = anySucceeded || *childNodePhase == wfv1.NodeSucceeded
 				allFailed = allFailed && *childNodePhase == wfv1.NodeFailed
+				anyFailed = anyFailed || *childNodePhase == wfv1.NodeFailed || *childNodePhase == wfv1.NodeError
 			}
 		}
 
@@ -908,6 +976,8 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 			Daemoned:     depNode.IsDaemoned() && depNode.Phase != wfv1.NodePending,
 			AnySucceeded: anySucceeded,
 			AllFailed:    allFailed,
+			// a task group only succeeds despite failed items when it has a success threshold
+			PartiallySucceeded: depNode.Phase == wfv1.NodeSucceeded && anyFailed,
 		}
 	}
 
@@ -3731,3 +3731,240 @@ func TestDagWftmplHookWithRetry(t *testing.T) {
 	finishNode := woc.wf.Status.Nodes.FindByDisplayName("finish")
 	assert.Equal(t, wfv1.NodeOmitted, finishNode.Phase)
 }
+
+var dagTasksFromWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-tasks-from
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: generate
+            template: generate
+          - name: expand
+            depends: generate
+            template: expand
+            arguments:
+              parameters:
+                - name: tasks
+                  value: "{{tasks.generate.outputs.parameters.tasks}}"
+    - name: generate
+      container:
+        image: argoproj/argosay:v2
+      outputs:
+        parameters:
+          - name: tasks
+            valueFrom:
+              path: /tmp/tasks.json
+    - name: expand
+      inputs:
+        parameters:
+          - name: tasks
+      dag:
+        tasksFrom: "{{inputs.parameters.tasks}}"
+    - name: echo
+      inputs:
+        parameters:
+          - name: message
+      container:
+        image: argoproj/argosay:v2
+        args: [echo, "{{inputs.parameters.message}}"]`
+
+func TestDAGTasksFrom(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	run := func(t *testing.T, tasks string) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(dagTasksFromWorkflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, v1.PodSucceeded, withNodeResult(ctx, wfv1.NodeResult{
+			Phase: wfv1.NodeSucceeded,
+			Outputs: &wfv1.Outputs{
+				Parameters: []wfv1.Parameter{{Name: "tasks", Value: wfv1.AnyStringPtr(tasks)}},
+			},
+		}))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+
+	t.Run("Expanded", func(t *testing.T) {
+		controller, woc := run(t, `[
+  {"name": "a", "template": "echo", "arguments": {"parameters": [{"name": "message", "value": "a"}]}},
+  {"name": "b", "template": "echo", "depends": "a", "arguments": {"parameters": [{"name": "message", "value": "{{tasks.a.status}}"}]}}
+]`)
+		expand := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand")
+		require.NotNil(t, expand)
+		assert.Equal(t, wfv1.NodeRunning, expand.Phase)
+		a := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand.a")
+		require.NotNil(t, a)
+		assert.Equal(t, expand.ID, a.BoundaryID)
+		assert.Nil(t, woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand.b"))
+
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		b := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand.b")
+		require.NotNil(t, b)
+		assert.Equal(t, expand.ID, b.BoundaryID)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		_, woc := run(t, `[]`)
+		expand := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand")
+		require.NotNil(t, expand)
+		assert.Equal(t, wfv1.NodeSucceeded, expand.Phase)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, woc := run(t, `{"name": "a"}`)
+		expand := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand")
+		require.NotNil(t, expand)
+		assert.Equal(t, wfv1.NodeError, expand.Phase)
+		assert.Contains(t, expand.Message, "tasksFrom is not a JSON list of DAG tasks")
+	})
+	t.Run("UndefinedDependency", func(t *testing.T) {
+		_, woc := run(t, `[{"name": "a", "template": "echo", "depends": "z", "arguments": {"parameters": [{"name": "message", "value": "a"}]}}]`)
+		expand := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand")
+		require.NotNil(t, expand)
+		assert.Equal(t, wfv1.NodeError, expand.Phase)
+		assert.Contains(t, expand.Message, "invalid dependency z")
+		assert.Nil(t, woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand.a"))
+	})
+	t.Run("UndefinedTemplate", func(t *testing.T) {
+		_, woc := run(t, `[{"name": "a", "template": "missing"}]`)
+		expand := woc.wf.Status.Nodes.FindByName("dag-tasks-from.expand")
+		require.NotNil(t, expand)
+		assert.Equal(t, wfv1.NodeError, expand.Phase)
+		assert.Contains(t, expand.Message, "template name 'missing' undefined")
+	})
+}
+
+var dagFanOutWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-fan-out
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: echo
+            withSequence:
+              count: "10"
+            parallelism: 2
+            failFastThreshold: 20%
+    - name: echo
+      container:
+        image: argoproj/argosay:v2`
+
+func TestDAGTaskFanOut(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	setup := func(t *testing.T) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(dagFanOutWorkflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+	pods := func(t *testing.T, woc *wfOperationCtx) int {
+		t.Helper()
+		list, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		return len(list.Items)
+	}
+
+	t.Run("Parallelism", func(t *testing.T) {
+		controller, woc := setup(t)
+		assert.Equal(t, 2, pods(t, woc))
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, 4, pods(t, woc))
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByDisplayName("a").Phase)
+	})
+	t.Run("FailFastThreshold", func(t *testing.T) {
+		controller, woc := setup(t)
+		makePodsPhase(ctx, woc, v1.PodFailed, withExitCode(1))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, 2, pods(t, woc))
+		a := woc.wf.Status.Nodes.FindByDisplayName("a")
+		require.NotNil(t, a)
+		assert.Equal(t, wfv1.NodeFailed, a.Phase)
+		assert.Equal(t, "2 of 10 items failed or errored, reaching the fail-fast threshold of 2", a.Message)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	})
+}
+
+var dagPartiallySucceededWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-partially-succeeded
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: echo
+            withItems: [x, y, z]
+            successThreshold: 2
+          - name: b
+            template: echo-failed
+            depends: a.PartiallySucceeded
+            arguments:
+              parameters:
+                - name: failed
+                  value: "{{tasks.a.outputs.failedItems}}"
+    - name: echo
+      container:
+        image: argoproj/argosay:v2
+    - name: echo-failed
+      inputs:
+        parameters:
+          - name: failed
+      container:
+        image: argoproj/argosay:v2`
+
+// failItem fails the pod of the item with the index, and lets makePodsPhase give the others the phase
+func failItem(index int) with {
+	return func(pod *v1.Pod, woc *wfOperationCtx) {
+		node, err := woc.wf.Status.Nodes.Get(woc.nodeID(pod))
+		if err != nil || parseLoopIndex(node.Name) != index {
+			return
+		}
+		pod.Status.Phase = v1.PodFailed
+		pod.Status.Message = "Pod failed"
+		withExitCode(1)(pod, woc)
+	}
+}
+
+func TestDAGTaskPartiallySucceeded(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(dagPartiallySucceededWorkflow)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, v1.PodSucceeded, failItem(1))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	a := woc.wf.Status.Nodes.FindByDisplayName("a")
+	require.NotNil(t, a)
+	assert.Equal(t, wfv1.NodeSucceeded, a.Phase)
+	assert.Equal(t, "2 of 3 items succeeded, reaching the success threshold of 2", a.Message)
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	require.NotNil(t, b)
+	require.NotNil(t, b.Inputs)
+	assert.Equal(t, "[1]", b.Inputs.Parameters[0].Value.String())
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	envutil "github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// decisionLogLimit is the maximum number of decisions kept in the decision log of a workflow
+var decisionLogLimit = envutil.LookupEnvIntOr(logging.InitLoggerInContext(), "DECISION_LOG_LIMIT", 100)
+
+// decisionLogCache holds the offloaded decision logs in memory, so each is only read from the DB once
+type decisionLogCache struct {
+	mutex sync.Mutex
+	logs  map[types.UID]wfv1.Decisions
+}
+
+func (c *decisionLogCache) get(uid types.UID) (wfv1.Decisions, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	decisions, ok := c.logs[uid]
+	return decisions, ok
+}
+
+func (c *decisionLogCache) set(uid types.UID, decisions wfv1.Decisions) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.logs == nil {
+		c.logs = make(map[types.UID]wfv1.Decisions)
+	}
+	c.logs[uid] = decisions
+}
+
+func (c *decisionLogCache) delete(uid types.UID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.logs, uid)
+}
+
+// recordDecision records a decision of the controller not to start or run the node, or the workflow if nodeName is
+// empty, in the decision log of the workflow
+func (woc *wfOperationCtx) recordDecision(ctx context.Context, nodeName string, reason wfv1.DecisionReason, message string) {
+	decision := wfv1.Decision{Time: metav1.Now(), Reason: reason, Message: message}
+	if nodeName != "" {
+		decision.NodeID = woc.wf.NodeID(nodeName)
+		decision.NodeName = nodeName
+	}
+	if !woc.controller.decisionLogRepo.IsEnabled() {
+		if woc.wf.Status.Decisions.Add(decision, decisionLogLimit) {
+			woc.updated = true
+		}
+		return
+	}
+	if !woc.decisionsLoaded {
+		decisions, ok := woc.controller.decisionLogs.get(woc.wf.UID)
+		if !ok {
+			var err error
+			decisions, err = woc.controller.decisionLogRepo.Get(ctx, string(woc.wf.UID))
+			if err != nil {
+				woc.log.WithError(err).Warn(ctx, "Failed to get the decision log")
+				return
+			}
+		}
+		woc.decisions = append(wfv1.Decisions{}, decisions...)
+		woc.decisionsLoaded = true
+	}
+	if woc.decisions.Add(decision, decisionLogLimit) {
+		woc.decisionsUpdated = true
+	}
+}
+
+// saveDecisions saves the offloaded decision log of the workflow if it has been updated
+func (woc *wfOperationCtx) saveDecisions(ctx context.Context) {
+	if !woc.decisionsUpdated {
+		return
+	}
+	if err := woc.controller.decisionLogRepo.Save(ctx, string(woc.wf.UID), woc.wf.Namespace, woc.decisions); err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to save the decision log")
+		return
+	}
+	woc.controller.decisionLogs.set(woc.wf.UID, woc.decisions)
+	woc.decisionsUpdated = false
+}
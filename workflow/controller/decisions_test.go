@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var decisionsWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: decisions
+  namespace: argo
+  uid: decisions-uid
+spec:
+  entrypoint: main
+  parallelism: 1
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: echo
+          - name: b
+            template: echo
+          - name: c
+            template: echo
+            when: "false"
+    - name: echo
+      container:
+        image: argoproj/argosay:v2`
+
+type fakeDecisionLogRepo struct {
+	sqldb.DecisionLogRepo
+	logs map[string]wfv1.Decisions
+}
+
+func (r *fakeDecisionLogRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *fakeDecisionLogRepo) Save(_ context.Context, uid, _ string, decisions wfv1.Decisions) error {
+	r.logs[uid] = decisions
+	return nil
+}
+
+func (r *fakeDecisionLogRepo) Get(_ context.Context, uid string) (wfv1.Decisions, error) {
+	return r.logs[uid], nil
+}
+
+func assertDecisions(t *testing.T, decisions wfv1.Decisions) {
+	t.Helper()
+	reasons := make(map[string]wfv1.DecisionReason)
+	for _, decision := range decisions {
+		reasons[decision.NodeName] = decision.Reason
+	}
+	assert.Equal(t, map[string]wfv1.DecisionReason{
+		"decisions[0].b": wfv1.DecisionThrottled,
+		"decisions[0].c": wfv1.DecisionSkipped,
+	}, reasons)
+}
+
+func TestRecordDecision(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+
+	t.Run("Status", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(decisionsWorkflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		assertDecisions(t, woc.wf.Status.Decisions)
+
+		// the next operation repeats the same decisions, which are not recorded again
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Len(t, woc.wf.Status.Decisions, 2)
+	})
+
+	t.Run("Offloaded", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(decisionsWorkflow)
+		repo := &fakeDecisionLogRepo{logs: map[string]wfv1.Decisions{}}
+		cancel, controller := newController(ctx, wf, func(controller *WorkflowController) {
+			controller.decisionLogRepo = repo
+		})
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		assert.Empty(t, woc.wf.Status.Decisions)
+		assertDecisions(t, repo.logs["decisions-uid"])
+		cached, ok := controller.decisionLogs.get("decisions-uid")
+		require.True(t, ok)
+		assertDecisions(t, cached)
+	})
+}
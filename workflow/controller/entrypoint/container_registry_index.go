@@ -35,9 +35,14 @@ func (i *containerRegistryIndex) Lookup(ctx context.Context, image string, optio
 	if err != nil {
 		return nil, err
 	}
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, err
+	}
 	return &Image{
 		Entrypoint: f.Config.Entrypoint,
 		Cmd:        f.Config.Cmd,
+		Digest:     digest.String(),
 	}, nil
 }
 
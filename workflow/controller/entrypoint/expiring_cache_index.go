@@ -0,0 +1,32 @@
+package entrypoint
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// expiringCacheIndex caches images for a time-to-live, so that an image whose tag was moved is looked-up again
+type expiringCacheIndex struct {
+	cache    *cache.LRUExpireCache
+	ttl      time.Duration
+	delegate Interface
+}
+
+func (i *expiringCacheIndex) Lookup(ctx context.Context, image string, options Options) (*Image, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if v, ok := i.cache.Get(image); ok {
+		logger.WithField("image", image).Debug(ctx, "Cache hit")
+		return v.(*Image), nil
+	}
+	logger.WithField("image", image).Debug(ctx, "Cache miss")
+	v, err := i.delegate.Lookup(ctx, image, options)
+	if err != nil {
+		return nil, err
+	}
+	i.cache.Add(image, v, i.ttl)
+	return v, nil
+}
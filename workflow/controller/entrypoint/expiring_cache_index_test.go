@@ -0,0 +1,45 @@
+package entrypoint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/cache"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// digestIndex returns a new digest for each look-up
+type digestIndex []string
+
+func (i *digestIndex) Lookup(_ context.Context, image string, _ Options) (*Image, error) {
+	*i = append(*i, image)
+	return &Image{Digest: fmt.Sprintf("sha256:%d", len(*i))}, nil
+}
+
+func TestExpiringCacheIndex(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	clock := testingclock.NewFakeClock(time.Now())
+	delegate := &digestIndex{}
+	index := &expiringCacheIndex{cache.NewLRUExpireCacheWithClock(10, clock), time.Minute, delegate}
+
+	image, err := index.Lookup(ctx, "alpine:latest", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1", image.Digest)
+
+	clock.Step(30 * time.Second)
+	image, err = index.Lookup(ctx, "alpine:latest", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1", image.Digest, "cached")
+
+	clock.Step(time.Minute)
+	image, err = index.Lookup(ctx, "alpine:latest", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:2", image.Digest, "expired")
+	assert.Equal(t, []string{"alpine:latest", "alpine:latest"}, []string(*delegate))
+}
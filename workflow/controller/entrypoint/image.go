@@ -2,8 +2,10 @@ package entrypoint
 
 import (
 	"context"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/lru"
 
@@ -23,6 +25,8 @@ type Options struct {
 type Image struct {
 	Entrypoint []string
 	Cmd        []string
+	// Digest is the digest of the image, if it was looked-up in a registry
+	Digest string
 }
 
 func New(kubernetesClient kubernetes.Interface, config map[string]config.Image) Interface {
@@ -34,3 +38,15 @@ func New(kubernetesClient kubernetes.Interface, config map[string]config.Image)
 		},
 	}
 }
+
+// NewExpiring returns an index like New, except that looked-up images expire after the ttl
+func NewExpiring(kubernetesClient kubernetes.Interface, config map[string]config.Image, ttl time.Duration) Interface {
+	return &expiringCacheIndex{
+		cache.NewLRUExpireCache(1024),
+		ttl,
+		chainIndex{
+			configIndex(config),
+			&containerRegistryIndex{kubernetesClient},
+		},
+	}
+}
@@ -6,12 +6,15 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/progress"
 )
 
 // Estimator return estimations for how long workflows and nodes will take
 type Estimator interface {
 	EstimateWorkflowDuration() wfv1.EstimatedDuration
 	EstimateNodeDuration(ctx context.Context, nodeName string) wfv1.EstimatedDuration
+	// EstimateExecutableNodes returns how many executable nodes the workflow will have, or zero if not known
+	EstimateExecutableNodes() int
 }
 
 type estimator struct {
@@ -40,3 +43,10 @@ func (e *estimator) EstimateNodeDuration(ctx context.Context, nodeName string) w
 	}
 	return wfv1.NewEstimatedDuration(node.GetDuration())
 }
+
+func (e *estimator) EstimateExecutableNodes() int {
+	if e.baselineWF == nil {
+		return 0
+	}
+	return progress.ExecutableNodes(e.baselineWF.Status.Nodes)
+}
@@ -34,7 +34,7 @@ func Test_estimator(t *testing.T) {
 				Nodes: map[string]wfv1.NodeStatus{
 					"my-baseline":             {StartedAt: a, FinishedAt: b},
 					"my-baseline-873244444":   {StartedAt: a, FinishedAt: b},
-					"my-baseline-873244444.x": {StartedAt: a, FinishedAt: b},
+					"my-baseline-873244444.x": {StartedAt: a, FinishedAt: b, Type: wfv1.NodeTypePod},
 				},
 			},
 		},
@@ -42,4 +42,5 @@ func Test_estimator(t *testing.T) {
 	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateWorkflowDuration())
 	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "my-wf"))
 	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration(ctx, "1"))
+	assert.Equal(t, 1, p.EstimateExecutableNodes())
 }
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// fanOut counts the items of a task or step expanded by withItems, withParam or withSequence, in order to
+// limit how many of them run at once, to start them in batches, to stop starting them once too many have failed,
+// and to decide whether enough of them succeeded
+type fanOut struct {
+	parallelism *int64
+	batchSize   *int64
+	// failFastThreshold is the number of failed items after which no more items are started, or zero for no threshold
+	failFastThreshold int
+	// successThreshold is the number of succeeded items needed for the task to succeed, or zero for all of them
+	successThreshold int
+	total            int
+	active           int
+	failed           int
+	succeeded        int
+}
+
+// hasFanOut returns whether a task or step has any of the fields that fanOut implements
+func hasFanOut(parallelism, batchSize *int64, failFastThreshold, successThreshold *intstr.IntOrString) bool {
+	return parallelism != nil || batchSize != nil || failFastThreshold != nil || successThreshold != nil
+}
+
+func newFanOut(parallelism, batchSize *int64, failFastThreshold, successThreshold *intstr.IntOrString, total int) (*fanOut, error) {
+	f := &fanOut{parallelism: parallelism, batchSize: batchSize, total: total}
+	if failFastThreshold != nil {
+		threshold, err := intstr.GetScaledValueFromIntOrPercent(failFastThreshold, total, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid failFastThreshold: %w", err)
+		}
+		f.failFastThreshold = max(threshold, 1)
+	}
+	if successThreshold != nil {
+		threshold, err := intstr.GetScaledValueFromIntOrPercent(successThreshold, total, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid successThreshold: %w", err)
+		}
+		f.successThreshold = max(threshold, 1)
+	}
+	return f, nil
+}
+
+// observe counts the node of an item, which is nil if the item has not started
+func (f *fanOut) observe(node *wfv1.NodeStatus) {
+	switch {
+	case node == nil:
+	case !node.Fulfilled():
+		f.active++
+	case node.FailedOrError():
+		f.failed++
+	case node.Phase == wfv1.NodeSucceeded:
+		f.succeeded++
+	}
+}
+
+// canStart returns whether another item may be started
+func (f *fanOut) canStart() bool {
+	if f.failFast() {
+		return false
+	}
+	if f.batchSize != nil {
+		// items are started in order, so the next one is in a new batch when all the started ones fill whole batches
+		started := int64(f.active + f.failed + f.succeeded)
+		if started%*f.batchSize == 0 && f.active > 0 {
+			return false
+		}
+	}
+	return f.parallelism == nil || int64(f.active) < *f.parallelism
+}
+
+// failFast returns whether enough items have failed that no more are started
+func (f *fanOut) failFast() bool {
+	return f.failFastThreshold > 0 && f.failed >= f.failFastThreshold
+}
+
+// partiallySucceeded returns whether enough items succeeded for the task to succeed even though some failed
+func (f *fanOut) partiallySucceeded() bool {
+	return f.successThreshold > 0 && f.failed > 0 && f.succeeded >= f.successThreshold
+}
+
+func (f *fanOut) failFastMessage() string {
+	return fmt.Sprintf("%d of %d items failed or errored, reaching the fail-fast threshold of %d", f.failed, f.total, f.failFastThreshold)
+}
+
+func (f *fanOut) partiallySucceededMessage() string {
+	return fmt.Sprintf("%d of %d items succeeded, reaching the success threshold of %d", f.succeeded, f.total, f.successThreshold)
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestFanOutCanStart(t *testing.T) {
+	running := &wfv1.NodeStatus{Phase: wfv1.NodeRunning}
+	succeeded := &wfv1.NodeStatus{Phase: wfv1.NodeSucceeded}
+	failed := &wfv1.NodeStatus{Phase: wfv1.NodeFailed}
+	canStart := func(t *testing.T, parallelism, batchSize *int64, failFastThreshold *intstr.IntOrString, nodes ...*wfv1.NodeStatus) bool {
+		t.Helper()
+		f, err := newFanOut(parallelism, batchSize, failFastThreshold, nil, 10)
+		require.NoError(t, err)
+		for _, node := range nodes {
+			f.observe(node)
+		}
+		return f.canStart()
+	}
+
+	t.Run("Parallelism", func(t *testing.T) {
+		assert.True(t, canStart(t, ptr.To[int64](2), nil, nil, running))
+		assert.False(t, canStart(t, ptr.To[int64](2), nil, nil, running, running))
+		assert.True(t, canStart(t, ptr.To[int64](2), nil, nil, succeeded, running))
+	})
+	t.Run("BatchSize", func(t *testing.T) {
+		assert.True(t, canStart(t, nil, ptr.To[int64](2), nil))
+		assert.True(t, canStart(t, nil, ptr.To[int64](2), nil, running), "the batch is not full")
+		assert.False(t, canStart(t, nil, ptr.To[int64](2), nil, running, running), "the batch is running")
+		assert.False(t, canStart(t, nil, ptr.To[int64](2), nil, succeeded, running), "the batch is still running")
+		assert.True(t, canStart(t, nil, ptr.To[int64](2), nil, succeeded, failed), "the batch has finished")
+		assert.True(t, canStart(t, nil, ptr.To[int64](2), nil, succeeded, succeeded, running), "the next batch is not full")
+	})
+	t.Run("BatchSizeAndParallelism", func(t *testing.T) {
+		assert.False(t, canStart(t, ptr.To[int64](1), ptr.To[int64](2), nil, running), "the parallelism is reached")
+		assert.True(t, canStart(t, ptr.To[int64](1), ptr.To[int64](2), nil, succeeded), "the batch is not full")
+		assert.False(t, canStart(t, ptr.To[int64](3), ptr.To[int64](2), nil, succeeded, succeeded, running, running), "the batch is running")
+	})
+	t.Run("FailFastThreshold", func(t *testing.T) {
+		threshold := intstr.FromInt32(1)
+		assert.True(t, canStart(t, nil, nil, &threshold, succeeded))
+		assert.False(t, canStart(t, nil, nil, &threshold, failed))
+	})
+}
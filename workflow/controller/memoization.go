@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
+)
+
+// autoMemoizationKey returns the key of a template with automatic memoization, and the hashes it was computed from.
+// The components are:
+// * "template" - the resolved template, less its input values
+// * "images.<container>" - the digest of each image, or the image itself if its digest cannot be found
+// * "inputs.parameters.<name>" - the value of each input parameter
+// * "inputs.artifacts.<name>" - the checksum of each input artifact, or its location if it has no checksum
+func (woc *wfOperationCtx) autoMemoizationKey(ctx context.Context, tmpl *wfv1.Template) (string, map[string]string, error) {
+	components := make(map[string]string)
+
+	spec := tmpl.DeepCopy()
+	spec.Memoize = nil
+	for i, p := range spec.Inputs.Parameters {
+		spec.Inputs.Parameters[i] = wfv1.Parameter{Name: p.Name}
+	}
+	for i, a := range spec.Inputs.Artifacts {
+		spec.Inputs.Artifacts[i] = wfv1.Artifact{Name: a.Name, Path: a.Path, Mode: a.Mode, RecurseMode: a.RecurseMode, Optional: a.Optional}
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", nil, err
+	}
+	components["template"] = sha256Hex(data)
+
+	for name, image := range templateImages(tmpl) {
+		components["images."+name] = woc.imageDigest(ctx, image)
+	}
+	for _, p := range tmpl.Inputs.Parameters {
+		components["inputs.parameters."+p.Name] = sha256Hex([]byte(p.GetValue()))
+	}
+	for _, a := range tmpl.Inputs.Artifacts {
+		if a.Checksum != "" {
+			components["inputs.artifacts."+a.Name] = a.Checksum
+			continue
+		}
+		data, err := json.Marshal(a.ArtifactLocation)
+		if err != nil {
+			return "", nil, err
+		}
+		components["inputs.artifacts."+a.Name] = sha256Hex(data)
+	}
+
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		_, _ = fmt.Fprintf(&b, "%s=%s\n", name, components[name])
+	}
+	return sha256Hex([]byte(b.String())), components, nil
+}
+
+// templateImages returns the images of the containers of a template by container name
+func templateImages(tmpl *wfv1.Template) map[string]string {
+	images := make(map[string]string)
+	if tmpl.Container != nil {
+		images[common.MainContainerName] = tmpl.Container.Image
+	}
+	if tmpl.Script != nil {
+		images[common.MainContainerName] = tmpl.Script.Image
+	}
+	if tmpl.ContainerSet != nil {
+		for _, c := range tmpl.ContainerSet.GetContainers() {
+			images[c.Name] = c.Image
+		}
+	}
+	for _, c := range tmpl.InitContainers {
+		images[c.Name] = c.Image
+	}
+	for _, c := range tmpl.Sidecars {
+		images[c.Name] = c.Image
+	}
+	return images
+}
+
+// imageDigestTTL is how long a looked-up image digest is used for, so a tag that is moved to a different image, such
+// as "latest", results in a different memoization key soon after
+const imageDigestTTL = time.Minute
+
+// imageDigest returns the digest of an image, falling back to the image itself when the digest cannot be found,
+// e.g. because the registry cannot be reached or the image is configured in the controller's images
+func (woc *wfOperationCtx) imageDigest(ctx context.Context, image string) string {
+	if _, digest, ok := strings.Cut(image, "@"); ok {
+		return digest
+	}
+	x, err := woc.controller.imageDigests.Lookup(ctx, image, entrypoint.Options{
+		Namespace: woc.wf.Namespace, ServiceAccountName: woc.execWf.Spec.ServiceAccountName, ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
+	})
+	if err != nil {
+		woc.log.WithField("image", image).WithError(err).Warn(ctx, "Failed to look-up image digest for memoization key, using the image instead")
+		return image
+	}
+	if x == nil || x.Digest == "" {
+		return image
+	}
+	return x.Digest
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
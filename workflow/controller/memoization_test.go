@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var autoMemoizedWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: auto-memoized
+  namespace: default
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: message
+    memoize:
+      auto: true
+      cache:
+        configMap:
+          name: my-cache
+    container:
+      image: argoproj/argosay@sha256:5b1bd5c4ac9b1fd1aa1ebc9e3e3e5a5ae9e0fe36fe1d4fe6dee6b7ebe9e0a4f2
+      args: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestAutoMemoizationKey(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(autoMemoizedWorkflow)
+	woc := newWoc(ctx, *wf)
+	tmpl := func(message string) *wfv1.Template {
+		x := wf.Spec.Templates[0].DeepCopy()
+		x.Inputs.Parameters[0].Value = wfv1.AnyStringPtr(message)
+		return x
+	}
+
+	key, components, err := woc.autoMemoizationKey(ctx, tmpl("hello"))
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+	assert.Equal(t, "sha256:5b1bd5c4ac9b1fd1aa1ebc9e3e3e5a5ae9e0fe36fe1d4fe6dee6b7ebe9e0a4f2", components["images.main"])
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", components["inputs.parameters.message"])
+	assert.Contains(t, components, "template")
+
+	t.Run("SameInputs", func(t *testing.T) {
+		other, _, err := woc.autoMemoizationKey(ctx, tmpl("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, key, other)
+	})
+	t.Run("DifferentInputs", func(t *testing.T) {
+		other, otherComponents, err := woc.autoMemoizationKey(ctx, tmpl("goodbye"))
+		require.NoError(t, err)
+		assert.NotEqual(t, key, other)
+		assert.Equal(t, components["template"], otherComponents["template"])
+	})
+	t.Run("ArtifactChecksum", func(t *testing.T) {
+		x := tmpl("hello")
+		x.Inputs.Artifacts = []wfv1.Artifact{{Name: "data", Path: "/tmp/data", Checksum: "sha256:abc"}}
+		_, components, err := woc.autoMemoizationKey(ctx, x)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:abc", components["inputs.artifacts.data"])
+	})
+}
+
+func TestAutoMemoizationOperate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(autoMemoizedWorkflow)
+	woc := newWoc(ctx, *wf)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("auto-memoized")
+	require.NotNil(t, node)
+	require.NotNil(t, node.MemoizationStatus)
+	assert.False(t, node.MemoizationStatus.Hit)
+	assert.Len(t, node.MemoizationStatus.Key, 64)
+	assert.Contains(t, node.MemoizationStatus.KeyComponents, "inputs.parameters.message")
+}
+
+var autoMemoizedArtifactWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: produce
+        template: produce
+    - - name: consume
+        template: consume
+        arguments:
+          artifacts:
+          - name: data
+            from: "{{steps.produce.outputs.artifacts.data}}"
+  - name: produce
+    container:
+      image: argoproj/argosay@sha256:5b1bd5c4ac9b1fd1aa1ebc9e3e3e5a5ae9e0fe36fe1d4fe6dee6b7ebe9e0a4f2
+      command: [sh, -c]
+      args: ["echo hello > /tmp/data"]
+    outputs:
+      artifacts:
+      - name: data
+        path: /tmp/data
+  - name: consume
+    inputs:
+      artifacts:
+      - name: data
+        path: /tmp/data
+    memoize:
+      auto: true
+      cache:
+        configMap:
+          name: my-cache
+    container:
+      image: argoproj/argosay@sha256:5b1bd5c4ac9b1fd1aa1ebc9e3e3e5a5ae9e0fe36fe1d4fe6dee6b7ebe9e0a4f2
+      command: [cat, /tmp/data]
+`
+
+func TestAutoMemoizationOfArtifactFromStepNotMemoized(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+
+	run := func(name string) *wfv1.NodeStatus {
+		wf := wfv1.MustUnmarshalWorkflow(autoMemoizedArtifactWorkflow)
+		wf.Name = name
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		// each run stores the artifact in a different location, with the same content
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withNodeResult(ctx, wfv1.NodeResult{
+			Phase: wfv1.NodeSucceeded,
+			Outputs: &wfv1.Outputs{Artifacts: []wfv1.Artifact{{
+				Name:             "data",
+				Path:             "/tmp/data",
+				Checksum:         "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: name + "/data.tgz"}},
+			}}},
+		}))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		node := woc.wf.Status.Nodes.FindByDisplayName("consume")
+		require.NotNil(t, node)
+		require.NotNil(t, node.MemoizationStatus)
+		return node
+	}
+
+	first := run("first")
+	assert.False(t, first.MemoizationStatus.Hit)
+	assert.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", first.MemoizationStatus.KeyComponents["inputs.artifacts.data"])
+	second := run("second")
+	assert.True(t, second.MemoizationStatus.Hit)
+	assert.Equal(t, first.MemoizationStatus.Key, second.MemoizationStatus.Key)
+}
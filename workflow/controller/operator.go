@@ -114,6 +114,15 @@ type wfOperationCtx struct {
 	// currentStackDepth tracks the depth of the "stack", increased with every nested call to executeTemplate and decreased
 	// when such calls return. This is used to prevent infinite recursion
 	currentStackDepth int
+
+	// decisions is the offloaded decision log of the workflow, loaded on the first decision of the operation
+	decisions wfv1.Decisions
+	// decisionsLoaded indicates whether the offloaded decision log has been loaded
+	decisionsLoaded bool
+	// decisionsUpdated indicates whether the offloaded decision log needs to be saved
+	decisionsUpdated bool
+	// podCreations is the number of pods created by the operation
+	podCreations int64
 }
 
 var (
@@ -190,9 +199,14 @@ func newWorkflowOperationCtx(ctx context.Context, wf *wfv1.Workflow, wfc *Workfl
 func (woc *wfOperationCtx) operate(ctx context.Context) {
 	defer argoruntime.RecoverFromPanic(ctx, woc.log)
 
+	requests := &metrics.RequestCounter{}
+	ctx = metrics.WithRequestCounter(ctx, requests)
+	defer woc.recordReconcileStats(requests, time.Now())
+
 	defer func() {
 		woc.persistUpdates(ctx)
 	}()
+	defer woc.saveDecisions(ctx)
 	defer func() {
 		if r := recover(); r != nil {
 			woc.log.WithFields(logging.Fields{"stack": string(debug.Stack()), "r": r}).Error(ctx, "Recovered from panic")
@@ -214,6 +228,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.log.WithError(err).Error(ctx, "Unable to set ExecWorkflow")
 		return
 	}
+	defer woc.checkSLO(ctx)
 
 	if woc.wf.Status.ArtifactRepositoryRef == nil {
 		ref, err := woc.controller.artifactRepositories.Resolve(ctx, woc.execWf.Spec.ArtifactRepositoryRef, woc.wf.Namespace)
@@ -263,6 +278,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		if !acquired {
 			if !woc.releaseLocksForPendingShuttingdownWfs(ctx) {
 				woc.log.Warn(ctx, "Workflow processing has been postponed due to concurrency limit")
+				woc.recordDecision(ctx, "", wfv1.DecisionWaitingForLock, msg)
 				phase := woc.wf.Status.Phase
 				if phase == wfv1.WorkflowUnknown {
 					phase = wfv1.WorkflowPending
@@ -443,6 +459,13 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		return
 	}
 
+	if retrying, err := woc.retryWorkflow(ctx, node, failures); err != nil {
+		woc.markWorkflowError(ctx, fmt.Errorf("failed to retry workflow: %w", err))
+		return
+	} else if retrying {
+		return
+	}
+
 	var onExitNode *wfv1.NodeStatus
 	if woc.execWf.Spec.HasExitHook() {
 		woc.log.WithField("onExit", woc.execWf.Spec.OnExit).Info(ctx, "Running OnExit handler")
@@ -646,23 +669,30 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 		woc.globalParams[common.GlobalVarWorkflowParametersJSON] = string(workflowParameters)
 	}
 	for _, param := range executionParameters.Parameters {
+		var value string
 		if param.Value != nil {
-			woc.globalParams["workflow.parameters."+param.Name] = param.Value.String()
+			value = param.Value.String()
 		} else if param.ValueFrom != nil && param.ValueFrom.ConfigMapKeyRef != nil {
 			cmValue, err := common.GetConfigMapValue(woc.controller.configMapInformer.GetIndexer(), woc.wf.Namespace, param.ValueFrom.ConfigMapKeyRef.Name, param.ValueFrom.ConfigMapKeyRef.Key)
 			if err != nil {
 				if param.ValueFrom.Default != nil {
-					woc.globalParams["workflow.parameters."+param.Name] = param.ValueFrom.Default.String()
+					value = param.ValueFrom.Default.String()
 				} else {
 					return fmt.Errorf("failed to set global parameter %s from configmap with name %s and key %s: %w",
 						param.Name, param.ValueFrom.ConfigMapKeyRef.Name, param.ValueFrom.ConfigMapKeyRef.Key, err)
 				}
 			} else {
-				woc.globalParams["workflow.parameters."+param.Name] = cmValue
+				value = cmValue
 			}
 		} else {
 			return fmt.Errorf("either value or valueFrom must be specified in order to set global parameter %s", param.Name)
 		}
+		if param.Schema != nil {
+			if err := param.Schema.Validate(value); err != nil {
+				return fmt.Errorf("global parameter %s does not match its schema: %w", param.Name, err)
+			}
+		}
+		woc.globalParams["workflow.parameters."+param.Name] = value
 	}
 	if woc.wf.Status.Outputs != nil {
 		for _, param := range woc.wf.Status.Outputs.Parameters {
@@ -732,7 +762,9 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 	diff.LogChanges(ctx, woc.orig, woc.wf)
 
 	resource.UpdateResourceDurations(ctx, woc.wf)
+	woc.updateEstimatedCost(ctx)
 	progress.UpdateProgress(ctx, woc.wf)
+	progress.UpdateEstimatedFinishedAt(woc.wf, woc.estimateExecutableNodes(ctx), time.Now())
 	// You MUST not call `persistUpdates` twice.
 	// * Fails the `reapplyUpdate` cannot work unless resource versions are different.
 	// * It will double the number of Kubernetes API requests.
@@ -790,6 +822,9 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 	// Create WorkflowNode* events for nodes that have changed phase
 	woc.recordNodePhaseChangeEvents(ctx, woc.orig.Status.Nodes, woc.wf.Status.Nodes)
 
+	// Record the spans of the nodes that have completed
+	woc.recordTraces(ctx, woc.orig)
+
 	if !woc.controller.hydrator.IsHydrated(woc.wf) {
 		panic("workflow should be hydrated")
 	}
@@ -993,6 +1028,16 @@ func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.No
 		return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), true, nil
 	}
 
+	// the limit and backoff may depend on the exit code or message of the failure
+	exitCode := ""
+	if lastChildNode.Outputs != nil && lastChildNode.Outputs.ExitCode != nil {
+		exitCode = *lastChildNode.Outputs.ExitCode
+	}
+	retryStrategy, err := retryStrategy.ForFailure(exitCode, lastChildNode.Message)
+	if err != nil {
+		return nil, false, err
+	}
+
 	if woc.GetShutdownStrategy().Enabled() || (woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)) {
 		var message string
 		if woc.GetShutdownStrategy().Enabled() {
@@ -1052,6 +1097,16 @@ func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.No
 				timeToWait = capDuration
 			}
 		}
+		previous := time.Duration(0)
+		if len(childNodeIds) > 1 {
+			if previousChildNode, err := woc.wf.Status.Nodes.Get(childNodeIds[len(childNodeIds)-2]); err == nil {
+				previous = lastChildNode.StartedAt.Sub(previousChildNode.FinishedAt.Time)
+			}
+		}
+		timeToWait, err = jitterBackoff(retryStrategy.Backoff, timeToWait, baseDuration, previous, fmt.Sprintf("%s/%d", node.ID, len(childNodeIds)))
+		if err != nil {
+			return nil, false, err
+		}
 		waitingDeadline := lastChildNode.FinishedAt.Add(timeToWait)
 
 		// If the waiting deadline is after the max duration deadline, then it's futile to wait until then. Stop early
@@ -1546,6 +1601,7 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 	if new.Fulfilled() && new.FinishedAt.IsZero() {
 		new.FinishedAt = getLatestFinishedAt(pod)
 		new.ResourcesDuration = resource.DurationForPod(pod)
+		new.EstimatedCost = woc.estimatePodCost(pod, new.ResourcesDuration)
 	}
 
 	if !reflect.DeepEqual(old, new) {
@@ -2052,6 +2108,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	// Check if we took too long operating on this workflow and immediately return if we did
 	if time.Now().UTC().After(woc.deadline) {
 		woc.log.Warn(ctx, "Deadline exceeded")
+		woc.recordDecision(ctx, nodeName, wfv1.DecisionDeadlineExceeded, fmt.Sprintf("the operation of the workflow took longer than %v, the node will be executed by the next operation", maxOperationTime))
 		woc.requeue()
 		return node, ErrDeadlineExceeded
 	}
@@ -2066,7 +2123,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	}
 
 	// Check if we exceeded template or workflow parallelism and immediately return if we did
-	if err := woc.checkParallelism(ctx, processedTmpl, node, opts.boundaryID); err != nil {
+	if err := woc.checkParallelism(ctx, nodeName, processedTmpl, node, opts.boundaryID); err != nil {
 		return node, err
 	}
 
@@ -2082,6 +2139,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 				node = woc.initializeExecutableNode(ctx, nodeName, wfutil.GetNodeType(processedTmpl), templateScope, processedTmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, false, msg)
 			}
 			woc.log.WithField("lockName", failedLockName).Info(ctx, "Could not acquire lock")
+			woc.recordDecision(ctx, nodeName, wfv1.DecisionWaitingForLock, msg)
 			return woc.markNodeWaitingForLock(ctx, node.Name, failedLockName, msg)
 		} else {
 			woc.log.WithField("nodeName", nodeName).Info(ctx, "Node acquired synchronization lock")
@@ -2109,6 +2167,16 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 				return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
 			}
 
+			var keyComponents map[string]string
+			if processedTmpl.Memoize.Auto {
+				key, components, err := woc.autoMemoizationKey(ctx, processedTmpl)
+				if err != nil {
+					return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
+				}
+				processedTmpl.Memoize.Key = key
+				keyComponents = components
+			}
+
 			entry, err := memoizationCache.Load(ctx, processedTmpl.Memoize.Key)
 			if err != nil {
 				return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
@@ -2133,9 +2201,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			}
 
 			memoizationStatus := &wfv1.MemoizationStatus{
-				Hit:       hit,
-				Key:       processedTmpl.Memoize.Key,
-				CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+				Hit:           hit,
+				Key:           processedTmpl.Memoize.Key,
+				CacheName:     processedTmpl.Memoize.Cache.ConfigMap.Name,
+				KeyComponents: keyComponents,
 			}
 			if hit {
 				if node == nil {
@@ -2284,6 +2353,17 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		}
 	}
 
+	if processedTmpl.Checkpoint != nil {
+		// the checkpoint is shared by all retries of the step
+		checkpointNodeName := nodeName
+		if retryNodeName != "" {
+			checkpointNodeName = retryNodeName
+		}
+		if err := woc.addCheckpointLocation(processedTmpl, checkpointNodeName); err != nil {
+			return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
+		}
+	}
+
 	switch processedTmpl.GetType() {
 	case wfv1.TemplateTypeContainer:
 		node, err = woc.executeContainer(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
@@ -2442,6 +2522,9 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 func (woc *wfOperationCtx) recordWorkflowPhaseChange(ctx context.Context) {
 	phase := metrics.ConvertWorkflowPhase(woc.wf.Status.Phase)
 	woc.controller.metrics.ChangeWorkflowPhase(ctx, phase, woc.wf.Namespace)
+	if woc.wf.Status.Phase.Completed() {
+		woc.recordEstimatedCost(ctx)
+	}
 	if woc.wf.Spec.WorkflowTemplateRef != nil { // not-woc-misuse
 		woc.controller.metrics.CountWorkflowTemplate(ctx, phase, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, woc.wf.Spec.WorkflowTemplateRef.ClusterScope) // not-woc-misuse
 		switch woc.wf.Status.Phase {
@@ -2563,6 +2646,14 @@ func (woc *wfOperationCtx) estimateNodeDuration(ctx context.Context, nodeName st
 	return woc.getEstimator(ctx).EstimateNodeDuration(ctx, nodeName)
 }
 
+func (woc *wfOperationCtx) estimateExecutableNodes(ctx context.Context) int {
+	// without an estimated duration there is no previous run to estimate from
+	if woc.wf.Status.Phase != wfv1.WorkflowRunning || woc.wf.Status.EstimatedDuration == 0 {
+		return 0
+	}
+	return woc.getEstimator(ctx).EstimateExecutableNodes()
+}
+
 func (woc *wfOperationCtx) hasDaemonNodes() bool {
 	for _, node := range woc.wf.Status.Nodes {
 		if node.IsDaemoned() {
@@ -2963,9 +3054,10 @@ func (woc *wfOperationCtx) findLeafNodeWithType(ctx context.Context, boundaryID
 }
 
 // checkParallelism checks if the given template is able to be executed, considering the current active pods and workflow/template parallelism
-func (woc *wfOperationCtx) checkParallelism(ctx context.Context, tmpl *wfv1.Template, node *wfv1.NodeStatus, boundaryID string) error {
+func (woc *wfOperationCtx) checkParallelism(ctx context.Context, nodeName string, tmpl *wfv1.Template, node *wfv1.NodeStatus, boundaryID string) error {
 	if woc.execWf.Spec.Parallelism != nil && woc.activePods >= *woc.execWf.Spec.Parallelism {
 		woc.log.WithFields(logging.Fields{"activePods": woc.activePods, "parallelism": *woc.execWf.Spec.Parallelism}).Info(ctx, "workflow active pod spec parallelism reached")
+		woc.recordDecision(ctx, nodeName, wfv1.DecisionThrottled, fmt.Sprintf("the workflow has reached its parallelism of %d", *woc.execWf.Spec.Parallelism))
 		return ErrParallelismReached
 	}
 
@@ -2987,6 +3079,7 @@ func (woc *wfOperationCtx) checkParallelism(ctx context.Context, tmpl *wfv1.Temp
 		// Check parallelism
 		if tmpl.HasParallelism() && woc.getActivePods(node.ID) >= *tmpl.Parallelism {
 			woc.log.WithFields(logging.Fields{"node": node.ID, "parallelism": *tmpl.Parallelism}).Info(ctx, "template active children parallelism exceeded")
+			woc.recordDecision(ctx, nodeName, wfv1.DecisionThrottled, fmt.Sprintf("the template has reached its parallelism of %d", *tmpl.Parallelism))
 			return ErrParallelismReached
 		}
 	}
@@ -3023,6 +3116,7 @@ func (woc *wfOperationCtx) checkParallelism(ctx context.Context, tmpl *wfv1.Temp
 		// Check parallelism
 		if boundaryTemplate.HasParallelism() && woc.getActiveChildren(boundaryID) >= *boundaryTemplate.Parallelism {
 			woc.log.WithFields(logging.Fields{"node": boundaryID, "parallelism": *boundaryTemplate.Parallelism}).Info(ctx, "template active children parallelism exceeded")
+			woc.recordDecision(ctx, nodeName, wfv1.DecisionThrottled, fmt.Sprintf("%s has reached its parallelism of %d", boundaryNode.Name, *boundaryTemplate.Parallelism))
 			return ErrParallelismReached
 		}
 	}
@@ -3280,6 +3374,9 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 
 func (woc *wfOperationCtx) requeueIfTransientErr(ctx context.Context, err error, nodeName string) (*wfv1.NodeStatus, error) {
 	if errorsutil.IsTransientErr(ctx, err) || err == ErrResourceRateLimitReached {
+		if err == ErrResourceRateLimitReached {
+			woc.recordDecision(ctx, nodeName, wfv1.DecisionRateLimited, "the pod was not created because of the resource creation rate limit, it will be retried")
+		}
 		// Our error was most likely caused by a lack of resources.
 		woc.requeue()
 		return woc.markNodePending(ctx, nodeName, err), nil
@@ -3453,6 +3550,28 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, prefix st
 		}
 		scope.addParamToScope(key, valueListJSON)
 	}
+	// Adding the indexes of the items that succeeded and failed, e.g. for tasks with a success threshold
+	succeededItems := make([]int, 0)
+	failedItems := make([]int, 0)
+	for _, node := range childNodes {
+		if !strings.HasSuffix(node.Name, ")") {
+			// e.g. the placeholder node of an empty withParam
+			continue
+		}
+		switch {
+		case node.Phase == wfv1.NodeSucceeded:
+			succeededItems = append(succeededItems, parseLoopIndex(node.Name))
+		case node.FailedOrError():
+			failedItems = append(failedItems, parseLoopIndex(node.Name))
+		}
+	}
+	for name, items := range map[string][]int{"succeededItems": succeededItems, "failedItems": failedItems} {
+		itemsJSON, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		scope.addParamToScope(fmt.Sprintf("%s.outputs.%s", prefix, name), string(itemsJSON))
+	}
 	return nil
 }
 
@@ -3957,7 +4076,7 @@ func (woc *wfOperationCtx) computeMetrics(ctx context.Context, metricList []*wfv
 				woc.reportMetricEmissionError(ctx, fmt.Sprintf("'%s' is not available as a real time metric", value))
 				continue
 			}
-			err = woc.controller.metrics.UpsertCustomMetric(ctx, metricTmpl, string(woc.wf.UID), valueFunc)
+			err = woc.controller.metrics.UpsertCustomMetric(ctx, metricTmpl, string(woc.wf.UID), woc.wf.Namespace, valueFunc)
 			if err != nil {
 				woc.reportMetricEmissionError(ctx, fmt.Sprintf("could not construct metric '%s': %s", metricTmpl.Name, err))
 				continue
@@ -3990,7 +4109,7 @@ func (woc *wfOperationCtx) computeMetrics(ctx context.Context, metricList []*wfv
 
 			metricSpec.SetValueString(replacedStringJSON)
 
-			err = woc.controller.metrics.UpsertCustomMetric(ctx, metricSpec, string(woc.wf.UID), nil)
+			err = woc.controller.metrics.UpsertCustomMetric(ctx, metricSpec, string(woc.wf.UID), woc.wf.Namespace, nil)
 			if err != nil {
 				woc.reportMetricEmissionError(ctx, fmt.Sprintf("could not construct metric '%s': %s", metricSpec.Name, err))
 				continue
@@ -35,6 +35,7 @@ import (
 	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/strftime"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
@@ -315,6 +316,65 @@ spec:
 	assert.Equal(t, wfv1.Progress("100/100"), pod.Progress)
 }
 
+func TestResourceUsage(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	resourceUsage := []wfv1.ContainerResourceUsage{{Name: "main", CPUMilliseconds: 1500, MemoryPeakBytes: 1024}}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withNodeResult(ctx, wfv1.NodeResult{ResourceUsage: resourceUsage}))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes[woc.wf.Name]
+	assert.Equal(t, resourceUsage, node.ResourceUsage)
+
+	// the usage is only recorded when it is first set
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, woc.wf.Namespace), attribute.String(telemetry.AttribNodeTemplate, node.TemplateName))
+	cpu, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentNodeCpuUsage.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), cpu)
+	memory, err := testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentNodeMemoryPeak.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), memory.Count)
+	assert.InDelta(t, 1024.0, memory.Sum, 0.001)
+}
+
+func TestProgressDetail(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	detail := &wfv1.ProgressDetail{Percent: 50, Message: "training"}
+	makePodsPhase(ctx, woc, apiv1.PodRunning, withNodeResult(ctx, wfv1.NodeResult{Progress: detail.Progress(), ProgressDetail: detail}))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes[woc.wf.Name]
+	assert.Equal(t, wfv1.Progress("50/100"), node.Progress)
+	assert.Equal(t, detail, node.ProgressDetail)
+	assert.NotNil(t, woc.wf.Status.EstimatedFinishedAt)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	assert.Nil(t, woc.wf.Status.EstimatedFinishedAt)
+}
+
 var sidecarWithVol = `
 # Verifies sidecars can reference volumeClaimTemplates
 apiVersion: argoproj.io/v1alpha1
@@ -805,6 +865,43 @@ func TestProcessNodeRetriesWithBackoff(t *testing.T) {
 	assert.Equal(t, wfv1.NodeSucceeded, n.Phase)
 }
 
+func TestProcessNodeRetriesWithRules(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	retries := wfv1.RetryStrategy{
+		Limit:           intstrutil.ParsePtr("5"),
+		OnExitCodes:     []wfv1.ExitCodeRetryRule{{ExitCodes: []int32{2}, RetryRule: wfv1.RetryRule{Limit: intstrutil.ParsePtr("0")}}},
+		OnErrorMessages: []wfv1.ErrorMessageRetryRule{{Pattern: "OOMKilled", RetryRule: wfv1.RetryRule{Limit: intstrutil.ParsePtr("0")}}},
+	}
+	for _, tt := range []struct {
+		name     string
+		exitCode string
+		message  string
+		phase    wfv1.NodePhase
+	}{
+		{"ExitCode", "2", "", wfv1.NodeFailed},
+		{"ErrorMessage", "137", "OOMKilled (exit code 137)", wfv1.NodeFailed},
+		{"NoRule", "1", "", wfv1.NodeRunning},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cancel, controller := newController(ctx)
+			defer cancel()
+			woc := newWorkflowOperationCtx(ctx, wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+			nodeName := "test-node"
+			woc.initializeNode(ctx, nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{}, true)
+			child := woc.initializeNode(ctx, nodeName+"(0)", wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", wfv1.NodeFailed, &wfv1.NodeFlag{Retried: true}, true, tt.message)
+			child.Outputs = &wfv1.Outputs{ExitCode: &tt.exitCode}
+			woc.wf.Status.Nodes.Set(ctx, child.ID, *child)
+			woc.addChildNode(ctx, nodeName, child.Name)
+
+			n, err := woc.wf.GetNodeByName(nodeName)
+			require.NoError(t, err)
+			n, _, err = woc.processNodeRetries(ctx, n, retries, &executeTemplateOpts{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.phase, n.Phase)
+		})
+	}
+}
+
 func TestProcessNodeRetriesWithExponentialBackoff(t *testing.T) {
 	require := require.New(t)
 
@@ -7424,7 +7521,7 @@ func TestWFWithRetryAndWithParam(t *testing.T) {
 		ctrs := pods.Items[0].Spec.Containers
 		assert.Len(t, ctrs, 2)
 		envs := ctrs[1].Env
-		assert.Len(t, envs, 7)
+		assert.Len(t, envs, 13)
 		assert.Equal(t, apiv1.EnvVar{Name: "ARGO_INCLUDE_SCRIPT_OUTPUT", Value: "true"}, envs[2])
 	})
 }
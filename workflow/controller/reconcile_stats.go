@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+// recordReconcileStats adds the operation, which started at startTime and made the requests, to the reconcile
+// statistics of the workflow
+func (woc *wfOperationCtx) recordReconcileStats(requests *metrics.RequestCounter, startTime time.Time) {
+	woc.controller.reconcileStats.Record(woc.wf.Namespace, woc.wf.Name, stats.Reconciliation{
+		Duration:     time.Since(startTime),
+		APICalls:     requests.Requests.Load(),
+		PodCreations: woc.podCreations,
+		StatusBytes:  requests.WorkflowBytes.Load(),
+	})
+}
+
+// ReconcileStats writes the workflows that are the most expensive to reconcile as JSON
+func (wfc *WorkflowController) ReconcileStats(w http.ResponseWriter, r *http.Request) {
+	wfc.reconcileStats.ServeHTTP(w, r)
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/stats"
+)
+
+func TestReconcileStats(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	waitForPodInformer(t, woc, 1)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withTerminatedContainers(0))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	top := controller.reconcileStats.Top(stats.SortByDuration, 0)
+	require.Len(t, top, 1)
+	s := top[0]
+	assert.Equal(t, wf.Namespace, s.Namespace)
+	assert.Equal(t, wf.Name, s.Name)
+	assert.Equal(t, int64(2), s.Reconciliations)
+	assert.Equal(t, int64(1), s.PodCreations)
+	assert.Positive(t, s.TotalDuration)
+	assert.GreaterOrEqual(t, s.TotalDuration, s.MaxDuration)
+
+	w := httptest.NewRecorder()
+	controller.ReconcileStats(w, httptest.NewRequest(http.MethodGet, "/debug/reconcile?sort=podCreations&limit=10", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var items []stats.Stats
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+	require.Len(t, items, 1)
+	assert.Equal(t, wf.Name, items[0].Name)
+}
@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"slices"
+
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/utils/env"
 
@@ -38,3 +40,15 @@ func RetryOnDifferentHost(retryNodeName string) RetryTweak {
 		}
 	}
 }
+
+// retryAttempt returns the attempt of the node, or of the node it is in, that is a child of a retry node, or 0
+func (woc *wfOperationCtx) retryAttempt(nodeID string) int {
+	retryNode := FindRetryNode(woc.wf.Status.Nodes, nodeID)
+	if retryNode == nil {
+		return 0
+	}
+	if i := slices.Index(retryNode.Children, nodeID); i >= 0 {
+		return i
+	}
+	return max(slices.Index(retryNode.Children, woc.wf.Status.Nodes[nodeID].BoundaryID), 0)
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// sloFrom returns when the workflow was scheduled by its CronWorkflow, or otherwise created
+func (woc *wfOperationCtx) sloFrom() time.Time {
+	if scheduledTime, ok := woc.wf.Annotations[common.AnnotationKeyCronWfScheduledTime]; ok {
+		if t, err := time.Parse(time.RFC3339, scheduledTime); err == nil {
+			return t
+		}
+	}
+	return woc.wf.CreationTimestamp.Time
+}
+
+// checkSLO marks the workflow with the SLOMissed condition once it has not completed by the deadline of its SLO, and
+// otherwise requeues it for the deadline
+func (woc *wfOperationCtx) checkSLO(ctx context.Context) {
+	slo := woc.execWf.Spec.SLO
+	if slo == nil {
+		return
+	}
+	for _, condition := range woc.wf.Status.Conditions {
+		if condition.Type == wfv1.ConditionTypeSLOMissed {
+			return
+		}
+	}
+	deadline, err := slo.Deadline(woc.sloFrom())
+	if err != nil {
+		woc.log.WithError(err).Warn(ctx, "Invalid SLO")
+		return
+	}
+	completedAt := time.Now()
+	if woc.wf.Status.Fulfilled() {
+		completedAt = woc.wf.Status.FinishedAt.Time
+	}
+	if !completedAt.After(deadline) {
+		if !woc.wf.Status.Fulfilled() {
+			woc.requeueAfter(time.Until(deadline))
+		}
+		return
+	}
+	message := fmt.Sprintf("Workflow did not complete by the deadline of its SLO, %s", deadline.UTC().Format(time.RFC3339))
+	woc.log.WithField("deadline", deadline).Info(ctx, "Workflow missed its SLO")
+	woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: wfv1.ConditionTypeSLOMissed, Status: metav1.ConditionTrue, Message: message})
+	woc.updated = true
+	woc.controller.metrics.SLOMissed(ctx, woc.wf.Namespace, woc.wf.Labels[common.LabelKeyCronWorkflow])
+	woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "WorkflowSLOMissed", message)
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var sloWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: slo
+  namespace: argo
+  labels:
+    workflows.argoproj.io/cron-workflow: nightly-slo
+spec:
+  entrypoint: main
+  slo:
+    maxDuration: 1h
+  templates:
+    - name: main
+      container:
+        image: argoproj/argosay:v2`
+
+// sloMissedEvents returns the WorkflowSLOMissed events that have been recorded
+func sloMissedEvents(controller *WorkflowController) []string {
+	var events []string
+	for {
+		select {
+		case event := <-controller.eventRecorderManager.(*testEventRecorderManager).eventRecorder.Events:
+			if strings.Contains(event, "WorkflowSLOMissed") {
+				events = append(events, event)
+			}
+		default:
+			return events
+		}
+	}
+}
+
+func TestCheckSLO(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	setup := func(t *testing.T, scheduledAt time.Time) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(sloWorkflow)
+		wf.Annotations = map[string]string{common.AnnotationKeyCronWfScheduledTime: scheduledAt.Format(time.RFC3339)}
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+	missed := func(woc *wfOperationCtx) *wfv1.Condition {
+		for _, condition := range woc.wf.Status.Conditions {
+			if condition.Type == wfv1.ConditionTypeSLOMissed {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	t.Run("Met", func(t *testing.T) {
+		controller, woc := setup(t, time.Now())
+		waitForPodInformer(t, woc, 1)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		require.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		assert.Nil(t, missed(woc))
+		assert.Empty(t, sloMissedEvents(controller))
+	})
+
+	t.Run("MissedWhileRunning", func(t *testing.T) {
+		controller, woc := setup(t, time.Now().Add(-2*time.Hour))
+		require.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		condition := missed(woc)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Contains(t, condition.Message, "Workflow did not complete by the deadline of its SLO")
+		assert.Len(t, sloMissedEvents(controller), 1)
+
+		attribs := attribute.NewSet(
+			attribute.String(telemetry.AttribWorkflowNamespace, "argo"),
+			attribute.String(telemetry.AttribCronWFName, "nightly-slo"),
+		)
+		val, err := testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentSloMissedTotal.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), val)
+
+		// the workflow is only marked once
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Empty(t, sloMissedEvents(controller))
+		val, err = testExporter.GetInt64CounterValue(ctx, telemetry.InstrumentSloMissedTotal.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), val)
+	})
+}
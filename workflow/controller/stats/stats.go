@@ -0,0 +1,150 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Sort keys of the reconcile statistics
+const (
+	SortByDuration        = "duration"
+	SortByMaxDuration     = "maxDuration"
+	SortByReconciliations = "reconciliations"
+	SortByAPICalls        = "apiCalls"
+	SortByPodCreations    = "podCreations"
+	SortByStatusBytes     = "statusBytes"
+)
+
+// SortKeys are the keys the reconcile statistics can be sorted by
+var SortKeys = []string{SortByDuration, SortByMaxDuration, SortByReconciliations, SortByAPICalls, SortByPodCreations, SortByStatusBytes}
+
+// Reconciliation is the cost of one reconciliation of a workflow
+type Reconciliation struct {
+	Duration     time.Duration
+	APICalls     int64
+	PodCreations int64
+	StatusBytes  int64
+}
+
+// Stats are the totals of the reconciliations of a workflow since the controller started
+type Stats struct {
+	Namespace       string        `json:"namespace"`
+	Name            string        `json:"name"`
+	Reconciliations int64         `json:"reconciliations"`
+	TotalDuration   time.Duration `json:"totalDuration"`
+	MaxDuration     time.Duration `json:"maxDuration"`
+	LastDuration    time.Duration `json:"lastDuration"`
+	APICalls        int64         `json:"apiCalls"`
+	PodCreations    int64         `json:"podCreations"`
+	StatusBytes     int64         `json:"statusBytes"`
+	LastReconciled  time.Time     `json:"lastReconciled"`
+}
+
+func (s Stats) value(sortBy string) int64 {
+	switch sortBy {
+	case SortByMaxDuration:
+		return int64(s.MaxDuration)
+	case SortByReconciliations:
+		return s.Reconciliations
+	case SortByAPICalls:
+		return s.APICalls
+	case SortByPodCreations:
+		return s.PodCreations
+	case SortByStatusBytes:
+		return s.StatusBytes
+	default:
+		return int64(s.TotalDuration)
+	}
+}
+
+// Tracker tracks the reconcile statistics of each workflow
+type Tracker struct {
+	mutex sync.Mutex
+	stats map[string]*Stats
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{stats: make(map[string]*Stats)}
+}
+
+func key(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// Record adds a reconciliation of the workflow to its statistics
+func (t *Tracker) Record(namespace, name string, r Reconciliation) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	s, ok := t.stats[key(namespace, name)]
+	if !ok {
+		s = &Stats{Namespace: namespace, Name: name}
+		t.stats[key(namespace, name)] = s
+	}
+	s.Reconciliations++
+	s.TotalDuration += r.Duration
+	s.MaxDuration = max(s.MaxDuration, r.Duration)
+	s.LastDuration = r.Duration
+	s.APICalls += r.APICalls
+	s.PodCreations += r.PodCreations
+	s.StatusBytes += r.StatusBytes
+	s.LastReconciled = time.Now()
+}
+
+// Delete forgets the statistics of the workflow
+func (t *Tracker) Delete(namespace, name string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.stats, key(namespace, name))
+}
+
+// Top returns the statistics of the limit workflows with the highest value of sortBy, or of all workflows if limit is
+// not positive
+func (t *Tracker) Top(sortBy string, limit int) []Stats {
+	t.mutex.Lock()
+	items := make([]Stats, 0, len(t.stats))
+	for _, s := range t.stats {
+		items = append(items, *s)
+	}
+	t.mutex.Unlock()
+	sort.Slice(items, func(i, j int) bool {
+		vi, vj := items[i].value(sortBy), items[j].value(sortBy)
+		if vi != vj {
+			return vi > vj
+		}
+		return key(items[i].Namespace, items[i].Name) < key(items[j].Namespace, items[j].Name)
+	})
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
+
+// ServeHTTP writes the top workflows as JSON, sorted by the `sort` query parameter and limited to the `limit` query
+// parameter
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sortBy := r.URL.Query().Get("sort")
+	if sortBy == "" {
+		sortBy = SortByDuration
+	}
+	if !slices.Contains(SortKeys, sortBy) {
+		http.Error(w, fmt.Sprintf("invalid sort %q, must be one of %v", sortBy, SortKeys), http.StatusBadRequest)
+		return
+	}
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit %q", v), http.StatusBadRequest)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(t.Top(sortBy, limit))
+}
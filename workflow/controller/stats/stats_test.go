@@ -0,0 +1,63 @@
+package stats
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker()
+	tracker.Record("argo", "slow", Reconciliation{Duration: 3 * time.Second, APICalls: 2, StatusBytes: 100})
+	tracker.Record("argo", "fast", Reconciliation{Duration: time.Second, APICalls: 5, PodCreations: 2})
+	tracker.Record("argo", "fast", Reconciliation{Duration: 500 * time.Millisecond, APICalls: 5, StatusBytes: 10})
+	tracker.Record("default", "deleted", Reconciliation{Duration: time.Minute})
+	tracker.Delete("default", "deleted")
+
+	t.Run("Record", func(t *testing.T) {
+		top := tracker.Top(SortByReconciliations, 1)
+		require.Len(t, top, 1)
+		s := top[0]
+		assert.Equal(t, "fast", s.Name)
+		assert.Equal(t, int64(2), s.Reconciliations)
+		assert.Equal(t, 1500*time.Millisecond, s.TotalDuration)
+		assert.Equal(t, time.Second, s.MaxDuration)
+		assert.Equal(t, 500*time.Millisecond, s.LastDuration)
+		assert.Equal(t, int64(10), s.APICalls)
+		assert.Equal(t, int64(2), s.PodCreations)
+		assert.Equal(t, int64(10), s.StatusBytes)
+		assert.False(t, s.LastReconciled.IsZero())
+	})
+
+	t.Run("Top", func(t *testing.T) {
+		names := func(items []Stats) []string {
+			var names []string
+			for _, s := range items {
+				names = append(names, s.Name)
+			}
+			return names
+		}
+		assert.Equal(t, []string{"slow", "fast"}, names(tracker.Top(SortByDuration, 0)))
+		assert.Equal(t, []string{"fast", "slow"}, names(tracker.Top(SortByAPICalls, 0)))
+		assert.Equal(t, []string{"slow", "fast"}, names(tracker.Top(SortByStatusBytes, 5)))
+	})
+
+	t.Run("ServeHTTP", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		tracker.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/reconcile?sort=podCreations&limit=1", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var items []Stats
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+		require.Len(t, items, 1)
+		assert.Equal(t, "fast", items[0].Name)
+
+		w = httptest.NewRecorder()
+		tracker.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/reconcile?sort=foo", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
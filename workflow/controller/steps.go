@@ -251,6 +251,14 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		return woc.markNodeError(ctx, sgNodeName, err), nil
 	}
 
+	// The items of expanded steps with a parallelism or threshold, by step name
+	fanOuts, err := woc.stepFanOuts(sgNodeName, stepGroup)
+	if err != nil {
+		return woc.markNodeError(ctx, sgNodeName, err), nil
+	}
+	// Whether some items have not been started because of their step's parallelism
+	waiting := false
+
 	// Maps nodes to their steps
 	nodeSteps := make(map[string]wfv1.WorkflowStep)
 
@@ -273,12 +281,21 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 			if _, err := woc.wf.GetNodeByName(childNodeName); err != nil {
 				skipReason := fmt.Sprintf("when '%s' evaluated false", step.When)
 				woc.log.WithFields(logging.Fields{"childNodeName": childNodeName, "skipReason": skipReason}).Info(ctx, "Skipping")
+				woc.recordDecision(ctx, childNodeName, wfv1.DecisionSkipped, skipReason)
 				woc.initializeNode(ctx, childNodeName, wfv1.NodeTypeSkipped, stepTemplateScope, &step, stepsCtx.boundaryID, wfv1.NodeSkipped, &wfv1.NodeFlag{}, true, skipReason)
 				woc.addChildNode(ctx, sgNodeName, childNodeName)
 			}
 			continue
 		}
 
+		items := fanOuts[stepNameOf(step)]
+		_, err = woc.wf.GetNodeByName(childNodeName)
+		notStarted := err != nil
+		if notStarted && items != nil && !items.canStart() {
+			waiting = waiting || !items.failFast()
+			continue
+		}
+
 		if stepsCtx.boundaryID == "" {
 			woc.log.Warn(ctx, "boundaryID was nil")
 		}
@@ -299,6 +316,9 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		if childNode != nil {
 			nodeSteps[childNodeName] = step
 			woc.addChildNode(ctx, sgNodeName, childNodeName)
+			if notStarted && items != nil {
+				items.observe(childNode)
+			}
 		}
 	}
 
@@ -340,7 +360,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 			}
 		}
 	}
-	if !completed {
+	if !completed || waiting {
 		if node.Fulfilled() {
 			return woc.markNodePhase(ctx, sgNodeName, wfv1.NodeRunning), nil
 		}
@@ -350,12 +370,27 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	woc.addOutputsToGlobalScope(ctx, node.Outputs)
 
 	// All children completed. Determine step group status as a whole
+	fanOuts, err = woc.stepFanOuts(sgNodeName, stepGroup)
+	if err != nil {
+		return woc.markNodeError(ctx, sgNodeName, err), nil
+	}
+	for name, items := range fanOuts {
+		if items.failFast() {
+			failMessage := fmt.Sprintf("step '%s': %s", name, items.failFastMessage())
+			woc.log.WithFields(logging.Fields{"nodeID": node.ID, "failMessage": failMessage}).Info(ctx, "Step group node deemed failed")
+			return woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, failMessage), nil
+		}
+	}
 	for _, childNodeID := range node.Children {
 		childNode, err := woc.wf.Status.Nodes.Get(childNodeID)
 		if err != nil {
 			woc.log.WithField("nodeID", childNodeID).WithPanic().Error(ctx, "Couldn't obtain child for nodeID, panicking")
 		}
 		step := nodeSteps[childNode.Name]
+		if items := fanOuts[stepNameOf(step)]; items != nil && items.partiallySucceeded() {
+			// enough of the other items of this step succeeded
+			continue
+		}
 		if childNode.FailedOrError() && !step.ContinuesOn(childNode.Phase) {
 			failMessage := fmt.Sprintf("child '%s' failed", childNodeID)
 			woc.log.WithFields(logging.Fields{"nodeID": node.ID, "failMessage": failMessage}).Info(ctx, "Step group node deemed failed")
@@ -366,6 +401,42 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), nil
 }
 
+// stepFanOuts returns the counts of the items of each expanded step that has a parallelism or threshold
+func (woc *wfOperationCtx) stepFanOuts(sgNodeName string, stepGroup []wfv1.WorkflowStep) (map[string]*fanOut, error) {
+	totals := make(map[string]int)
+	for _, step := range stepGroup {
+		if hasFanOut(step.Parallelism, step.BatchSize, step.FailFastThreshold, step.SuccessThreshold) {
+			totals[stepNameOf(step)]++
+		}
+	}
+	fanOuts := make(map[string]*fanOut)
+	for _, step := range stepGroup {
+		name := stepNameOf(step)
+		total, ok := totals[name]
+		if !ok {
+			continue
+		}
+		items, ok := fanOuts[name]
+		if !ok {
+			var err error
+			items, err = newFanOut(step.Parallelism, step.BatchSize, step.FailFastThreshold, step.SuccessThreshold, total)
+			if err != nil {
+				return nil, fmt.Errorf("step '%s' %w", name, err)
+			}
+			fanOuts[name] = items
+		}
+		node, _ := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", sgNodeName, step.Name))
+		items.observe(node)
+	}
+	return fanOuts, nil
+}
+
+// stepNameOf returns the name of the step that an expanded step was expanded from, e.g. "a" for "a(0:foo)"
+func stepNameOf(step wfv1.WorkflowStep) string {
+	name, _, _ := strings.Cut(step.Name, "(")
+	return name
+}
+
 // shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
 func shouldExecute(when string) (bool, error) {
 	if when == "" {
@@ -602,6 +673,29 @@ func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowSte
 	return expandedStep, nil
 }
 
+var resourceUsageNames = []string{"cpu", "memoryPeak", "ioRead", "ioWrite"}
+
+// resourceUsageScope summarises the resource usage of the main containers of a node: CPU seconds and I/O bytes are
+// summed, and the memory peak is the largest of any container
+func resourceUsageScope(usages []wfv1.ContainerResourceUsage) map[string]string {
+	if len(usages) == 0 {
+		return nil
+	}
+	var cpuMilliseconds, memoryPeakBytes, ioReadBytes, ioWriteBytes int64
+	for _, usage := range usages {
+		cpuMilliseconds += usage.CPUMilliseconds
+		memoryPeakBytes = max(memoryPeakBytes, usage.MemoryPeakBytes)
+		ioReadBytes += usage.IOReadBytes
+		ioWriteBytes += usage.IOWriteBytes
+	}
+	return map[string]string{
+		"cpu":        fmt.Sprint(float64(cpuMilliseconds) / 1000),
+		"memoryPeak": fmt.Sprint(memoryPeakBytes),
+		"ioRead":     fmt.Sprint(ioReadBytes),
+		"ioWrite":    fmt.Sprint(ioWriteBytes),
+	}
+}
+
 func (woc *wfOperationCtx) prepareDefaultMetricScope() (map[string]string, map[string]func() float64) {
 	durationCPU := fmt.Sprintf("%s.%s", common.LocalVarResourcesDuration, v1.ResourceCPU)
 	durationMem := fmt.Sprintf("%s.%s", common.LocalVarResourcesDuration, v1.ResourceMemory)
@@ -611,6 +705,9 @@ func (woc *wfOperationCtx) prepareDefaultMetricScope() (map[string]string, map[s
 	localScope[common.LocalVarStatus] = string(wfv1.NodePending)
 	localScope[durationCPU] = "0"
 	localScope[durationMem] = "0"
+	for _, name := range resourceUsageNames {
+		localScope[fmt.Sprintf("%s.%s", common.LocalVarResourceUsage, name)] = "0"
+	}
 
 	var realTimeScope = map[string]func() float64{
 		common.GlobalVarWorkflowDuration: func() float64 {
@@ -680,5 +777,9 @@ func (woc *wfOperationCtx) prepareMetricScope(node *wfv1.NodeStatus) (map[string
 		}
 	}
 
+	for name, value := range resourceUsageScope(node.ResourceUsage) {
+		localScope[fmt.Sprintf("%s.%s", common.LocalVarResourceUsage, name)] = value
+	}
+
 	return localScope, realTimeScope
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -188,6 +189,27 @@ func TestResourceDurationMetricDefaultMetricScope(t *testing.T) {
 	assert.Less(t, realTimeScope["workflow.duration"](), 1.0)
 }
 
+func TestResourceUsageMetricScope(t *testing.T) {
+	wf := wfv1.Workflow{Status: wfv1.WorkflowStatus{StartedAt: metav1.NewTime(time.Now())}}
+	woc := wfOperationCtx{
+		globalParams: make(common.Parameters),
+		wf:           &wf,
+	}
+
+	localScope, _ := woc.prepareMetricScope(&wfv1.NodeStatus{})
+	assert.Equal(t, "0", localScope["resourceUsage.cpu"])
+	assert.Equal(t, "0", localScope["resourceUsage.memoryPeak"])
+
+	localScope, _ = woc.prepareMetricScope(&wfv1.NodeStatus{ResourceUsage: []wfv1.ContainerResourceUsage{
+		{Name: "a", CPUMilliseconds: 1500, MemoryPeakBytes: 1024, IOReadBytes: 1, IOWriteBytes: 2},
+		{Name: "b", CPUMilliseconds: 250, MemoryPeakBytes: 2048, IOReadBytes: 10, IOWriteBytes: 20},
+	}})
+	assert.Equal(t, "1.75", localScope["resourceUsage.cpu"])
+	assert.Equal(t, "2048", localScope["resourceUsage.memoryPeak"])
+	assert.Equal(t, "11", localScope["resourceUsage.ioRead"])
+	assert.Equal(t, "22", localScope["resourceUsage.ioWrite"])
+}
+
 var optionalArgumentAndParameter = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -445,3 +467,115 @@ func TestOptionalArgumentUseSubPathInLoop(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
 }
+
+var stepsFanOutWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-fan-out
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: echo
+            withSequence:
+              count: "10"
+            parallelism: 2
+            failFastThreshold: 2
+          - name: b
+            template: echo
+    - name: echo
+      container:
+        image: argoproj/argosay:v2`
+
+func TestStepFanOut(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	setup := func(t *testing.T) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(stepsFanOutWorkflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+	pods := func(t *testing.T, woc *wfOperationCtx) int {
+		t.Helper()
+		list, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		return len(list.Items)
+	}
+
+	t.Run("Parallelism", func(t *testing.T) {
+		controller, woc := setup(t)
+		// two items of a, and b
+		assert.Equal(t, 3, pods(t, woc))
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, 5, pods(t, woc))
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByName("steps-fan-out[0]").Phase)
+	})
+	t.Run("FailFastThreshold", func(t *testing.T) {
+		controller, woc := setup(t)
+		makePodsPhase(ctx, woc, apiv1.PodFailed, withExitCode(1))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, 3, pods(t, woc))
+		group := woc.wf.Status.Nodes.FindByName("steps-fan-out[0]")
+		require.NotNil(t, group)
+		assert.Equal(t, wfv1.NodeFailed, group.Phase)
+		assert.Equal(t, "step 'a': 2 of 10 items failed or errored, reaching the fail-fast threshold of 2", group.Message)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	})
+}
+
+var stepsPartiallySucceededWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-partially-succeeded
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: echo
+            withItems: [x, y, z]
+            successThreshold: 2
+    - name: echo
+      container:
+        image: argoproj/argosay:v2`
+
+func TestStepPartiallySucceeded(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	setup := func(t *testing.T) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(stepsPartiallySucceededWorkflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		controller, woc := setup(t)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, failItem(2))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		group := woc.wf.Status.Nodes.FindByName("steps-partially-succeeded[0]")
+		require.NotNil(t, group)
+		assert.Equal(t, wfv1.NodeSucceeded, group.Phase)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		controller, woc := setup(t)
+		makePodsPhase(ctx, woc, apiv1.PodFailed, withExitCode(1))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes.FindByName("steps-partially-succeeded[0]").Phase)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	})
+}
@@ -126,6 +126,16 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 		if result.Progress.IsValid() {
 			newNode.Progress = result.Progress
 		}
+		if result.ProgressDetail.IsValid() {
+			newNode.ProgressDetail = result.ProgressDetail
+		}
+		if len(result.ResourceUsage) > 0 {
+			// the usage is only reported once, when the main containers have exited
+			if len(old.ResourceUsage) == 0 {
+				woc.controller.metrics.RecordResourceUsage(ctx, result.ResourceUsage, woc.wf.Namespace, nodeTemplateName(old))
+			}
+			newNode.ResourceUsage = result.ResourceUsage
+		}
 		if !reflect.DeepEqual(old, newNode) {
 			woc.log.
 				WithField("nodeID", nodeID).
@@ -135,3 +145,11 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 		}
 	}
 }
+
+// nodeTemplateName is the name of the template that the node ran, whether it is in the workflow or referenced
+func nodeTemplateName(node *wfv1.NodeStatus) string {
+	if node.TemplateRef != nil {
+		return node.TemplateRef.Template
+	}
+	return node.TemplateName
+}
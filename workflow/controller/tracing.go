@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// The trace of a workflow is recorded from its status, as its spans can last longer than the controller. The IDs of
+// the spans are derived from the workflow UID and the node IDs, so that the span of a node is known before it is
+// recorded and can be the parent of the spans of the code running in its pod.
+
+func (woc *wfOperationCtx) traceID() trace.TraceID {
+	return telemetry.TraceID(string(woc.wf.UID))
+}
+
+func (woc *wfOperationCtx) workflowSpanID() trace.SpanID {
+	return telemetry.SpanID(string(woc.wf.UID))
+}
+
+// nodeSpanKey returns the key of the span of the node, which is different for each workflow retry, as the nodes that
+// are retried keep their IDs
+func (woc *wfOperationCtx) nodeSpanKey(nodeID string) string {
+	attempts := int32(0)
+	if woc.wf.Status.Retries != nil {
+		attempts = woc.wf.Status.Retries.Attempts
+	}
+	return fmt.Sprintf("%s/%d", nodeID, attempts)
+}
+
+// traceparent returns the W3C trace context of the span of the node, for the TRACEPARENT environment variable
+func (woc *wfOperationCtx) traceparent(nodeID string) string {
+	return telemetry.Traceparent(woc.traceID(), telemetry.SpanID(woc.nodeSpanKey(nodeID)))
+}
+
+// recordTraces records the spans of the nodes that have completed since the old workflow, and of the workflow once it
+// has completed
+func (woc *wfOperationCtx) recordTraces(ctx context.Context, old *wfv1.Workflow) {
+	tracing := woc.controller.tracing
+	if !tracing.Enabled() {
+		return
+	}
+	// the children of retry nodes and groups are nested in them, while those of other nodes are their dependents
+	parents := make(map[string]string)
+	for id, node := range woc.wf.Status.Nodes {
+		switch node.Type {
+		case wfv1.NodeTypeRetry, wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup:
+			for _, child := range node.Children {
+				parents[child] = id
+			}
+		}
+	}
+	for id, node := range woc.wf.Status.Nodes {
+		if !node.Fulfilled() {
+			continue
+		}
+		if oldNode, ok := old.Status.Nodes[id]; ok && oldNode.Fulfilled() {
+			continue
+		}
+		parentID := woc.workflowSpanID()
+		if parent, ok := parents[id]; ok {
+			parentID = telemetry.SpanID(woc.nodeSpanKey(parent))
+		} else if node.BoundaryID != "" {
+			parentID = telemetry.SpanID(woc.nodeSpanKey(node.BoundaryID))
+		}
+		woc.recordNodeSpans(ctx, &node, parentID)
+	}
+	if woc.wf.Status.Fulfilled() && !old.Status.Fulfilled() {
+		retries := int32(0)
+		if woc.wf.Status.Retries != nil {
+			retries = woc.wf.Status.Retries.Attempts
+		}
+		tracing.Record(ctx, telemetry.Span{
+			Name:      woc.wf.Name,
+			TraceID:   woc.traceID(),
+			SpanID:    woc.workflowSpanID(),
+			StartTime: woc.wf.Status.StartedAt.Time,
+			EndTime:   woc.wf.Status.FinishedAt.Time,
+			Failed:    woc.wf.Status.Phase != wfv1.WorkflowSucceeded,
+			Error:     woc.wf.Status.Message,
+			Attributes: []attribute.KeyValue{
+				attribute.String("argo.workflow.namespace", woc.wf.Namespace),
+				attribute.String("argo.workflow.name", woc.wf.Name),
+				attribute.String("argo.workflow.phase", string(woc.wf.Status.Phase)),
+				attribute.Int("argo.workflow.retries", int(retries)),
+			},
+		})
+	}
+}
+
+// recordNodeSpans records the span of the node, and the spans of the pending phase and the containers of its pod
+func (woc *wfOperationCtx) recordNodeSpans(ctx context.Context, node *wfv1.NodeStatus, parentID trace.SpanID) {
+	tracing := woc.controller.tracing
+	startedAt, finishedAt := node.StartedAt.Time, node.FinishedAt.Time
+	if startedAt.IsZero() {
+		startedAt = finishedAt
+	}
+	if finishedAt.IsZero() {
+		finishedAt = startedAt
+	}
+	if startedAt.IsZero() {
+		return
+	}
+	key := woc.nodeSpanKey(node.ID)
+	spanID := telemetry.SpanID(key)
+	tracing.Record(ctx, telemetry.Span{
+		Name:      node.DisplayName,
+		TraceID:   woc.traceID(),
+		SpanID:    spanID,
+		ParentID:  parentID,
+		StartTime: startedAt,
+		EndTime:   finishedAt,
+		Failed:    node.FailedOrError(),
+		Error:     node.Message,
+		Attributes: []attribute.KeyValue{
+			attribute.String("argo.workflow.namespace", woc.wf.Namespace),
+			attribute.String("argo.workflow.name", woc.wf.Name),
+			attribute.String("argo.node.id", node.ID),
+			attribute.String("argo.node.name", node.Name),
+			attribute.String("argo.node.type", string(node.Type)),
+			attribute.String("argo.node.phase", string(node.Phase)),
+			attribute.String("argo.template.name", node.TemplateName),
+		},
+	})
+
+	if node.Type != wfv1.NodeTypePod {
+		return
+	}
+	pod, err := woc.controller.PodController.GetPod(woc.wf.Namespace, woc.getPodName(node.Name, wfutil.GetTemplateFromNode(*node)))
+	if err != nil || pod == nil {
+		return
+	}
+	statuses := append(append([]apiv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+
+	// the pod is pending until its first container starts
+	var runningAt time.Time
+	for _, status := range statuses {
+		if t := status.State.Terminated; t != nil && !t.StartedAt.IsZero() && (runningAt.IsZero() || t.StartedAt.Time.Before(runningAt)) {
+			runningAt = t.StartedAt.Time
+		}
+	}
+	if !runningAt.IsZero() && runningAt.After(startedAt) {
+		tracing.Record(ctx, telemetry.Span{
+			Name:      "pending",
+			TraceID:   woc.traceID(),
+			SpanID:    telemetry.SpanID(key + "/pending"),
+			ParentID:  spanID,
+			StartTime: startedAt,
+			EndTime:   runningAt,
+		})
+	}
+	for _, status := range statuses {
+		t := status.State.Terminated
+		if t == nil || t.StartedAt.IsZero() {
+			continue
+		}
+		tracing.Record(ctx, telemetry.Span{
+			Name:      status.Name,
+			TraceID:   woc.traceID(),
+			SpanID:    telemetry.SpanID(key + "/" + status.Name),
+			ParentID:  spanID,
+			StartTime: t.StartedAt.Time,
+			EndTime:   t.FinishedAt.Time,
+			Failed:    t.ExitCode != 0,
+			Error:     t.Message,
+			Attributes: []attribute.KeyValue{
+				attribute.String("argo.container.name", status.Name),
+				attribute.Int("argo.container.exit_code", int(t.ExitCode)),
+			},
+		})
+	}
+}
+
+// executorTracingEnvVars returns the environment variables that configure the executor to export its spans like the
+// controller, either from the tracing config, or from the controller's own OTLP environment variables. Headers are not
+// passed, as they may hold credentials that anyone who can read the pod could read.
+func executorTracingEnvVars(c config.TracingConfig) []apiv1.EnvVar {
+	var envVars []apiv1.EnvVar
+	if c.Endpoint != "" {
+		scheme := "https://"
+		if c.Insecure {
+			scheme = "http://"
+		}
+		return append(envVars, apiv1.EnvVar{Name: "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", Value: scheme + c.Endpoint})
+	}
+	for _, name := range []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_INSECURE", "OTEL_EXPORTER_OTLP_TRACES_INSECURE"} {
+		if value, ok := os.LookupEnv(name); ok {
+			envVars = append(envVars, apiv1.EnvVar{Name: name, Value: value})
+		}
+	}
+	return envVars
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+var tracingWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: tracing
+  namespace: argo
+  uid: my-uid
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: echo
+    - name: echo
+      retryStrategy:
+        limit: 1
+      container:
+        image: argoproj/argosay:v2`
+
+// withTerminatedContainers terminates the containers of the pod with the exit code, a second after it was created
+func withTerminatedContainers(exitCode int32) with {
+	return func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		startedAt := metav1.NewTime(time.Now().Add(time.Second))
+		for _, c := range pod.Spec.Containers {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, apiv1.ContainerStatus{
+				Name: c.Name,
+				State: apiv1.ContainerState{
+					Terminated: &apiv1.ContainerStateTerminated{
+						ExitCode:   exitCode,
+						StartedAt:  startedAt,
+						FinishedAt: metav1.NewTime(startedAt.Add(time.Second)),
+					},
+				},
+			})
+		}
+	}
+}
+
+// findSpan returns the span with the name and the parent
+func findSpan(t *testing.T, spans tracetest.SpanStubs, name string, parent tracetest.SpanStub) tracetest.SpanStub {
+	t.Helper()
+	for _, span := range spans {
+		if span.Name == name && span.Parent.SpanID() == parent.SpanContext.SpanID() {
+			return span
+		}
+	}
+	require.Failf(t, "span not found", "%s not in %v", name, spans)
+	return tracetest.SpanStub{}
+}
+
+// waitForPodInformer waits until the pod informer has the pods, so that their creation does not overwrite their phase
+func waitForPodInformer(t *testing.T, woc *wfOperationCtx, count int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return len(woc.controller.PodController.TestingPodInformer().GetStore().List()) == count
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRecordTraces(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tracing, exporter, err := telemetry.NewTestTracing(ctx)
+	require.NoError(t, err)
+	wf := wfv1.MustUnmarshalWorkflow(tracingWorkflow)
+	cancel, controller := newController(ctx, wf, func(controller *WorkflowController) {
+		controller.tracing = tracing
+	})
+	t.Cleanup(cancel)
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	attempt := woc.wf.Status.Nodes.FindByName("tracing[0].a(0)")
+	require.NotNil(t, attempt)
+	assert.Contains(t, pods.Items[0].Spec.Containers[1].Env, apiv1.EnvVar{Name: telemetry.EnvVarTraceparent, Value: woc.traceparent(attempt.ID)})
+
+	waitForPodInformer(t, woc, 1)
+	makePodsPhase(ctx, woc, apiv1.PodFailed, withTerminatedContainers(1))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	deletePods(ctx, woc)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	waitForPodInformer(t, woc, 1)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withTerminatedContainers(0))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	require.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+	spans := exporter.GetSpans()
+	traceID := telemetry.TraceID("my-uid")
+	for _, span := range spans {
+		assert.Equal(t, traceID, span.SpanContext.TraceID())
+	}
+	workflow := findSpan(t, spans, "tracing", tracetest.SpanStub{})
+	assert.Equal(t, telemetry.SpanID("my-uid"), workflow.SpanContext.SpanID())
+	assert.Equal(t, codes.Unset, workflow.Status.Code)
+
+	steps := findSpan(t, spans, "tracing", workflow)
+	group := findSpan(t, spans, "[0]", steps)
+	retry := findSpan(t, spans, "a", group)
+	failed := findSpan(t, spans, "a(0)", retry)
+	succeeded := findSpan(t, spans, "a(1)", retry)
+	assert.Equal(t, telemetry.SpanID(woc.nodeSpanKey(attempt.ID)), failed.SpanContext.SpanID())
+	assert.Equal(t, codes.Error, failed.Status.Code)
+	assert.Equal(t, codes.Unset, succeeded.Status.Code)
+
+	var containers []string
+	for _, span := range spans {
+		if span.Parent.SpanID() == succeeded.SpanContext.SpanID() {
+			containers = append(containers, span.Name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"pending", "wait", "main"}, containers)
+}
+
+func TestExecutorTracingEnvVars(t *testing.T) {
+	t.Run("Config", func(t *testing.T) {
+		t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://ignored:4317")
+		t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "x-tenant=a")
+		envVars := executorTracingEnvVars(config.TracingConfig{
+			Enabled:  true,
+			Endpoint: "otel-collector:4317",
+			Insecure: true,
+			Headers:  map[string]string{"authorization": "Bearer secret"},
+		})
+		assert.Equal(t, []apiv1.EnvVar{
+			{Name: "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", Value: "http://otel-collector:4317"},
+		}, envVars)
+	})
+	t.Run("Environment", func(t *testing.T) {
+		t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "http://otel-collector:4317")
+		t.Setenv("OTEL_EXPORTER_OTLP_TRACES_INSECURE", "true")
+		t.Setenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS", "authorization=secret")
+		envVars := executorTracingEnvVars(config.TracingConfig{})
+		assert.Equal(t, []apiv1.EnvVar{
+			{Name: "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", Value: "http://otel-collector:4317"},
+			{Name: "OTEL_EXPORTER_OTLP_TRACES_INSECURE", Value: "true"},
+		}, envVars)
+	})
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// retryWorkflow retries the workflow by its workflowRetryStrategy once its root node has failed or errored, in the
+// same way as `argo retry`. It returns whether the workflow is being retried, in which case it must not complete.
+func (woc *wfOperationCtx) retryWorkflow(ctx context.Context, node *wfv1.NodeStatus, failures []failedNodeStatus) (bool, error) {
+	strategy := woc.execWf.Spec.WorkflowRetryStrategy
+	if strategy == nil || !node.FailedOrError() || woc.GetShutdownStrategy().Enabled() {
+		return false, nil
+	}
+	status := woc.wf.Status.Retries
+	if status == nil {
+		status = &wfv1.WorkflowRetryStatus{}
+	}
+
+	if status.NextRetryAt == nil {
+		retry, err := woc.shouldRetryWorkflow(ctx, strategy, status, node, failures)
+		if err != nil || !retry {
+			return false, err
+		}
+		backoff, err := workflowRetryBackoff(strategy.Backoff, status.Attempts, fmt.Sprintf("%s/%d", woc.wf.UID, status.Attempts))
+		if err != nil {
+			return false, err
+		}
+		woc.log.WithFields(logging.Fields{"attempt": status.Attempts + 1, "backoff": backoff}).Info(ctx, "Retrying workflow")
+		woc.wf.Status.Retries = &wfv1.WorkflowRetryStatus{
+			Attempts:    status.Attempts,
+			NextRetryAt: &metav1.Time{Time: time.Now().UTC().Add(backoff)},
+			Message:     node.Message,
+		}
+		status = woc.wf.Status.Retries
+		woc.updated = true
+	}
+
+	// FormulateRetryWorkflow only retries completed workflows
+	wf := woc.wf.DeepCopy()
+	wf.Status.Phase = wfv1.WorkflowFailed
+	newWf, podsToDelete, err := wfutil.FormulateRetryWorkflow(ctx, wf, false, "", nil)
+	if err != nil {
+		return false, err
+	}
+
+	// the nodes that are reset create pods with the same names, so the old pods must be gone before they do
+	deleting := false
+	for _, podName := range podsToDelete {
+		pod, err := woc.controller.PodController.GetPod(woc.wf.Namespace, podName)
+		if err != nil {
+			return false, err
+		}
+		if pod == nil {
+			continue
+		}
+		deleting = true
+		if pod.DeletionTimestamp == nil {
+			woc.controller.PodController.DeletePod(ctx, pod.Namespace, pod.Name)
+		}
+	}
+	if wait := time.Until(status.NextRetryAt.Time); wait > 0 {
+		woc.requeueAfter(wait)
+		return true, nil
+	}
+	if deleting {
+		woc.requeueAfter(time.Second)
+		return true, nil
+	}
+
+	newWf.Status.Retries = &wfv1.WorkflowRetryStatus{Attempts: status.Attempts + 1, Message: status.Message}
+	newWf.DeepCopyInto(woc.wf)
+	woc.updated = true
+	woc.eventRecorder.Event(woc.wf, apiv1.EventTypeNormal, "WorkflowRetried", fmt.Sprintf("Retried workflow (attempt %d): %s", woc.wf.Status.Retries.Attempts, status.Message))
+	woc.requeue()
+	return true, nil
+}
+
+// shouldRetryWorkflow returns whether the workflow is retried for the failure of its root node
+func (woc *wfOperationCtx) shouldRetryWorkflow(ctx context.Context, strategy *wfv1.WorkflowRetryStrategy, status *wfv1.WorkflowRetryStatus, node *wfv1.NodeStatus, failures []failedNodeStatus) (bool, error) {
+	limit, err := intstr.Int32(strategy.Limit)
+	if err != nil {
+		return false, err
+	}
+	if limit == nil || status.Attempts >= *limit {
+		woc.log.Info(ctx, "No more workflow retries left")
+		return false, nil
+	}
+	if strategy.Expression == "" {
+		return true, nil
+	}
+	localScope, err := buildWorkflowRetryLocalScope(status, node, failures)
+	if err != nil {
+		return false, err
+	}
+	retry, err := argoexpr.EvalBool(strategy.Expression, env.GetFuncMap(localScope))
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate workflowRetryStrategy.expression: %w", err)
+	}
+	if !retry {
+		woc.log.Info(ctx, "workflowRetryStrategy.expression evaluated to false")
+	}
+	return retry, nil
+}
+
+// buildWorkflowRetryLocalScope returns the variables of a workflowRetryStrategy expression, which are named like
+// those of a retryStrategy expression
+func buildWorkflowRetryLocalScope(status *wfv1.WorkflowRetryStatus, node *wfv1.NodeStatus, failures []failedNodeStatus) (map[string]interface{}, error) {
+	data, err := json.Marshal(failures)
+	if err != nil {
+		return nil, err
+	}
+	var failuresList []interface{}
+	if err := json.Unmarshal(data, &failuresList); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		common.LocalVarRetries:             strconv.Itoa(int(status.Attempts)),
+		common.LocalVarRetriesLastStatus:   string(node.Phase),
+		common.LocalVarRetriesLastDuration: fmt.Sprint(node.GetDuration().Seconds()),
+		common.LocalVarRetriesLastMessage:  node.Message,
+		"failures":                         failuresList,
+	}, nil
+}
+
+// workflowRetryBackoff returns how long to wait before a retry, which is duration * factor^attempts, up to the cap,
+// randomized by the jitter with the key. The time waited before the previous retry is not known, so a decorrelated
+// jitter is always between the duration and three times the duration.
+func workflowRetryBackoff(backoff *wfv1.Backoff, attempts int32, key string) (time.Duration, error) {
+	if backoff == nil || backoff.Duration == "" {
+		return 0, nil
+	}
+	base, err := wfv1.ParseStringToDuration(backoff.Duration)
+	if err != nil {
+		return 0, err
+	}
+	timeToWait := base
+	factor, err := intstr.Int32(backoff.Factor)
+	if err != nil {
+		return 0, err
+	}
+	if factor != nil && *factor > 0 {
+		timeToWait *= time.Duration(math.Pow(float64(*factor), float64(attempts)))
+	}
+	if backoff.Cap != "" {
+		capDuration, err := wfv1.ParseStringToDuration(backoff.Cap)
+		if err != nil {
+			return 0, err
+		}
+		timeToWait = min(timeToWait, capDuration)
+	}
+	return jitterBackoff(backoff, timeToWait, base, 0, key)
+}
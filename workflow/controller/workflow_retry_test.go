@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var workflowRetryWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: workflow-retry
+  namespace: argo
+spec:
+  entrypoint: main
+  workflowRetryStrategy:
+    limit: 1
+    expression: lastRetry.status == "Failed"
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: a
+            template: echo
+          - name: b
+            template: echo
+    - name: echo
+      container:
+        image: argoproj/argosay:v2`
+
+// failNode fails the pod of the node with the display name, and lets makePodsPhase give the others the phase
+func failNode(displayName string) with {
+	return func(pod *apiv1.Pod, woc *wfOperationCtx) {
+		node, err := woc.wf.Status.Nodes.Get(woc.nodeID(pod))
+		if err != nil || node.DisplayName != displayName {
+			return
+		}
+		pod.Status.Phase = apiv1.PodFailed
+		pod.Status.Message = "Pod failed"
+		withExitCode(1)(pod, woc)
+	}
+}
+
+func TestWorkflowRetry(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	setup := func(t *testing.T, workflow string) (*WorkflowController, *wfOperationCtx) {
+		t.Helper()
+		wf := wfv1.MustUnmarshalWorkflow(workflow)
+		cancel, controller := newController(ctx, wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, failNode("b"))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		return controller, woc
+	}
+
+	t.Run("Retried", func(t *testing.T) {
+		controller, woc := setup(t, workflowRetryWorkflow)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		require.NotNil(t, woc.wf.Status.Retries)
+		assert.NotNil(t, woc.wf.Status.Retries.NextRetryAt)
+		assert.Equal(t, int32(0), woc.wf.Status.Retries.Attempts)
+
+		// the pod of the failed node must be deleted before it is retried
+		deletePods(ctx, woc)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Equal(t, int32(1), woc.wf.Status.Retries.Attempts)
+		assert.Nil(t, woc.wf.Status.Retries.NextRetryAt)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes.FindByDisplayName("a").Phase)
+		assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("b"))
+
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		makePodsPhase(ctx, woc, apiv1.PodFailed, withExitCode(1))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, int32(1), woc.wf.Status.Retries.Attempts)
+	})
+	t.Run("Expression", func(t *testing.T) {
+		_, woc := setup(t, strings.Replace(workflowRetryWorkflow, `lastRetry.status == "Failed"`, `lastRetry.status == "Error"`, 1))
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Nil(t, woc.wf.Status.Retries)
+	})
+	t.Run("Backoff", func(t *testing.T) {
+		controller, woc := setup(t, strings.Replace(workflowRetryWorkflow, "limit: 1", "limit: 1\n    backoff:\n      duration: 1h", 1))
+		deletePods(ctx, woc)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Equal(t, int32(0), woc.wf.Status.Retries.Attempts)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), woc.wf.Status.Retries.NextRetryAt.Time, time.Minute)
+	})
+}
+
+func TestWorkflowRetryBackoff(t *testing.T) {
+	backoff := &wfv1.Backoff{Duration: "1m", Factor: ptr.To(intstr.FromInt32(2)), Cap: "5m"}
+	for attempts, expected := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute} {
+		d, err := workflowRetryBackoff(backoff, int32(attempts), "")
+		require.NoError(t, err)
+		assert.Equal(t, expected, d)
+	}
+	d, err := workflowRetryBackoff(nil, 3, "")
+	require.NoError(t, err)
+	assert.Zero(t, d)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -16,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -23,6 +25,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
@@ -327,6 +330,23 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		{Name: common.EnvVarDeadline, Value: woc.getDeadline(opts).Format(time.RFC3339)},
 	}
 
+	// the code running in the pod, and the emissary when it writes the logs of the main containers in JSON, can
+	// correlate its logs with those of the controller
+	envVars = append(envVars,
+		apiv1.EnvVar{Name: common.EnvVarWorkflowNamespace, Value: woc.wf.Namespace},
+		apiv1.EnvVar{Name: common.EnvVarNodeName, Value: nodeName},
+		apiv1.EnvVar{Name: common.EnvVarTemplateName, Value: tmpl.Name},
+		apiv1.EnvVar{Name: common.EnvVarRetryAttempt, Value: strconv.Itoa(woc.retryAttempt(nodeID))},
+	)
+	if woc.controller.Config.ContainerLogFormat == config.ContainerLogFormatJSON {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarContainerLogFormat, Value: string(config.ContainerLogFormatJSON)})
+	}
+
+	// the code running in the pod can record its spans as children of the span of the node
+	if woc.controller.tracing.Enabled() {
+		envVars = append(envVars, apiv1.EnvVar{Name: telemetry.EnvVarTraceparent, Value: woc.traceparent(nodeID)})
+	}
+
 	// only set tick durations/EnvVarProgressFile if progress is enabled.
 	// The progress is only monitored if the tick durations are >0.
 	if woc.controller.progressPatchTickDuration != 0 && woc.controller.progressFileTickDuration != 0 {
@@ -344,6 +364,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 				Value: woc.controller.progressFileTickDuration.String(),
 			},
 		)
+		if woc.controller.progressPort != "" {
+			envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarProgressPort, Value: woc.controller.progressPort})
+		}
 	}
 
 	for i, c := range pod.Spec.InitContainers {
@@ -355,6 +378,13 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	for i, c := range pod.Spec.Containers {
 		c.Env = append(c.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: c.Name})
 		c.Env = append(c.Env, envVars...)
+		// the wait container already has the workflow name and UID
+		if c.Name != common.WaitContainerName {
+			c.Env = append(c.Env,
+				apiv1.EnvVar{Name: common.EnvVarWorkflowName, Value: woc.wf.Name},
+				apiv1.EnvVar{Name: common.EnvVarWorkflowUID, Value: string(woc.wf.UID)},
+			)
+		}
 		pod.Spec.Containers[i] = c
 	}
 
@@ -377,7 +407,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 				if err != nil {
 					return nil, err
 				}
-				for _, obj := range []interface{}{tmpl.ArchiveLocation} {
+				objs := []interface{}{tmpl.ArchiveLocation}
+				if tmpl.Checkpoint != nil {
+					objs = append(objs, tmpl.Checkpoint.ArtifactLocation)
+				}
+				for _, obj := range objs {
 					err = validate.VerifyResolvedVariables(obj)
 					if err != nil {
 						return nil, err
@@ -551,6 +585,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		woc.log.WithFields(logging.Fields{"nodeName": nodeName, "podName": pod.Name, "error": err}).Info(ctx, "Failed to create pod")
 		return nil, errors.InternalWrapError(err)
 	}
+	woc.podCreations++
 	woc.log.WithFields(logging.Fields{"nodeName": nodeName, "podName": created.Name}).Info(ctx, "Created pod")
 	woc.activePods++
 	return created, nil
@@ -667,6 +702,10 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 			apiv1.EnvVar{Name: common.EnvVarInstanceID, Value: v},
 		)
 	}
+	// the executor exports the spans of loading and saving artifacts to the same collector as the controller
+	if woc.controller.tracing.Enabled() {
+		execEnvVars = append(execEnvVars, executorTracingEnvVars(woc.controller.Config.TracingConfig)...)
+	}
 	if woc.controller.Config.Executor != nil {
 		execEnvVars = append(execEnvVars, woc.controller.Config.Executor.Env...)
 	}
@@ -1111,6 +1150,34 @@ func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Te
 	tmpl.ArchiveLocation.ArchiveLogs = &archiveLogs
 }
 
+// addCheckpointLocation sets the location of the checkpoint of the template, defaulting to the artifact repository.
+// Unless one is set, the key is derived from the node that all retries of the step share, so that a retry restores
+// the checkpoint of the previous attempt.
+func (woc *wfOperationCtx) addCheckpointLocation(tmpl *wfv1.Template, nodeName string) error {
+	checkpoint := tmpl.Checkpoint.DeepCopy()
+	repository := woc.artifactRepository.ToArtifactLocation()
+	switch {
+	case checkpoint.ArtifactLocation.HasLocation() && checkpoint.ArtifactLocation.HasKey():
+	case checkpoint.ArtifactLocation.HasKey():
+		if err := checkpoint.ArtifactLocation.Relocate(repository); err != nil {
+			return err
+		}
+	default:
+		if !checkpoint.ArtifactLocation.HasLocation() {
+			if !repository.HasLocation() {
+				return errors.Errorf(errors.CodeBadRequest, "checkpoint requires an artifact repository")
+			}
+			checkpoint.ArtifactLocation = repository
+		}
+		// the key format of the repository is not used, as it usually contains the pod name, which differs for each retry
+		if err := checkpoint.ArtifactLocation.SetKey(path.Join(woc.wf.Name, "checkpoints", woc.wf.NodeID(nodeName)+".tgz")); err != nil {
+			return err
+		}
+	}
+	tmpl.Checkpoint = checkpoint
+	return nil
+}
+
 // IsArchiveLogs determines if container should archive logs
 // priorities: controller(on) > template > workflow > controller(off)
 func (woc *wfOperationCtx) IsArchiveLogs(tmpl *wfv1.Template) bool {
@@ -1244,6 +1311,9 @@ func createSecretVolumesAndMounts(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.
 	var secretVolMounts []apiv1.VolumeMount
 
 	createArchiveLocationSecret(tmpl, allVolumesMap, uniqueKeyMap)
+	if tmpl.Checkpoint != nil {
+		createSecretVolumesFromArtifactLocations(allVolumesMap, []*wfv1.ArtifactLocation{tmpl.Checkpoint.ArtifactLocation}, uniqueKeyMap)
+	}
 
 	for _, art := range tmpl.Outputs.Artifacts {
 		createSecretVolume(allVolumesMap, art, uniqueKeyMap)
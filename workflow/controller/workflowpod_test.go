@@ -15,6 +15,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/config"
@@ -554,6 +555,59 @@ func TestConditionalArchiveLocation(t *testing.T) {
 	assert.Nil(t, tmpl.ArchiveLocation)
 }
 
+// TestCheckpointLocation verifies the checkpoint key is the same for every retry of a step
+func TestCheckpointLocation(t *testing.T) {
+	repo := &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{
+		S3Bucket: wfv1.S3Bucket{
+			Endpoint: "minio:9000",
+			Bucket:   "foo",
+		},
+		KeyFormat: "path/in/bucket",
+	}}
+	t.Run("Default", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Templates[0].Checkpoint = &wfv1.Checkpoint{Path: "/mnt/checkpoint"}
+		wf.Spec.Templates[0].RetryStrategy = &wfv1.RetryStrategy{Limit: ptr.To(intstr.FromInt32(2))}
+		woc := newWoc(ctx, *wf)
+		setArtifactRepository(woc.controller, repo)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		tmpl, err := getPodTemplate(&pods.Items[0])
+		require.NoError(t, err)
+		require.NotNil(t, tmpl.Checkpoint)
+		require.NotNil(t, tmpl.Checkpoint.ArtifactLocation)
+		require.NotNil(t, tmpl.Checkpoint.ArtifactLocation.S3)
+		assert.Equal(t, "foo", tmpl.Checkpoint.ArtifactLocation.S3.Bucket)
+		assert.Equal(t, "hello-world/checkpoints/"+woc.wf.NodeID("hello-world")+".tgz", tmpl.Checkpoint.ArtifactLocation.S3.Key)
+		assert.Nil(t, woc.execWf.Spec.Templates[0].Checkpoint.ArtifactLocation)
+	})
+	t.Run("ArtifactLocation", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Templates[0].Checkpoint = &wfv1.Checkpoint{
+			Path: "/mnt/checkpoint",
+			ArtifactLocation: &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+				S3Bucket: wfv1.S3Bucket{Endpoint: "minio:9000", Bucket: "bar"},
+				Key:      "my-checkpoint.tgz",
+			}},
+		}
+		woc := newWoc(ctx, *wf)
+		setArtifactRepository(woc.controller, repo)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		tmpl, err := getPodTemplate(&pods.Items[0])
+		require.NoError(t, err)
+		require.NotNil(t, tmpl.Checkpoint.ArtifactLocation.S3)
+		assert.Equal(t, "bar", tmpl.Checkpoint.ArtifactLocation.S3.Bucket)
+		assert.Equal(t, "my-checkpoint.tgz", tmpl.Checkpoint.ArtifactLocation.S3.Key)
+	})
+}
+
 // TestConditionalAddArchiveLocationTemplateArchiveLogs verifies we do  add archive location if it is needed for logs
 func TestConditionalAddArchiveLocationTemplateArchiveLogs(t *testing.T) {
 	tests := []struct {
@@ -2138,3 +2192,76 @@ func TestMergeEnvVars(t *testing.T) {
 		})
 	})
 }
+
+var correlationWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: correlation
+  namespace: argo
+  uid: 0a2b3c4d
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      retryStrategy:
+        limit: 1
+      container:
+        image: argoproj/argosay:v2`
+
+func TestCorrelationEnvVars(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(correlationWorkflow)
+	cancel, controller := newController(ctx, wf, func(controller *WorkflowController) {
+		controller.Config.ContainerLogFormat = config.ContainerLogFormatJSON
+	})
+	defer cancel()
+	// containerEnv returns the env of the container of the pod of the node
+	containerEnv := func(t *testing.T, woc *wfOperationCtx, nodeName, containerName string) []apiv1.EnvVar {
+		t.Helper()
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		for _, pod := range pods.Items {
+			if pod.Annotations[common.AnnotationKeyNodeName] != nodeName {
+				continue
+			}
+			for _, c := range pod.Spec.Containers {
+				if c.Name == containerName {
+					return c.Env
+				}
+			}
+		}
+		require.Failf(t, "container not found", "%s of %s", containerName, nodeName)
+		return nil
+	}
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	env := containerEnv(t, woc, "correlation(0)", common.MainContainerName)
+	for _, envVar := range []apiv1.EnvVar{
+		{Name: common.EnvVarWorkflowName, Value: "correlation"},
+		{Name: common.EnvVarWorkflowNamespace, Value: "argo"},
+		{Name: common.EnvVarWorkflowUID, Value: "0a2b3c4d"},
+		{Name: common.EnvVarNodeName, Value: "correlation(0)"},
+		{Name: common.EnvVarTemplateName, Value: "main"},
+		{Name: common.EnvVarRetryAttempt, Value: "0"},
+		{Name: common.EnvVarContainerLogFormat, Value: "json"},
+	} {
+		assert.Contains(t, env, envVar)
+	}
+	// the wait container has the workflow name once
+	names := 0
+	for _, envVar := range containerEnv(t, woc, "correlation(0)", common.WaitContainerName) {
+		if envVar.Name == common.EnvVarWorkflowName {
+			names++
+		}
+	}
+	assert.Equal(t, 1, names)
+
+	waitForPodInformer(t, woc, 1)
+	makePodsPhase(ctx, woc, apiv1.PodFailed, withTerminatedContainers(1))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	env = containerEnv(t, woc, "correlation(1)", common.MainContainerName)
+	assert.Contains(t, env, apiv1.EnvVar{Name: common.EnvVarRetryAttempt, Value: "1"})
+	assert.Contains(t, env, apiv1.EnvVar{Name: common.EnvVarNodeName, Value: "correlation(1)"})
+}
@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// artifactDriverName returns the name of the type of the repository of the artifact, as used in the artifact spec
+func artifactDriverName(art *wfv1.Artifact) string {
+	location, err := art.Get()
+	if err != nil {
+		return "unknown"
+	}
+	switch location.(type) {
+	case *wfv1.ArtifactoryArtifact:
+		return "artifactory"
+	case *wfv1.AzureArtifact:
+		return "azure"
+	case *wfv1.GCSArtifact:
+		return "gcs"
+	case *wfv1.GitArtifact:
+		return "git"
+	case *wfv1.HDFSArtifact:
+		return "hdfs"
+	case *wfv1.HTTPArtifact:
+		return "http"
+	case *wfv1.OSSArtifact:
+		return "oss"
+	case *wfv1.RawArtifact:
+		return "raw"
+	case *wfv1.S3Artifact:
+		return "s3"
+	}
+	return "unknown"
+}
+
+// pathSize returns the total size of the files at the path, which is a file or a directory
+func pathSize(path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// recordArtifactTransfer records the upload or download of the artifact at the local path, which started at startTime
+func (we *WorkflowExecutor) recordArtifactTransfer(ctx context.Context, art *wfv1.Artifact, direction, localPath string, startTime time.Time) {
+	if we.Metrics == nil {
+		return
+	}
+	we.Metrics.ArtifactTransferred(ctx, artifactDriverName(art), direction, pathSize(localPath), time.Since(startTime))
+}
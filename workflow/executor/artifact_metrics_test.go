@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
+)
+
+func TestArtifactDriverName(t *testing.T) {
+	assert.Equal(t, "s3", artifactDriverName(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{}}}))
+	assert.Equal(t, "gcs", artifactDriverName(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{GCS: &wfv1.GCSArtifact{}}}))
+	assert.Equal(t, "unknown", artifactDriverName(&wfv1.Artifact{}))
+}
+
+func TestRecordArtifactTransfer(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b", "c"), make([]byte, 50), 0o600))
+	assert.Equal(t, int64(150), pathSize(dir))
+	assert.Equal(t, int64(100), pathSize(filepath.Join(dir, "a")))
+
+	ctx := logging.TestContext(t.Context())
+	we := WorkflowExecutor{}
+	art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{}}}
+	// without metrics
+	we.recordArtifactTransfer(ctx, art, executormetrics.ArtifactUpload, dir, time.Now())
+
+	metrics, te, err := executormetrics.CreateTestMetrics(ctx, fakeNamespace)
+	require.NoError(t, err)
+	we.Metrics = metrics
+	we.recordArtifactTransfer(ctx, art, executormetrics.ArtifactUpload, dir, time.Now().Add(-2*time.Second))
+
+	attribs := attribute.NewSet(
+		attribute.String(telemetry.AttribWorkflowNamespace, fakeNamespace),
+		attribute.String(telemetry.AttribArtifactDriver, "s3"),
+		attribute.String(telemetry.AttribArtifactDirection, executormetrics.ArtifactUpload),
+	)
+	size, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentExecutorArtifactBytes.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), size)
+	data, err := te.GetFloat64HistogramData(ctx, telemetry.InstrumentExecutorArtifactDuration.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), data.Count)
+	assert.GreaterOrEqual(t, data.Sum, 2.0)
+}
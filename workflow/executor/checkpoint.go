@@ -0,0 +1,168 @@
+package executor
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// checkpointPollDuration is how often the wait container checks for a new checkpoint to save
+var checkpointPollDuration = 10 * time.Second
+
+// LoadCheckpoint downloads the last checkpoint of the step, if there is one, so that the main container can
+// restore it before it starts
+func (we *WorkflowExecutor) LoadCheckpoint(ctx context.Context) error {
+	if we.Template.Checkpoint == nil {
+		return nil
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	driverArt, err := we.newCheckpointDriverArt()
+	if err != nil {
+		return err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(common.ArgoCheckpointRestorePath), 0o755); err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	err = artDriver.Load(ctx, driverArt, common.ArgoCheckpointRestorePath)
+	if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		logger.Info(ctx, "No checkpoint to restore")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	logger.Info(ctx, "Successfully loaded checkpoint")
+	return nil
+}
+
+// SaveCheckpoint uploads the checkpoint archived by the main container, unless it has not changed since it was
+// last saved
+func (we *WorkflowExecutor) SaveCheckpoint(ctx context.Context) error {
+	if we.Template.Checkpoint == nil {
+		return nil
+	}
+	fi, err := os.Stat(common.ArgoCheckpointPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	if !fi.ModTime().After(we.checkpointModTime) {
+		return nil
+	}
+	driverArt, err := we.newCheckpointDriverArt()
+	if err != nil {
+		return err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	if err := artDriver.Save(ctx, common.ArgoCheckpointPath, driverArt); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	we.checkpointModTime = fi.ModTime()
+	logging.RequireLoggerFromContext(ctx).WithField("size", fi.Size()).Info(ctx, "Successfully saved checkpoint")
+	return nil
+}
+
+func (we *WorkflowExecutor) newCheckpointDriverArt() (*wfv1.Artifact, error) {
+	if !we.Template.Checkpoint.ArtifactLocation.HasLocation() {
+		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "checkpoint artifact location not set")
+	}
+	return we.newDriverArt(&wfv1.Artifact{Name: "checkpoint", ArtifactLocation: *we.Template.Checkpoint.ArtifactLocation})
+}
+
+// monitorCheckpoint saves the checkpoint whenever the main container archives a new one
+func (we *WorkflowExecutor) monitorCheckpoint(ctx context.Context) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	ticker := time.NewTicker(checkpointPollDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := we.SaveCheckpoint(ctx); err != nil {
+				logger.WithError(err).Warn(ctx, "failed to save checkpoint")
+			}
+		}
+	}
+}
+
+// ArchiveCheckpoint archives the checkpoint directory of the main container, so that the wait container can save it
+func ArchiveCheckpoint(ctx context.Context, checkpoint *wfv1.Checkpoint) error {
+	return archiveCheckpoint(ctx, checkpoint.Path, common.ArgoCheckpointPath)
+}
+
+func archiveCheckpoint(ctx context.Context, srcPath, dstPath string) error {
+	if _, err := os.Stat(srcPath); os.IsNotExist(err) {
+		// nothing has been written yet
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(dstPath), err)
+	}
+	// the archive is renamed into place, so the wait container never saves a partial archive
+	tmpPath := dstPath + ".tmp"
+	dst, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", tmpPath, err)
+	}
+	defer func() { _ = dst.Close() }()
+	if err := archive.TarGzToWriter(ctx, srcPath, gzip.DefaultCompression, dst); err != nil {
+		return fmt.Errorf("failed to tarball the checkpoint %s to %s: %w", srcPath, tmpPath, err)
+	}
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", tmpPath, err)
+	}
+	return os.Rename(tmpPath, dstPath)
+}
+
+// RestoreCheckpoint extracts the checkpoint loaded by the init container, if there is one, into the checkpoint
+// directory of the main container
+func RestoreCheckpoint(ctx context.Context, checkpoint *wfv1.Checkpoint) error {
+	return restoreCheckpoint(ctx, common.ArgoCheckpointRestorePath, checkpoint.Path)
+}
+
+func restoreCheckpoint(ctx context.Context, srcPath, dstPath string) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if _, err := os.Stat(srcPath); os.IsNotExist(err) {
+		logger.Info(ctx, "No checkpoint to restore")
+		return nil
+	}
+	if err := os.MkdirAll(dstPath, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dstPath, err)
+	}
+	// the checkpoint replaces any stale contents of the directory, as extracting does not overwrite existing files
+	entries, err := os.ReadDir(dstPath)
+	if err != nil {
+		return fmt.Errorf("failed to read directory %s: %w", dstPath, err)
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dstPath, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", entry.Name(), err)
+		}
+	}
+	// the archive contains the checkpoint directory itself, which may be a volume mount, so only its contents are
+	// extracted
+	if err := extractTar(srcPath, dstPath, 1); err != nil {
+		return fmt.Errorf("failed to extract checkpoint %s to %s: %w", srcPath, dstPath, err)
+	}
+	logger.WithField("path", dstPath).Info(ctx, "Successfully restored checkpoint")
+	return nil
+}
@@ -0,0 +1,52 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestCheckpoint(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpDir := t.TempDir()
+	srcPath := filepath.Join(tmpDir, "src")
+	archivePath := filepath.Join(tmpDir, "outputs", "checkpoint.tgz")
+	dstPath := filepath.Join(tmpDir, "dst")
+
+	t.Run("NothingToArchive", func(t *testing.T) {
+		require.NoError(t, archiveCheckpoint(ctx, srcPath, archivePath))
+		assert.NoFileExists(t, archivePath)
+	})
+	t.Run("NothingToRestore", func(t *testing.T) {
+		require.NoError(t, restoreCheckpoint(ctx, archivePath, dstPath))
+		assert.NoDirExists(t, dstPath)
+	})
+	t.Run("ArchiveAndRestore", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(srcPath, "epochs"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(srcPath, "state"), []byte("epoch=2"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(srcPath, "epochs", "2"), []byte("weights"), 0o600))
+		require.NoError(t, archiveCheckpoint(ctx, srcPath, archivePath))
+		assert.FileExists(t, archivePath)
+		assert.NoFileExists(t, archivePath+".tmp")
+
+		// the directory may already exist, e.g. as a volume mount, and contain stale files
+		require.NoError(t, os.MkdirAll(dstPath, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dstPath, "state"), []byte("epoch=1 and some more"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dstPath, "stale"), nil, 0o600))
+		require.NoError(t, restoreCheckpoint(ctx, archivePath, dstPath))
+
+		data, err := os.ReadFile(filepath.Join(dstPath, "state"))
+		require.NoError(t, err)
+		assert.Equal(t, "epoch=2", string(data))
+		data, err = os.ReadFile(filepath.Join(dstPath, "epochs", "2"))
+		require.NoError(t, err)
+		assert.Equal(t, "weights", string(data))
+		assert.NoDirExists(t, filepath.Join(dstPath, "src"))
+		assert.NoFileExists(t, filepath.Join(dstPath, "stale"))
+	})
+}
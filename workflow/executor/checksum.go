@@ -0,0 +1,102 @@
+package executor
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// artifactChecksum returns the SHA-256 checksum of the content of a staged artifact, e.g. "sha256:2c26b46b...".
+// Tar and zip archives are hashed by the names and contents of their entries, rather than their bytes, so that
+// metadata such as modification times do not change the checksum of the same content.
+func artifactChecksum(localArtPath string) (string, error) {
+	h := sha256.New()
+	err := hashArchive(h, localArtPath)
+	if err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashArchive(h hash.Hash, localArtPath string) error {
+	if fi, err := os.Stat(localArtPath); err != nil {
+		return err
+	} else if fi.IsDir() {
+		// the archive strategy is none and the artifact is a directory
+		return filepath.WalkDir(localArtPath, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(localArtPath, p)
+			if err != nil {
+				return err
+			}
+			return hashEntry(h, filepath.ToSlash(name), d.IsDir(), func() (io.ReadCloser, error) { return os.Open(filepath.Clean(p)) })
+		})
+	}
+	if zr, err := zip.OpenReader(filepath.Clean(localArtPath)); err == nil {
+		defer zr.Close()
+		for _, f := range zr.File {
+			if err := hashEntry(h, f.Name, f.FileInfo().IsDir(), f.Open); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	f, err := os.Open(filepath.Clean(localArtPath))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		// not an archive, e.g. the archive strategy is none
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		_, err = io.Copy(h, f)
+		return err
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = hashEntry(h, header.Name, header.Typeflag == tar.TypeDir, func() (io.ReadCloser, error) { return io.NopCloser(tr), nil })
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func hashEntry(h hash.Hash, name string, isDir bool, open func() (io.ReadCloser, error)) error {
+	_, _ = fmt.Fprintf(h, "%s\x00%t\x00", name, isDir)
+	if isDir {
+		return nil
+	}
+	r, err := open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(h, "\x00%d\x00", n)
+	return nil
+}
@@ -0,0 +1,55 @@
+package executor
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestArtifactChecksum(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpDir := t.TempDir()
+	srcPath := filepath.Join(tmpDir, "src")
+	require.NoError(t, os.MkdirAll(srcPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcPath, "data"), []byte("hello"), 0o600))
+
+	tgz := func(name string) string {
+		p := filepath.Join(tmpDir, name)
+		f, err := os.Create(p)
+		require.NoError(t, err)
+		defer f.Close()
+		require.NoError(t, archive.TarGzToWriter(ctx, srcPath, gzip.DefaultCompression, f))
+		return p
+	}
+
+	t.Run("File", func(t *testing.T) {
+		checksum, err := artifactChecksum(filepath.Join(srcPath, "data"))
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", checksum)
+	})
+	t.Run("ModificationTime", func(t *testing.T) {
+		before, err := artifactChecksum(tgz("before.tgz"))
+		require.NoError(t, err)
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(srcPath, "data"), later, later))
+		after, err := artifactChecksum(tgz("after.tgz"))
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+	t.Run("Content", func(t *testing.T) {
+		before, err := artifactChecksum(tgz("before.tgz"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(srcPath, "data"), []byte("goodbye"), 0o600))
+		after, err := artifactChecksum(tgz("after.tgz"))
+		require.NoError(t, err)
+		assert.NotEqual(t, before, after)
+	})
+}
@@ -33,6 +33,7 @@ In the main container, the emissary creates these files:
 * `/var/run/argo/ctr/${containerName}/exitcode` The container exit code.
 * `/var/run/argo/ctr/${containerName}/combined` A copy of stdout+stderr (if needed).
 * `/var/run/argo/ctr/${containerName}/stdout`  A copy of stdout (if needed).
+* `/var/run/argo/ctr/${containerName}/resource-usage` The resource usage of the container, measured by its cgroup (main containers only).
 
 If the container is named `main` it also copies base-layer artifacts to the shared volume:
 
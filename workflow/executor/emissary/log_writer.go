@@ -0,0 +1,103 @@
+package emissary
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// maxLogLineSize is the size of the longest line the log writer buffers, longer lines are split
+const maxLogLineSize = 64 * 1024
+
+// LogFields returns the fields that correlate the logs of a container with the logs of the controller, from the
+// environment variables of the container
+func LogFields() map[string]any {
+	fields := map[string]any{}
+	for key, envVar := range map[string]string{
+		"workflow":  common.EnvVarWorkflowName,
+		"namespace": common.EnvVarWorkflowNamespace,
+		"uid":       common.EnvVarWorkflowUID,
+		"nodeID":    common.EnvVarNodeID,
+		"nodeName":  common.EnvVarNodeName,
+		"template":  common.EnvVarTemplateName,
+		"container": common.EnvVarContainerName,
+	} {
+		if v := os.Getenv(envVar); v != "" {
+			fields[key] = v
+		}
+	}
+	if v, err := strconv.Atoi(os.Getenv(common.EnvVarRetryAttempt)); err == nil {
+		fields["retryAttempt"] = v
+	}
+	return fields
+}
+
+type logWriter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	stream string
+	fields map[string]any
+	buf    []byte
+}
+
+// NewLogWriter returns a writer that writes each line written to it to w as a JSON object, with the time, the stream
+// and the fields. Call Close to write the last line if it does not end with a new line.
+func NewLogWriter(w io.Writer, stream string, fields map[string]any) io.WriteCloser {
+	return &logWriter{w: w, stream: stream, fields: fields}
+}
+
+func (l *logWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buf = append(l.buf, p...)
+	for {
+		i := bytes.IndexByte(l.buf, '\n')
+		if i < 0 && len(l.buf) < maxLogLineSize {
+			return len(p), nil
+		}
+		if i < 0 || i > maxLogLineSize {
+			i = maxLogLineSize
+		}
+		line := l.buf[:i]
+		if i < len(l.buf) && l.buf[i] == '\n' {
+			i++
+		}
+		if err := l.writeLine(line); err != nil {
+			return 0, err
+		}
+		l.buf = l.buf[i:]
+	}
+}
+
+func (l *logWriter) writeLine(line []byte) error {
+	entry := make(map[string]any, len(l.fields)+3)
+	for k, v := range l.fields {
+		entry[k] = v
+	}
+	entry["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["stream"] = l.stream
+	entry["msg"] = string(bytes.TrimSuffix(line, []byte("\r")))
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = l.w.Write(append(data, '\n'))
+	return err
+}
+
+func (l *logWriter) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.buf) == 0 {
+		return nil
+	}
+	err := l.writeLine(l.buf)
+	l.buf = nil
+	return err
+}
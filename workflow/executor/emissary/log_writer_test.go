@@ -0,0 +1,72 @@
+package emissary
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestLogFields(t *testing.T) {
+	t.Setenv(common.EnvVarWorkflowName, "my-wf")
+	t.Setenv(common.EnvVarWorkflowNamespace, "argo")
+	t.Setenv(common.EnvVarNodeID, "my-wf-123")
+	t.Setenv(common.EnvVarTemplateName, "main")
+	t.Setenv(common.EnvVarRetryAttempt, "2")
+	fields := LogFields()
+	assert.Equal(t, "my-wf", fields["workflow"])
+	assert.Equal(t, "argo", fields["namespace"])
+	assert.Equal(t, "my-wf-123", fields["nodeID"])
+	assert.Equal(t, "main", fields["template"])
+	assert.Equal(t, 2, fields["retryAttempt"])
+	assert.NotContains(t, fields, "nodeName")
+}
+
+func TestLogWriter(t *testing.T) {
+	entries := func(t *testing.T, out *bytes.Buffer) []map[string]any {
+		t.Helper()
+		var entries []map[string]any
+		for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+			entry := map[string]any{}
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	t.Run("Lines", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		w := NewLogWriter(out, "stdout", map[string]any{"workflow": "my-wf", "retryAttempt": 1})
+		_, err := w.Write([]byte("hello\nwor"))
+		require.NoError(t, err)
+		_, err = w.Write([]byte("ld\r\nlast"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		got := entries(t, out)
+		require.Len(t, got, 3)
+		assert.Equal(t, "hello", got[0]["msg"])
+		assert.Equal(t, "world", got[1]["msg"])
+		assert.Equal(t, "last", got[2]["msg"])
+		assert.Equal(t, "stdout", got[0]["stream"])
+		assert.Equal(t, "my-wf", got[0]["workflow"])
+		assert.InDelta(t, 1.0, got[0]["retryAttempt"], 0)
+		assert.NotEmpty(t, got[0]["time"])
+	})
+
+	t.Run("LongLine", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		w := NewLogWriter(out, "stderr", nil)
+		_, err := w.Write([]byte(strings.Repeat("x", maxLogLineSize+10) + "\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		got := entries(t, out)
+		require.Len(t, got, 2)
+		assert.Len(t, got[0]["msg"], maxLogLineSize)
+		assert.Len(t, got[1]["msg"], 10)
+	})
+}
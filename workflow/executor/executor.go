@@ -44,6 +44,7 @@ import (
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
 	executorretry "github.com/argoproj/argo-workflows/v3/workflow/executor/retry"
 )
 
@@ -67,6 +68,8 @@ type WorkflowExecutor struct {
 	RESTClient          rest.Interface
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
+	// Metrics records the metrics of the executor, if they are pushed
+	Metrics *executormetrics.Metrics
 
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
@@ -78,12 +81,21 @@ type WorkflowExecutor struct {
 
 	// current progress which is synced every `annotationPatchTickDuration` to the pods annotations.
 	progress wfv1.Progress
+	// current progress detail, synced with progress
+	progressDetail *wfv1.ProgressDetail
 
 	annotationPatchTickDuration  time.Duration
 	readProgressFileTickDuration time.Duration
 
 	// flag to indicate if the task result was created
 	taskResultCreated bool
+
+	// modification time of the last checkpoint that was saved
+	checkpointModTime time.Time
+
+	// the outputs results file, and the error reading it, once it has been read
+	results    *Results
+	resultsErr error
 }
 
 type Initializer interface {
@@ -222,6 +234,7 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
 			return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
 		}
+		startTime := time.Now()
 		err = artDriver.Load(ctx, driverArt, tempArtPath)
 		if err != nil {
 			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
@@ -230,6 +243,7 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
+		we.recordArtifactTransfer(ctx, driverArt, executormetrics.ArtifactDownload, tempArtPath, startTime)
 
 		isTar := false
 		isZip := false
@@ -323,6 +337,16 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 
 	aggregateError := ""
 	for _, art := range we.Template.Outputs.Artifacts {
+		if art.ResultsKey != "" {
+			if err := we.resolveResultsArtifactPath(ctx, &art); err != nil {
+				if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+					logger.WithField("name", art.Name).WithError(err).Warn(ctx, "Ignoring optional artifact which is not in the results file")
+					continue
+				}
+				aggregateError += err.Error() + "; "
+				continue
+			}
+		}
 		saved, err := we.saveArtifact(ctx, common.MainContainerName, &art)
 		if err != nil {
 			aggregateError += err.Error() + "; "
@@ -363,6 +387,12 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if size == 0 {
 		logger.WithField("path", localArtPath).Warn(ctx, "The file is empty. It may not be uploaded successfully depending on the artifact driver")
 	}
+	// the checksum keys the artifact by its content in the memoization keys of the steps that use it, which may be
+	// memoized even if this one is not
+	art.Checksum, err = artifactChecksum(localArtPath)
+	if err != nil {
+		logger.WithField("name", art.Name).WithError(err).Warn(ctx, "Failed to compute the checksum of the artifact, it will be keyed by its location")
+	}
 	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 	return err == nil, err
 }
@@ -393,10 +423,12 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	startTime := time.Now()
 	err = artDriver.Save(ctx, localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	we.recordArtifactTransfer(ctx, driverArt, executormetrics.ArtifactUpload, localArtPath, startTime)
 	we.maybeDeleteLocalArtPath(ctx, localArtPath)
 	logging.RequireLoggerFromContext(ctx).WithField("path", localArtPath).Info(ctx, "Successfully saved file")
 	return nil
@@ -573,8 +605,16 @@ func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
 	return true
 }
 
-// SaveParameters will save the content in the specified file path as output parameter value
+// SaveParameters saves the values of the output parameters in the template
 func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
+	err := we.saveParameters(ctx)
+	if err != nil {
+		we.Metrics.ParameterSaveFailed(ctx)
+	}
+	return err
+}
+
+func (we *WorkflowExecutor) saveParameters(ctx context.Context) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	if len(we.Template.Outputs.Parameters) == 0 {
 		logger.Info(ctx, "No output parameters")
@@ -584,12 +624,25 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 	for i, param := range we.Template.Outputs.Parameters {
 		logger.WithField("name", param.Name).Info(ctx, "Saving path output parameter")
 		// Determine the file path of where to find the parameter
-		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
+		if param.ValueFrom == nil || (param.ValueFrom.Path == "" && param.ValueFrom.ResultsKey == "") {
 			continue
 		}
 
 		var output *wfv1.AnyString
-		if we.isBaseImagePath(param.ValueFrom.Path) {
+		if param.ValueFrom.ResultsKey != "" {
+			logger.WithField("key", param.ValueFrom.ResultsKey).Info(ctx, "Copying from results file")
+			value, err := we.resultsParameter(ctx, param)
+			if err != nil {
+				// We have a default value to use instead of returning an error
+				if param.ValueFrom.Default != nil && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+					output = param.ValueFrom.Default
+				} else {
+					return err
+				}
+			} else {
+				output = wfv1.AnyStringPtr(value)
+			}
+		} else if we.isBaseImagePath(param.ValueFrom.Path) {
 			logger.WithField("path", param.ValueFrom.Path).Info(ctx, "Copying from base image layer")
 			fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, param.ValueFrom.Path)
 			if err != nil {
@@ -620,6 +673,11 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 
 		// Trims off a single newline for user convenience
 		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
+		if param.Schema != nil {
+			if err := param.Schema.Validate(output.String()); err != nil {
+				return argoerrs.Errorf(argoerrs.CodeBadRequest, "outputs.parameters.%s %s", param.Name, err.Error())
+			}
+		}
 		we.Template.Outputs.Parameters[i].Value = output
 		logger.WithField("name", param.Name).Info(ctx, "Successfully saved output parameter")
 	}
@@ -878,7 +936,7 @@ func (we *WorkflowExecutor) InitializeOutput(ctx context.Context) {
 func (we *WorkflowExecutor) ReportOutputs(ctx context.Context, artifacts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
 	outputs.Artifacts = artifacts
-	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs})
+	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs, ResourceUsage: we.readResourceUsage(ctx)})
 }
 
 // ReportOutputsLogs updates the WorkflowTaskResult log fields
@@ -968,61 +1026,73 @@ func isTarball(ctx context.Context, filePath string) (bool, error) {
 // untar extracts a tarball to a temporary directory,
 // renaming it to the desired location
 func untar(tarPath string, destPath string) error {
-	decompressor := func(src string, dest string) error {
-		f, err := os.Open(src)
-		if err != nil {
+	return unpack(tarPath, destPath, func(src string, dest string) error {
+		return extractTar(src, dest, 0)
+	})
+}
+
+// extractTar extracts a tarball into a directory, removing the given number of leading
+// path components from the names of its files
+func extractTar(src string, dest string, stripComponents int) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gzr, err := file.GetGzipReader(f)
+	if err != nil {
+		return err
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
 			return err
+		case header == nil:
+			continue
 		}
-		defer f.Close()
-		gzr, err := file.GetGzipReader(f)
-		if err != nil {
+		name := filepath.Clean(header.Name)
+		if stripComponents > 0 {
+			parts := strings.SplitN(filepath.ToSlash(name), "/", stripComponents+1)
+			if len(parts) <= stripComponents {
+				continue
+			}
+			name = parts[stripComponents]
+		}
+		target := filepath.Join(dest, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil && os.IsExist(err) {
 			return err
 		}
-		defer gzr.Close()
-		tr := tar.NewReader(gzr)
-		for {
-			header, err := tr.Next()
-			switch {
-			case err == io.EOF:
-				return nil
-			case err != nil:
+		switch header.Typeflag {
+		case tar.TypeSymlink:
+			err := os.Symlink(header.Linkname, target)
+			if err != nil {
 				return err
-			case header == nil:
-				continue
 			}
-			target := filepath.Join(dest, filepath.Clean(header.Name))
-			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil && os.IsExist(err) {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
 				return err
 			}
-			switch header.Typeflag {
-			case tar.TypeSymlink:
-				err := os.Symlink(header.Linkname, target)
-				if err != nil {
-					return err
-				}
-			case tar.TypeDir:
-				if err := os.MkdirAll(target, 0o755); err != nil {
-					return err
-				}
-			case tar.TypeReg:
-				f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
-				if err != nil {
-					return err
-				}
-				if _, err := io.Copy(f, tr); err != nil {
-					return err
-				}
-				if err := f.Close(); err != nil {
-					return err
-				}
-				if err := os.Chtimes(target, header.AccessTime, header.ModTime); err != nil {
-					return err
-				}
+		case tar.TypeReg:
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			if err := os.Chtimes(target, header.AccessTime, header.ModTime); err != nil {
+				return err
 			}
 		}
 	}
-
-	return unpack(tarPath, destPath, decompressor)
 }
 
 // unzip extracts a zip folder to a temporary directory,
@@ -1168,13 +1238,32 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 	containerNames := we.Template.GetMainContainerNames()
 	// only monitor progress if both tick durations are >0
 	if we.annotationPatchTickDuration != 0 && we.readProgressFileTickDuration != 0 {
-		go we.monitorProgress(ctx, os.Getenv(common.EnvVarProgressFile))
+		var updates chan progressUpdate
+		if port := os.Getenv(common.EnvVarProgressPort); port != "" {
+			updates = make(chan progressUpdate)
+			go serveProgress(ctx, port, updates)
+		}
+		go we.monitorProgress(ctx, os.Getenv(common.EnvVarProgressFile), updates)
 	} else {
 		logger.WithField("annotationPatchTickDuration", we.annotationPatchTickDuration).WithField("readProgressFileTickDuration", we.readProgressFileTickDuration).Info(ctx, "monitoring progress disabled")
 	}
 
 	go we.monitorDeadline(ctx, containerNames)
 
+	if we.Template.Checkpoint != nil {
+		checkpointCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			we.monitorCheckpoint(checkpointCtx)
+		}()
+		// stop saving checkpoints before returning, so they are not saved concurrently with the final checkpoint
+		defer func() {
+			cancel()
+			<-done
+		}()
+	}
+
 	err := retryutil.OnError(executorretry.ExecutorRetry(ctx), func(err error) bool {
 		return errorsutil.IsTransientErr(ctx, err)
 	}, func() error {
@@ -1192,10 +1281,11 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 // monitorProgress monitors for self-reported progress in the progressFile and patches the pod annotations with the parsed progress.
 //
 // The function reads the last line of the `progressFile` every `readFileTickDuration`.
-// If the line matches `N/M`, will set the progress annotation to the parsed progress value.
+// If the line matches `N/M`, or is a JSON progress detail, will set the progress annotation to the parsed progress value.
+// Progress received from updates, which are reported to the progress endpoint, is set in the same way.
 // Every `annotationPatchTickDuration` the pod is patched with the updated annotations. This way the controller
 // gets notified of new self reported progress.
-func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile string) {
+func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile string, updates <-chan progressUpdate) {
 	logger := logging.RequireLoggerFromContext(ctx)
 	annotationPatchTicker := time.NewTicker(we.annotationPatchTickDuration)
 	defer annotationPatchTicker.Stop()
@@ -1211,11 +1301,16 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 			logger.WithError(ctx.Err()).Info(ctx, "stopping progress monitor (context done)")
 			return
 		case <-annotationPatchTicker.C:
-			if err := we.reportResult(ctx, wfv1.NodeResult{Progress: we.progress}); err != nil {
+			if err := we.reportResult(ctx, wfv1.NodeResult{Progress: we.progress, ProgressDetail: we.progressDetail}); err != nil {
 				logger.WithError(err).Info(ctx, "failed to report progress")
 			} else {
 				we.progress = ""
+				we.progressDetail = nil
 			}
+		case update := <-updates:
+			logger.WithField("progress", update.progress).Info(ctx, "")
+			we.progress = update.progress
+			we.progressDetail = update.detail
 		case <-fileTicker.C:
 			data, err := os.ReadFile(progressFile)
 			if err != nil {
@@ -1232,11 +1327,12 @@ func (we *WorkflowExecutor) monitorProgress(ctx context.Context, progressFile st
 			}
 			lastLine = mostRecent
 
-			if progress, ok := wfv1.ParseProgress(lastLine); ok {
-				logger.WithField("progress", progress).Info(ctx, "")
-				we.progress = progress
+			if update, err := parseProgressUpdate(lastLine); err == nil {
+				logger.WithField("progress", update.progress).Info(ctx, "")
+				we.progress = update.progress
+				we.progressDetail = update.detail
 			} else {
-				logger.WithField("line", lastLine).Info(ctx, "unable to parse progress")
+				logger.WithError(err).WithField("line", lastLine).Info(ctx, "unable to parse progress")
 			}
 		}
 	}
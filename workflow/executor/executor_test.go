@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -20,7 +21,9 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	executormetrics "github.com/argoproj/argo-workflows/v3/workflow/executor/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
 
@@ -116,6 +119,39 @@ func TestSaveParameters(t *testing.T) {
 	assert.Equal(t, "has a newline", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestSaveParametersSchema(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName: fakePodName,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{
+				Parameters: []wfv1.Parameter{
+					{
+						Name:      "my-out",
+						ValueFrom: &wfv1.ValueFrom{Path: "/path"},
+						Schema:    &wfv1.ParameterSchema{Type: "integer"},
+					},
+				},
+			},
+		},
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/path").Return("not a number\n", nil)
+	ctx := logging.TestContext(t.Context())
+	metrics, te, err := executormetrics.CreateTestMetrics(ctx, fakeNamespace)
+	require.NoError(t, err)
+	we.Metrics = metrics
+
+	err = we.SaveParameters(ctx)
+	require.EqualError(t, err, `outputs.parameters.my-out value "not a number" is not an integer`)
+	attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, fakeNamespace))
+	val, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentExecutorParameterSaveErrorsTotal.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+}
+
 // TestIsBaseImagePath tests logic of isBaseImagePath which determines if a path is coming from a
 // base image layer versus a shared volumeMount.
 func TestIsBaseImagePath(t *testing.T) {
@@ -523,7 +559,8 @@ func TestMonitorProgress(t *testing.T) {
 		readProgressFileTickDuration,
 	)
 
-	go we.monitorProgress(ctx, progressFile)
+	updates := make(chan progressUpdate)
+	go we.monitorProgress(ctx, progressFile, updates)
 
 	err := os.WriteFile(progressFile, []byte("100/100\n"), os.ModePerm)
 	require.NoError(t, err)
@@ -535,6 +572,16 @@ func TestMonitorProgress(t *testing.T) {
 	assert.Equal(t, fakeWorkflow, result.Labels[common.LabelKeyWorkflow])
 	assert.Len(t, result.OwnerReferences, 1)
 	assert.Equal(t, wfv1.Progress("100/100"), result.Progress)
+
+	detail := &wfv1.ProgressDetail{Percent: 50, Message: "training"}
+	updates <- progressUpdate{progress: detail.Progress(), detail: detail}
+
+	time.Sleep(time.Second)
+
+	result, err = taskResults.Get(ctx, fakeNodeID, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.Progress("50/100"), result.Progress)
+	assert.Equal(t, detail, result.ProgressDetail)
 }
 
 func TestSaveLogs(t *testing.T) {
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// Directions of artifact transfers
+const (
+	ArtifactUpload   = "upload"
+	ArtifactDownload = "download"
+)
+
+// Stages of the executor that are overhead of the pod
+const (
+	StageInit = "init"
+	StageWait = "wait"
+)
+
+// Metrics are the metrics of the executor. A nil Metrics records nothing.
+type Metrics struct {
+	*telemetry.Metrics
+
+	namespace string
+}
+
+// New creates the metrics of the executor of the pod in the namespace, which are pushed with OTLP if the
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT environment variable is set. Otherwise it returns
+// nil, unless extraOpts are given, which are for tests to read the metrics.
+func New(ctx context.Context, serviceName, namespace, podName string, extraOpts ...metricsdk.Option) (*Metrics, error) {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpMetricsEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_METRICS_ENDPOINT`)
+	if !otlpEnabled && !otlpMetricsEnabled && len(extraOpts) == 0 {
+		return nil, nil
+	}
+
+	// the executor is short-lived and many run at once, so each pushes the changes since its last push, rather than
+	// totals that would reset with every pod. The pod is a resource attribute, so that the pushes of different pods
+	// are different series, which the collector or backend sums.
+	config := &telemetry.Config{
+		Temporality: func(metricsdk.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		},
+		ShortLived: true,
+		ResourceAttributes: []attribute.KeyValue{
+			semconv.K8SNamespaceName(namespace),
+			semconv.K8SPodName(podName),
+		},
+	}
+	m, err := telemetry.NewMetrics(ctx, serviceName, serviceName, config, extraOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &Metrics{
+		Metrics:   m,
+		namespace: namespace,
+	}
+	for _, instrument := range []telemetry.BuiltinInstrument{
+		telemetry.InstrumentExecutorArtifactBytes,
+		telemetry.InstrumentExecutorArtifactDuration,
+		telemetry.InstrumentExecutorParameterSaveErrorsTotal,
+		telemetry.InstrumentExecutorPodOverhead,
+	} {
+		if err := m.CreateBuiltinInstrument(instrument); err != nil {
+			return nil, err
+		}
+	}
+	return metrics, nil
+}
+
+// ArtifactTransferred records an artifact of size bytes uploaded or downloaded with the driver
+func (m *Metrics) ArtifactTransferred(ctx context.Context, driver, direction string, size int64, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	attribs := telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: m.namespace},
+		{Name: telemetry.AttribArtifactDriver, Value: driver},
+		{Name: telemetry.AttribArtifactDirection, Value: direction},
+	}
+	m.AddInt(ctx, telemetry.InstrumentExecutorArtifactBytes.Name(), size, attribs)
+	m.Record(ctx, telemetry.InstrumentExecutorArtifactDuration.Name(), duration.Seconds(), attribs)
+}
+
+// ParameterSaveFailed records an output parameter that failed to save
+func (m *Metrics) ParameterSaveFailed(ctx context.Context) {
+	if m == nil {
+		return
+	}
+	m.AddInt(ctx, telemetry.InstrumentExecutorParameterSaveErrorsTotal.Name(), 1, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: m.namespace},
+	})
+}
+
+// PodOverhead records the time spent in a stage of the executor
+func (m *Metrics) PodOverhead(ctx context.Context, stage string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.Record(ctx, telemetry.InstrumentExecutorPodOverhead.Name(), duration.Seconds(), telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: m.namespace},
+		{Name: telemetry.AttribExecutorStage, Value: stage},
+	})
+}
+
+// Shutdown pushes the metrics that have not been pushed yet and stops the metrics
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+	return m.Metrics.Shutdown(ctx)
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func TestNew(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+
+	t.Run("NotPushed", func(t *testing.T) {
+		m, err := New(ctx, telemetry.TestScopeName, "argo", "my-pod")
+		require.NoError(t, err)
+		assert.Nil(t, m)
+		// a nil Metrics records nothing
+		m.PodOverhead(ctx, StageInit, time.Second)
+		m.ParameterSaveFailed(ctx)
+		require.NoError(t, m.Shutdown(ctx))
+	})
+
+	t.Run("PodOverhead", func(t *testing.T) {
+		m, te, err := CreateTestMetrics(ctx, "argo")
+		require.NoError(t, err)
+		m.PodOverhead(ctx, StageWait, 3*time.Second)
+		attribs := attribute.NewSet(
+			attribute.String(telemetry.AttribWorkflowNamespace, "argo"),
+			attribute.String(telemetry.AttribExecutorStage, StageWait),
+		)
+		data, err := te.GetFloat64HistogramData(ctx, telemetry.InstrumentExecutorPodOverhead.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), data.Count)
+		assert.InDelta(t, 3.0, data.Sum, 0.001)
+		require.NoError(t, m.Shutdown(ctx))
+	})
+
+	t.Run("Resource", func(t *testing.T) {
+		global := otel.GetMeterProvider()
+		m, te, err := CreateTestMetrics(ctx, "argo")
+		require.NoError(t, err)
+		// the executor does not replace the global meter provider, which starts the runtime metrics
+		assert.Equal(t, global, otel.GetMeterProvider())
+		m.ParameterSaveFailed(ctx)
+		data := metricdata.ResourceMetrics{}
+		require.NoError(t, te.Collect(ctx, &data))
+		pod, ok := data.Resource.Set().Value(semconv.K8SPodNameKey)
+		require.True(t, ok)
+		assert.Equal(t, "test-pod", pod.AsString())
+		namespace, ok := data.Resource.Set().Value(semconv.K8SNamespaceNameKey)
+		require.True(t, ok)
+		assert.Equal(t, "argo", namespace.AsString())
+		require.NoError(t, m.Shutdown(ctx))
+	})
+}
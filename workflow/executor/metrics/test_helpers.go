@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// CreateTestMetrics creates metrics for the namespace that are read by the returned test exporter instead of being
+// pushed
+func CreateTestMetrics(ctx context.Context, namespace string) (*Metrics, *telemetry.TestMetricsExporter, error) {
+	te := telemetry.NewTestMetricsExporter()
+	m, err := New(ctx, telemetry.TestScopeName, namespace, "test-pod", metric.WithReader(te))
+	return m, te, err
+}
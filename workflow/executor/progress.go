@@ -0,0 +1,97 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// maxProgressBodySize is the maximum size of a progress report sent to the progress endpoint
+const maxProgressBodySize = 64 * 1024
+
+// progressUpdate is progress self-reported by the main container, either as N/M or in detail
+type progressUpdate struct {
+	progress wfv1.Progress
+	detail   *wfv1.ProgressDetail
+}
+
+// parseProgressUpdate parses a progress report, which is either `N/M` or a JSON progress detail, e.g.
+// `{"percent": 40, "message": "loading shard 4", "stages": [{"name": "download", "percent": 100}, {"name": "train"}]}`
+func parseProgressUpdate(s string) (progressUpdate, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "{") {
+		detail := &wfv1.ProgressDetail{}
+		if err := json.Unmarshal([]byte(s), detail); err != nil {
+			return progressUpdate{}, fmt.Errorf("invalid progress detail: %w", err)
+		}
+		if !detail.IsValid() {
+			return progressUpdate{}, errors.New("invalid progress detail: percentages must be between 0 and 100, and stages must be named")
+		}
+		return progressUpdate{progress: detail.Progress(), detail: detail}, nil
+	}
+	progress, ok := wfv1.ParseProgress(s)
+	if !ok {
+		return progressUpdate{}, fmt.Errorf("invalid progress %q: must be N/M or a JSON progress detail", s)
+	}
+	return progressUpdate{progress: progress}, nil
+}
+
+// progressHandler accepts progress reports POSTed to it and sends them to updates
+func progressHandler(ctx context.Context, updates chan<- progressUpdate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxProgressBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		update, err := parseProgressUpdate(string(data))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		select {
+		case updates <- update:
+			w.WriteHeader(http.StatusNoContent)
+		case <-ctx.Done():
+			http.Error(w, "executor is stopping", http.StatusServiceUnavailable)
+		case <-r.Context().Done():
+		}
+	})
+}
+
+// serveProgress serves the progress endpoint on the port of localhost until the context is done, so the main
+// container can report its progress with e.g. `curl -d 3/10 localhost:$ARGO_PROGRESS_PORT/progress`
+func serveProgress(ctx context.Context, port string, updates chan<- progressUpdate) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	mux := http.NewServeMux()
+	mux.Handle("/progress", progressHandler(ctx, updates))
+	server := &http.Server{
+		Addr:              net.JoinHostPort("localhost", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	logger.WithField("addr", server.Addr).Info(ctx, "serving progress endpoint")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.WithError(err).Error(ctx, "failed to serve progress endpoint")
+	}
+}
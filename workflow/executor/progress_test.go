@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParseProgressUpdate(t *testing.T) {
+	update, err := parseProgressUpdate(" 3/10\n")
+	require.NoError(t, err)
+	assert.Equal(t, progressUpdate{progress: "3/10"}, update)
+
+	update, err = parseProgressUpdate(`{"message": "loading shard 4", "stages": [{"name": "download", "percent": 100}, {"name": "train", "percent": 20}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.Progress("60/100"), update.progress)
+	assert.Equal(t, &wfv1.ProgressDetail{
+		Message: "loading shard 4",
+		Stages:  []wfv1.ProgressStage{{Name: "download", Percent: 100}, {Name: "train", Percent: 20}},
+	}, update.detail)
+
+	_, err = parseProgressUpdate("3/")
+	require.ErrorContains(t, err, "must be N/M or a JSON progress detail")
+	_, err = parseProgressUpdate(`{"percent": 200}`)
+	require.ErrorContains(t, err, "percentages must be between 0 and 100")
+	_, err = parseProgressUpdate(`{"percent": `)
+	require.ErrorContains(t, err, "invalid progress detail")
+}
+
+func TestProgressHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	updates := make(chan progressUpdate, 1)
+	handler := progressHandler(ctx, updates)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/progress", strings.NewReader(`{"percent": 40}`)))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	update := <-updates
+	assert.Equal(t, wfv1.Progress("40/100"), update.progress)
+	assert.Equal(t, &wfv1.ProgressDetail{Percent: 40}, update.detail)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/progress", strings.NewReader("foo")))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/progress", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,227 @@
+package executor
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// resourceUsageSamplePeriod is how often the memory usage is sampled, when the cgroup does not record the peak itself
+var resourceUsageSamplePeriod = 5 * time.Second
+
+// ResourceUsageSampler measures the resource usage of the current container, using its cgroup. As the cgroup
+// namespace of a container is private, only the container itself can read it, so it is run by the emissary.
+type ResourceUsageSampler struct {
+	root            string
+	mu              sync.Mutex
+	memoryPeakBytes int64
+}
+
+func NewResourceUsageSampler() *ResourceUsageSampler {
+	return &ResourceUsageSampler{root: "/sys/fs/cgroup"}
+}
+
+// Run samples the memory usage until the context is done
+func (s *ResourceUsageSampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(resourceUsageSamplePeriod)
+	defer ticker.Stop()
+	for {
+		s.sampleMemory()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ResourceUsageSampler) sampleMemory() {
+	path := filepath.Join(s.root, "memory", "memory.usage_in_bytes")
+	if s.isV2() {
+		path = filepath.Join(s.root, "memory.current")
+	}
+	current, err := readInt(path)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.memoryPeakBytes = max(s.memoryPeakBytes, current)
+}
+
+func (s *ResourceUsageSampler) isV2() bool {
+	_, err := os.Stat(filepath.Join(s.root, "cgroup.controllers"))
+	return err == nil
+}
+
+// Usage returns the resource usage of the container so far
+func (s *ResourceUsageSampler) Usage(name string) (*wfv1.ContainerResourceUsage, error) {
+	s.sampleMemory()
+	usage := &wfv1.ContainerResourceUsage{Name: name}
+	var errs []error
+	if s.isV2() {
+		// https://docs.kernel.org/admin-guide/cgroup-v2.html
+		usec, err := readKey(filepath.Join(s.root, "cpu.stat"), "usage_usec")
+		usage.CPUMilliseconds = usec / 1000
+		errs = append(errs, err)
+		// memory.peak is only available from Linux 5.19
+		usage.MemoryPeakBytes, _ = readInt(filepath.Join(s.root, "memory.peak"))
+		usage.IOReadBytes, usage.IOWriteBytes, err = readIOStatV2(filepath.Join(s.root, "io.stat"))
+		errs = append(errs, err)
+	} else {
+		// https://docs.kernel.org/admin-guide/cgroup-v1/index.html
+		nsec, err := readInt(filepath.Join(s.root, "cpuacct", "cpuacct.usage"))
+		usage.CPUMilliseconds = nsec / int64(time.Millisecond)
+		errs = append(errs, err)
+		usage.MemoryPeakBytes, _ = readInt(filepath.Join(s.root, "memory", "memory.max_usage_in_bytes"))
+		usage.IOReadBytes, usage.IOWriteBytes, err = readIOStatV1(filepath.Join(s.root, "blkio", "blkio.throttle.io_service_bytes"))
+		errs = append(errs, err)
+	}
+	s.mu.Lock()
+	usage.MemoryPeakBytes = max(usage.MemoryPeakBytes, s.memoryPeakBytes)
+	s.mu.Unlock()
+	if usage.MemoryPeakBytes == 0 {
+		errs = append(errs, errors.New("failed to read memory usage"))
+	}
+	// only fail if nothing could be read, e.g. because this is not Linux
+	if err := errors.Join(errs...); err != nil && usage.CPUMilliseconds == 0 && usage.MemoryPeakBytes == 0 {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// WriteResourceUsage writes the resource usage of the container, so that the wait container can report it
+func WriteResourceUsage(usage *wfv1.ContainerResourceUsage) error {
+	data, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(resourceUsagePath(usage.Name), data, 0o644)
+}
+
+func resourceUsagePath(containerName string) string {
+	return filepath.Join(common.VarRunArgoPath, "ctr", containerName, "resource-usage")
+}
+
+// readResourceUsage reads the resource usage written by each of the main containers
+func (we *WorkflowExecutor) readResourceUsage(ctx context.Context) []wfv1.ContainerResourceUsage {
+	logger := logging.RequireLoggerFromContext(ctx)
+	var usages []wfv1.ContainerResourceUsage
+	for _, containerName := range we.Template.GetMainContainerNames() {
+		data, err := os.ReadFile(filepath.Clean(resourceUsagePath(containerName)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			logger.WithError(err).WithField("containerName", containerName).Warn(ctx, "failed to read resource usage")
+			continue
+		}
+		usage := wfv1.ContainerResourceUsage{}
+		if err := json.Unmarshal(data, &usage); err != nil {
+			logger.WithError(err).WithField("containerName", containerName).Warn(ctx, "failed to unmarshal resource usage")
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+func readInt(path string) (int64, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// readKey reads the value of a key from a flat keyed file, e.g. cpu.stat
+func readKey(path, key string) (int64, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == key {
+			return strconv.ParseInt(fields[1], 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("%s not found in %s", key, path)
+}
+
+// readIOStatV2 sums the bytes read and written to each device, e.g. "8:0 rbytes=1024 wbytes=2048 rios=1 wios=2"
+func readIOStatV2(path string) (read, write int64, err error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				read += n
+			case "wbytes":
+				write += n
+			}
+		}
+	}
+	return read, write, scanner.Err()
+}
+
+// readIOStatV1 sums the bytes read and written to each device, e.g. "8:0 Read 1024"
+func readIOStatV1(path string) (read, write int64, err error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			continue
+		}
+		n, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[1] {
+		case "Read":
+			read += n
+		case "Write":
+			write += n
+		}
+	}
+	return read, write, scanner.Err()
+}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func writeCgroupFiles(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+	return root
+}
+
+func TestResourceUsageSampler_Usage(t *testing.T) {
+	t.Run("V2", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{
+			"cgroup.controllers": "cpu io memory pids",
+			"cpu.stat":           "usage_usec 1500000\nuser_usec 1000000\nsystem_usec 500000\n",
+			"memory.current":     "1024\n",
+			"memory.peak":        "4096\n",
+			"io.stat":            "8:0 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n8:16 rbytes=1000 wbytes=2000 rios=1 wios=2 dbytes=0 dios=0\n",
+		})
+		usage, err := (&ResourceUsageSampler{root: root}).Usage("main")
+		require.NoError(t, err)
+		assert.Equal(t, &wfv1.ContainerResourceUsage{Name: "main", CPUMilliseconds: 1500, MemoryPeakBytes: 4096, IOReadBytes: 1100, IOWriteBytes: 2200}, usage)
+	})
+	t.Run("V2WithoutMemoryPeak", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{
+			"cgroup.controllers": "cpu io memory pids",
+			"cpu.stat":           "usage_usec 1500000\n",
+			"memory.current":     "1024\n",
+		})
+		s := &ResourceUsageSampler{root: root, memoryPeakBytes: 2048}
+		usage, err := s.Usage("main")
+		require.NoError(t, err)
+		assert.Equal(t, &wfv1.ContainerResourceUsage{Name: "main", CPUMilliseconds: 1500, MemoryPeakBytes: 2048}, usage)
+	})
+	t.Run("V1", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{
+			"cpuacct/cpuacct.usage":                 "2500000000\n",
+			"memory/memory.usage_in_bytes":          "1024\n",
+			"memory/memory.max_usage_in_bytes":      "8192\n",
+			"blkio/blkio.throttle.io_service_bytes": "8:0 Read 100\n8:0 Write 200\n8:0 Sync 300\n8:0 Async 0\n8:0 Total 300\nTotal 300\n",
+		})
+		usage, err := (&ResourceUsageSampler{root: root}).Usage("main")
+		require.NoError(t, err)
+		assert.Equal(t, &wfv1.ContainerResourceUsage{Name: "main", CPUMilliseconds: 2500, MemoryPeakBytes: 8192, IOReadBytes: 100, IOWriteBytes: 200}, usage)
+	})
+	t.Run("NoCgroup", func(t *testing.T) {
+		_, err := (&ResourceUsageSampler{root: t.TempDir()}).Usage("main")
+		require.Error(t, err)
+	})
+}
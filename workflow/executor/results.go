@@ -0,0 +1,123 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/tidwall/gjson"
+	"sigs.k8s.io/yaml"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Results is the outputs results file of a template, see wfv1.Outputs.ResultsFile
+type Results struct {
+	path string
+	data []byte
+}
+
+// ParseResults parses a JSON or YAML results file, which must contain an object
+func ParseResults(path string, data []byte) (*Results, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "failed to parse results file %s: %v", path, err)
+	}
+	if !gjson.ParseBytes(data).IsObject() {
+		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "results file %s must contain an object", path)
+	}
+	return &Results{path: path, data: data}, nil
+}
+
+// ReadResultsFile reads and parses a results file in the current container
+func ReadResultsFile(path string) (*Results, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if os.IsNotExist(err) {
+		return nil, argoerrs.Errorf(argoerrs.CodeNotFound, "results file %s does not exist", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseResults(path, data)
+}
+
+func (r *Results) get(key string) (gjson.Result, error) {
+	value := gjson.GetBytes(r.data, key)
+	if !value.Exists() || value.Type == gjson.Null {
+		return value, argoerrs.Errorf(argoerrs.CodeNotFound, "key %q not found in results file %s", key, r.path)
+	}
+	return value, nil
+}
+
+// Parameter returns the value of an output parameter. Strings are returned as is, and everything else is JSON encoded.
+func (r *Results) Parameter(key string) (string, error) {
+	value, err := r.get(key)
+	if err != nil {
+		return "", err
+	}
+	if value.Type == gjson.String {
+		return value.Str, nil
+	}
+	return value.Raw, nil
+}
+
+// ArtifactPath returns the path of an output artifact, which must be a clean absolute path
+func (r *Results) ArtifactPath(key string) (string, error) {
+	value, err := r.get(key)
+	if err != nil {
+		return "", err
+	}
+	if value.Type != gjson.String || value.Str == "" {
+		return "", argoerrs.Errorf(argoerrs.CodeBadRequest, "key %q in results file %s must be the path of an artifact, got %s", key, r.path, value.Raw)
+	}
+	if !filepath.IsAbs(value.Str) || filepath.Clean(value.Str) != value.Str {
+		return "", argoerrs.Errorf(argoerrs.CodeBadRequest, "key %q in results file %s must be a clean absolute path, got %q", key, r.path, value.Str)
+	}
+	return value.Str, nil
+}
+
+// loadResults reads the outputs results file written by the main container, once
+func (we *WorkflowExecutor) loadResults(ctx context.Context) (*Results, error) {
+	if we.results != nil || we.resultsErr != nil {
+		return we.results, we.resultsErr
+	}
+	path := we.Template.Outputs.ResultsFile
+	var data []byte
+	if we.isBaseImagePath(path) {
+		logging.RequireLoggerFromContext(ctx).WithField("path", path).Info(ctx, "Reading results file from base image layer")
+		contents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, path)
+		data, we.resultsErr = []byte(contents), err
+	} else {
+		logging.RequireLoggerFromContext(ctx).WithField("path", path).Info(ctx, "Reading results file from volume mount")
+		data, we.resultsErr = os.ReadFile(filepath.Clean(filepath.Join(common.ExecutorMainFilesystemDir, path)))
+	}
+	if os.IsNotExist(we.resultsErr) {
+		we.resultsErr = argoerrs.Errorf(argoerrs.CodeNotFound, "results file %s does not exist", path)
+	}
+	if we.resultsErr == nil {
+		we.results, we.resultsErr = ParseResults(path, data)
+	}
+	return we.results, we.resultsErr
+}
+
+// resultsParameter returns the value of an output parameter from the outputs results file
+func (we *WorkflowExecutor) resultsParameter(ctx context.Context, param wfv1.Parameter) (string, error) {
+	results, err := we.loadResults(ctx)
+	if err != nil {
+		return "", err
+	}
+	return results.Parameter(param.ValueFrom.ResultsKey)
+}
+
+// resolveResultsArtifactPath sets the path of an output artifact from the outputs results file
+func (we *WorkflowExecutor) resolveResultsArtifactPath(ctx context.Context, art *wfv1.Artifact) error {
+	results, err := we.loadResults(ctx)
+	if err != nil {
+		return err
+	}
+	art.Path, err = results.ArtifactPath(art.ResultsKey)
+	return err
+}
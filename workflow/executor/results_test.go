@@ -0,0 +1,152 @@
+package executor
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
+)
+
+func TestParseResults(t *testing.T) {
+	for name, data := range map[string]string{
+		"JSON": `{"name": "foo", "metrics": {"accuracy": 0.9, "passed": true}, "tags": ["a", "b"], "none": null, "model": "/tmp/model"}`,
+		"YAML": `
+name: foo
+metrics:
+  accuracy: 0.9
+  passed: true
+tags: [a, b]
+none: null
+model: /tmp/model
+`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			results, err := ParseResults("/tmp/results", []byte(data))
+			require.NoError(t, err)
+			for key, expected := range map[string]string{
+				"name":             "foo",
+				"metrics.accuracy": "0.9",
+				"metrics.passed":   "true",
+				"metrics":          `{"accuracy":0.9,"passed":true}`,
+				"tags":             `["a","b"]`,
+				"tags.1":           "b",
+			} {
+				value, err := results.Parameter(key)
+				require.NoError(t, err, key)
+				assert.Equal(t, expected, value, key)
+			}
+			for _, key := range []string{"missing", "none", "metrics.missing"} {
+				_, err := results.Parameter(key)
+				assert.True(t, argoerrs.IsCode(argoerrs.CodeNotFound, err), key)
+			}
+
+			path, err := results.ArtifactPath("model")
+			require.NoError(t, err)
+			assert.Equal(t, "/tmp/model", path)
+			_, err = results.ArtifactPath("metrics")
+			require.EqualError(t, err, `key "metrics" in results file /tmp/results must be the path of an artifact, got {"accuracy":0.9,"passed":true}`)
+			_, err = results.ArtifactPath("missing")
+			assert.True(t, argoerrs.IsCode(argoerrs.CodeNotFound, err))
+		})
+	}
+	t.Run("ArtifactPaths", func(t *testing.T) {
+		results, err := ParseResults("/tmp/results", []byte(`{"relative": "tmp/model", "unclean": "/tmp/../etc/passwd", "number": 1}`))
+		require.NoError(t, err)
+		_, err = results.ArtifactPath("relative")
+		require.EqualError(t, err, `key "relative" in results file /tmp/results must be a clean absolute path, got "tmp/model"`)
+		_, err = results.ArtifactPath("unclean")
+		require.EqualError(t, err, `key "unclean" in results file /tmp/results must be a clean absolute path, got "/tmp/../etc/passwd"`)
+		_, err = results.ArtifactPath("number")
+		require.EqualError(t, err, `key "number" in results file /tmp/results must be the path of an artifact, got 1`)
+	})
+	t.Run("NotAnObject", func(t *testing.T) {
+		_, err := ParseResults("/tmp/results", []byte(`["foo"]`))
+		require.EqualError(t, err, "results file /tmp/results must contain an object")
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseResults("/tmp/results", []byte(`{`))
+		require.Error(t, err)
+	})
+}
+
+func TestSaveParametersFromResults(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName: fakePodName,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{
+				ResultsFile: "/tmp/results.json",
+				Parameters: []wfv1.Parameter{
+					{Name: "accuracy", ValueFrom: &wfv1.ValueFrom{ResultsKey: "metrics.accuracy"}},
+					{Name: "loss", ValueFrom: &wfv1.ValueFrom{ResultsKey: "metrics.loss", Default: wfv1.AnyStringPtr("1")}},
+				},
+			},
+		},
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/results.json").Return(`{"metrics": {"accuracy": 0.9}}`, nil).Once()
+
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, we.SaveParameters(ctx))
+	assert.Equal(t, "0.9", we.Template.Outputs.Parameters[0].Value.String())
+	assert.Equal(t, "1", we.Template.Outputs.Parameters[1].Value.String())
+	mockRuntimeExecutor.AssertExpectations(t)
+}
+
+func TestSaveParametersFromResultsOfWrongType(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName: fakePodName,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{
+				ResultsFile: "/tmp/results.json",
+				Parameters: []wfv1.Parameter{
+					{Name: "accuracy", ValueFrom: &wfv1.ValueFrom{ResultsKey: "metrics.accuracy", Default: wfv1.AnyStringPtr("0")}, Schema: &wfv1.ParameterSchema{Type: "number"}},
+				},
+			},
+		},
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/results.json").Return(`{"metrics": {"accuracy": "high"}}`, nil).Once()
+
+	ctx := logging.TestContext(t.Context())
+	err := we.SaveParameters(ctx)
+	require.EqualError(t, err, `outputs.parameters.accuracy value "high" is not a number`)
+	assert.Nil(t, we.Template.Outputs.Parameters[0].Value)
+}
+
+func TestSaveParametersFromMissingResults(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName: fakePodName,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{
+				ResultsFile: "/tmp/results.json",
+				Parameters: []wfv1.Parameter{
+					{Name: "loss", ValueFrom: &wfv1.ValueFrom{ResultsKey: "metrics.loss", Default: wfv1.AnyStringPtr("1")}},
+					{Name: "accuracy", ValueFrom: &wfv1.ValueFrom{ResultsKey: "metrics.accuracy"}},
+				},
+			},
+		},
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/results.json").Return("", os.ErrNotExist)
+
+	ctx := logging.TestContext(t.Context())
+	err := we.SaveParameters(ctx)
+	require.EqualError(t, err, "results file /tmp/results.json does not exist")
+	assert.Equal(t, "1", we.Template.Outputs.Parameters[0].Value.String())
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addSLOMissedCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentSloMissedTotal)
+}
+
+// SLOMissed counts a workflow that did not complete by the deadline of its SLO, by the CronWorkflow that created it if any
+func (m *Metrics) SLOMissed(ctx context.Context, namespace, cronWorkflow string) {
+	attribs := telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+	}
+	if cronWorkflow != "" {
+		attribs = append(attribs, telemetry.InstAttrib{Name: telemetry.AttribCronWFName, Value: cronWorkflow})
+	}
+	m.AddInt(ctx, telemetry.InstrumentSloMissedTotal.Name(), 1, attribs)
+}
@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addWorkflowCostHistogram(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentWorkflowEstimatedCost)
+}
+
+// RecordWorkflowCost records the estimated cost of a completed workflow
+func (m *Metrics) RecordWorkflowCost(ctx context.Context, cost float64, namespace string) {
+	m.Record(ctx, telemetry.InstrumentWorkflowEstimatedCost.Name(), cost, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+	})
+}
@@ -17,6 +17,11 @@ type Metrics struct {
 	realtimeMutex     sync.Mutex
 	realtimeWorkflows map[string][]realtimeTracker
 	fallbackLogger    logging.Logger // use a logger from context if available
+
+	// guards the policy and the number of series of custom metrics of each namespace
+	customSeriesMutex sync.Mutex
+	customPolicy      CustomMetricsPolicy
+	customSeries      map[string]int
 }
 
 func New(ctx context.Context, serviceName, prometheusName string, config *telemetry.Config, callbacks Callbacks, extraOpts ...metricsdk.Option) (*Metrics, error) {
@@ -37,6 +42,7 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		Metrics:           m,
 		callbacks:         callbacks,
 		realtimeWorkflows: make(map[string][]realtimeTracker),
+		customSeries:      make(map[string]int),
 		fallbackLogger:    logging.RequireLoggerFromContext(ctx),
 	}
 
@@ -49,9 +55,13 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addWorkflowPhaseGauge,
 		addCronWfTriggerCounter,
 		addCronWfPolicyCounter,
+		addSLOMissedCounter,
+		addCustomMetricPolicyMetrics,
 		addWorkflowPhaseCounter,
 		addWorkflowTemplateCounter,
 		addWorkflowTemplateHistogram,
+		addWorkflowCostHistogram,
+		addResourceUsageMetrics,
 		addOperationDurationHistogram,
 		addErrorCounter,
 		addLogCounter,
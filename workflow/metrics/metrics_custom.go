@@ -28,6 +28,8 @@ type customMetricValue struct {
 	labels          []*wfv1.MetricLabel
 	key             string
 	completed       bool
+	// namespace is the namespace of the workflow that created the series, whose budget it counts towards
+	namespace string
 }
 
 type customMetricUserData struct {
@@ -76,7 +78,7 @@ func customUserData(i *telemetry.Instrument, requireSuccess bool) *customMetricU
 	}
 }
 
-func getOrCreateValue(i *telemetry.Instrument, key string, labels []*wfv1.MetricLabel) *customMetricValue {
+func getOrCreateValue(i *telemetry.Instrument, key string, labels []*wfv1.MetricLabel, namespace string) *customMetricValue {
 	ud := customUserData(i, true)
 	ud.mutex.Lock()
 	defer ud.mutex.Unlock()
@@ -84,8 +86,9 @@ func getOrCreateValue(i *telemetry.Instrument, key string, labels []*wfv1.Metric
 		return value
 	}
 	newValue := customMetricValue{
-		key:    key,
-		labels: labels,
+		key:       key,
+		labels:    labels,
+		namespace: namespace,
 	}
 	ud.values[key] = &newValue
 	return &newValue
@@ -155,20 +158,18 @@ func (m *Metrics) matchExistingMetric(metricSpec *wfv1.Prometheus) (*telemetry.I
 	return nil, nil
 }
 
-func (m *Metrics) ensureBaseMetric(metricSpec *wfv1.Prometheus, ownerKey string) (*telemetry.Instrument, error) {
+func (m *Metrics) ensureBaseMetric(metricSpec *wfv1.Prometheus) (*telemetry.Instrument, error) {
 	metric, err := m.matchExistingMetric(metricSpec)
 	if err != nil {
 		return nil, err
 	}
 	if metric != nil {
-		m.attachCustomMetricToWorkflow(metricSpec, ownerKey)
 		return metric, nil
 	}
 	err = m.createCustomMetric(metricSpec)
 	if err != nil {
 		return nil, err
 	}
-	m.attachCustomMetricToWorkflow(metricSpec, ownerKey)
 	inst := m.GetInstrument(metricSpec.Name)
 	if inst == nil {
 		return nil, fmt.Errorf("failed to create new metric %s", metricSpec.Name)
@@ -177,15 +178,20 @@ func (m *Metrics) ensureBaseMetric(metricSpec *wfv1.Prometheus, ownerKey string)
 	return inst, nil
 }
 
-func (m *Metrics) UpsertCustomMetric(ctx context.Context, metricSpec *wfv1.Prometheus, ownerKey string, valueFunc RealTimeValueFunc) error {
+// UpsertCustomMetric records the custom metric of a workflow of the namespace, subject to the CustomMetricsPolicy
+func (m *Metrics) UpsertCustomMetric(ctx context.Context, metricSpec *wfv1.Prometheus, ownerKey, namespace string, valueFunc RealTimeValueFunc) error {
 	if !IsValidMetricName(metricSpec.Name) {
 		return fmt.Errorf("%s", invalidMetricNameError)
 	}
-	baseMetric, err := m.ensureBaseMetric(metricSpec, ownerKey)
+	baseMetric, err := m.ensureBaseMetric(metricSpec)
+	if err != nil {
+		return err
+	}
+	metricSpec, metricValue, err := m.getOrCreateSeries(ctx, baseMetric, m.withAllowedLabels(ctx, metricSpec, namespace), namespace)
 	if err != nil {
 		return err
 	}
-	metricValue := getOrCreateValue(baseMetric, metricSpec.GetKey(), metricSpec.Labels)
+	m.attachCustomMetricToWorkflow(metricSpec, ownerKey)
 	metricValue.lastUpdated = time.Now()
 
 	metricType := metricSpec.GetMetricType()
@@ -285,18 +291,22 @@ func (m *Metrics) runCustomGC(ttl time.Duration) {
 		if ud == nil {
 			return
 		}
+		var released []string
 		ud.mutex.Lock()
 		for key, value := range ud.values {
 			if time.Since(value.lastUpdated) > ttl {
 				switch {
 				case value.rtValueFunc != nil && value.completed:
 					delete(ud.values, key)
+					released = append(released, value.namespace)
 				case value.rtValueFunc == nil:
 					delete(ud.values, key)
+					released = append(released, value.namespace)
 				}
 			}
 		}
 		ud.mutex.Unlock()
+		m.releaseSeries(released)
 	})
 }
 
@@ -331,6 +341,7 @@ func (m *Metrics) handleRealtimeMetricsForWfUID(key string, op operation) {
 		return
 	}
 	realtimeMetrics := m.realtimeWorkflows[key]
+	var released []string
 	for _, metric := range realtimeMetrics {
 		ud := customUserData(metric.inst, true)
 		ud.mutex.Lock()
@@ -340,10 +351,14 @@ func (m *Metrics) handleRealtimeMetricsForWfUID(key string, op operation) {
 				value.completed = true
 			}
 		case Delete:
-			delete(ud.values, metric.key)
+			if value, ok := ud.values[metric.key]; ok && value != nil {
+				delete(ud.values, metric.key)
+				released = append(released, value.namespace)
+			}
 		}
 		ud.mutex.Unlock()
 	}
+	m.releaseSeries(released)
 	if op == Delete {
 		delete(m.realtimeWorkflows, key)
 	}
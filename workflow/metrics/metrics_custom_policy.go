@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/metric"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// CustomMetricsPolicy limits the labels and the number of series of custom metrics
+type CustomMetricsPolicy struct {
+	AllowedLabels         []string
+	DeniedLabels          []string
+	MaxSeriesPerNamespace int
+	AggregateLabels       []string
+}
+
+const (
+	seriesAggregated = "aggregated"
+	seriesRejected   = "rejected"
+)
+
+func addCustomMetricPolicyMetrics(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentCustomMetricLabelsDroppedTotal)
+	if err != nil {
+		return err
+	}
+	err = m.CreateBuiltinInstrument(telemetry.InstrumentCustomMetricSeriesLimitedTotal)
+	if err != nil {
+		return err
+	}
+	err = m.CreateBuiltinInstrument(telemetry.InstrumentCustomMetricSeries)
+	if err != nil {
+		return err
+	}
+	series := m.GetInstrument(telemetry.InstrumentCustomMetricSeries.Name())
+	return series.RegisterCallback(m.Metrics, func(ctx context.Context, o metric.Observer) error {
+		m.customSeriesMutex.Lock()
+		defer m.customSeriesMutex.Unlock()
+		for namespace, count := range m.customSeries {
+			series.ObserveInt(ctx, o, int64(count), telemetry.InstAttribs{{Name: telemetry.AttribWorkflowNamespace, Value: namespace}})
+		}
+		return nil
+	})
+}
+
+// SetCustomMetricsPolicy sets the policy for the custom metrics recorded from now on
+func (m *Metrics) SetCustomMetricsPolicy(policy CustomMetricsPolicy) {
+	m.customSeriesMutex.Lock()
+	defer m.customSeriesMutex.Unlock()
+	m.customPolicy = policy
+}
+
+// withAllowedLabels returns the metric without the labels that the policy does not allow
+func (m *Metrics) withAllowedLabels(ctx context.Context, metricSpec *wfv1.Prometheus, namespace string) *wfv1.Prometheus {
+	m.customSeriesMutex.Lock()
+	policy := m.customPolicy
+	m.customSeriesMutex.Unlock()
+	allowed := func(key string) bool {
+		return (len(policy.AllowedLabels) == 0 || slices.Contains(policy.AllowedLabels, key)) && !slices.Contains(policy.DeniedLabels, key)
+	}
+	if !slices.ContainsFunc(metricSpec.Labels, func(label *wfv1.MetricLabel) bool { return !allowed(label.Key) }) {
+		return metricSpec
+	}
+	allowedSpec := metricSpec.DeepCopy()
+	allowedSpec.Labels = nil
+	for _, label := range metricSpec.Labels {
+		if allowed(label.Key) {
+			allowedSpec.Labels = append(allowedSpec.Labels, label)
+			continue
+		}
+		m.AddInt(ctx, telemetry.InstrumentCustomMetricLabelsDroppedTotal.Name(), 1, telemetry.InstAttribs{
+			{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+			{Name: telemetry.AttribCustomMetricName, Value: metricSpec.Name},
+			{Name: telemetry.AttribCustomMetricLabel, Value: label.Key},
+		})
+	}
+	return allowedSpec
+}
+
+// getOrCreateSeries returns the value of the series of the metric, creating it if the namespace has not used its
+// budget of series. Otherwise, the metric is aggregated into an existing series without its aggregate labels, or rejected.
+func (m *Metrics) getOrCreateSeries(ctx context.Context, inst *telemetry.Instrument, metricSpec *wfv1.Prometheus, namespace string) (*wfv1.Prometheus, *customMetricValue, error) {
+	m.customSeriesMutex.Lock()
+	defer m.customSeriesMutex.Unlock()
+	ud := customUserData(inst, true)
+	if value := ud.GetValue(metricSpec.GetKey()); value != nil {
+		return metricSpec, value, nil
+	}
+	budget := m.customPolicy.MaxSeriesPerNamespace
+	if budget <= 0 || m.customSeries[namespace] < budget {
+		m.customSeries[namespace]++
+		return metricSpec, getOrCreateValue(inst, metricSpec.GetKey(), metricSpec.Labels, namespace), nil
+	}
+	attribs := telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+		{Name: telemetry.AttribCustomMetricName, Value: metricSpec.Name},
+	}
+	aggregatedSpec := metricSpec.DeepCopy()
+	aggregatedSpec.Labels = slices.DeleteFunc(aggregatedSpec.Labels, func(label *wfv1.MetricLabel) bool {
+		return slices.Contains(m.customPolicy.AggregateLabels, label.Key)
+	})
+	if len(aggregatedSpec.Labels) < len(metricSpec.Labels) {
+		if value := ud.GetValue(aggregatedSpec.GetKey()); value != nil {
+			m.AddInt(ctx, telemetry.InstrumentCustomMetricSeriesLimitedTotal.Name(), 1, append(attribs, telemetry.InstAttrib{Name: telemetry.AttribCustomMetricLimitAction, Value: seriesAggregated}))
+			return aggregatedSpec, value, nil
+		}
+	}
+	m.AddInt(ctx, telemetry.InstrumentCustomMetricSeriesLimitedTotal.Name(), 1, append(attribs, telemetry.InstAttrib{Name: telemetry.AttribCustomMetricLimitAction, Value: seriesRejected}))
+	return nil, nil, fmt.Errorf("namespace %s has reached its limit of %d series of custom metrics", namespace, budget)
+}
+
+// releaseSeries removes the series of the namespaces from their budgets
+func (m *Metrics) releaseSeries(namespaces []string) {
+	if len(namespaces) == 0 {
+		return
+	}
+	m.customSeriesMutex.Lock()
+	defer m.customSeriesMutex.Unlock()
+	for _, namespace := range namespaces {
+		m.customSeries[namespace]--
+		if m.customSeries[namespace] <= 0 {
+			delete(m.customSeries, namespace)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func policyCounter(labels ...string) *wfv1.Prometheus {
+	metric := &wfv1.Prometheus{Name: "policy_counter", Help: "A counter", Counter: &wfv1.Counter{Value: "1"}}
+	for i := 0; i < len(labels); i += 2 {
+		metric.Labels = append(metric.Labels, &wfv1.MetricLabel{Key: labels[i], Value: labels[i+1]})
+	}
+	return metric
+}
+
+func TestCustomMetricsPolicy(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, te, err := CreateDefaultTestMetrics(ctx)
+	require.NoError(t, err)
+	m.SetCustomMetricsPolicy(CustomMetricsPolicy{
+		DeniedLabels:          []string{"workflow_name"},
+		MaxSeriesPerNamespace: 2,
+		AggregateLabels:       []string{"phase"},
+	})
+	series := func(namespace string) int64 {
+		attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, namespace))
+		val, err := te.GetInt64GaugeValue(ctx, telemetry.InstrumentCustomMetricSeries.Name(), &attribs)
+		require.NoError(t, err)
+		return val
+	}
+	limited := func(namespace, action string) int64 {
+		attribs := attribute.NewSet(
+			attribute.String(telemetry.AttribWorkflowNamespace, namespace),
+			attribute.String(telemetry.AttribCustomMetricName, "policy_counter"),
+			attribute.String(telemetry.AttribCustomMetricLimitAction, action),
+		)
+		val, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentCustomMetricSeriesLimitedTotal.Name(), &attribs)
+		require.NoError(t, err)
+		return val
+	}
+
+	t.Run("DeniedLabels", func(t *testing.T) {
+		require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "a", "workflow_name", "wf-1"), "wf-1", "ns-1", nil))
+		require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "a", "workflow_name", "wf-2"), "wf-2", "ns-1", nil))
+		ud := customUserData(m.GetCustomMetric("policy_counter"), true)
+		require.Len(t, ud.values, 1)
+		value := ud.GetValue(policyCounter("template", "a").GetKey())
+		require.NotNil(t, value)
+		assert.InDelta(t, 2.0, value.prometheusValue, 0)
+		assert.Equal(t, int64(1), series("ns-1"))
+
+		attribs := attribute.NewSet(
+			attribute.String(telemetry.AttribWorkflowNamespace, "ns-1"),
+			attribute.String(telemetry.AttribCustomMetricName, "policy_counter"),
+			attribute.String(telemetry.AttribCustomMetricLabel, "workflow_name"),
+		)
+		val, err := te.GetInt64CounterValue(ctx, telemetry.InstrumentCustomMetricLabelsDroppedTotal.Name(), &attribs)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), val)
+	})
+
+	t.Run("Aggregated", func(t *testing.T) {
+		require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "b"), "wf-3", "ns-1", nil))
+		assert.Equal(t, int64(2), series("ns-1"))
+		// the namespace has used its budget, so the phase is dropped to aggregate into the series of template b
+		require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "b", "phase", "Failed"), "wf-4", "ns-1", nil))
+		ud := customUserData(m.GetCustomMetric("policy_counter"), true)
+		assert.Len(t, ud.values, 2)
+		assert.InDelta(t, 2.0, ud.GetValue(policyCounter("template", "b").GetKey()).prometheusValue, 0)
+		assert.Equal(t, int64(1), limited("ns-1", seriesAggregated))
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		err := m.UpsertCustomMetric(ctx, policyCounter("template", "c"), "wf-5", "ns-1", nil)
+		require.EqualError(t, err, "namespace ns-1 has reached its limit of 2 series of custom metrics")
+		assert.Equal(t, int64(1), limited("ns-1", seriesRejected))
+		// other namespaces have their own budgets
+		require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "c"), "wf-6", "ns-2", nil))
+		assert.Equal(t, int64(1), series("ns-2"))
+	})
+
+	t.Run("Released", func(t *testing.T) {
+		m.runCustomGC(0)
+		m.customSeriesMutex.Lock()
+		defer m.customSeriesMutex.Unlock()
+		assert.Empty(t, m.customSeries)
+	})
+}
+
+func TestCustomMetricsPolicyAllowedLabels(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	m, _, err := CreateDefaultTestMetrics(ctx)
+	require.NoError(t, err)
+	m.SetCustomMetricsPolicy(CustomMetricsPolicy{AllowedLabels: []string{"template"}})
+	require.NoError(t, m.UpsertCustomMetric(ctx, policyCounter("template", "a", "team", "x"), "wf-1", "ns-1", nil))
+	ud := customUserData(m.GetCustomMetric("policy_counter"), true)
+	assert.NotNil(t, ud.GetValue(policyCounter("template", "a").GetKey()))
+}
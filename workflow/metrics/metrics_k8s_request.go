@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/client-go/rest"
@@ -37,12 +38,35 @@ type metricsRoundTripper struct {
 // we can instantiate metrics
 var k8sMetrics metricsRoundTripperContext
 
+// RequestCounter counts the Kubernetes API requests made with a context, and the bytes of workflows they write
+type RequestCounter struct {
+	Requests      atomic.Int64
+	WorkflowBytes atomic.Int64
+}
+
+type requestCounterKey struct{}
+
+// WithRequestCounter returns a context that counts the Kubernetes API requests made with it in the counter
+func WithRequestCounter(ctx context.Context, counter *RequestCounter) context.Context {
+	return context.WithValue(ctx, requestCounterKey{}, counter)
+}
+
+func (c *RequestCounter) count(r *http.Request, verb, kind string) {
+	c.Requests.Add(1)
+	if (verb == "Update" || verb == "Patch") && (kind == "workflows" || kind == "workflows/status") && r.ContentLength > 0 {
+		c.WorkflowBytes.Add(r.ContentLength)
+	}
+}
+
 func (m metricsRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
 	startTime := time.Now()
 	x, err := m.roundTripper.RoundTrip(r)
 	duration := time.Since(startTime)
+	verb, kind := k8s.ParseRequest(r)
+	if counter, ok := r.Context().Value(requestCounterKey{}).(*RequestCounter); ok {
+		counter.count(r, verb, kind)
+	}
 	if x != nil && m.metrics != nil {
-		verb, kind := k8s.ParseRequest(r)
 		attribs := telemetry.InstAttribs{
 			{Name: telemetry.AttribRequestKind, Value: kind},
 			{Name: telemetry.AttribRequestVerb, Value: verb},
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type okRoundTripper struct{}
+
+func (okRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestRequestCounter(t *testing.T) {
+	counter := &RequestCounter{}
+	ctx := WithRequestCounter(t.Context(), counter)
+	rt := metricsRoundTripper{roundTripper: okRoundTripper{}, metricsRoundTripperContext: &metricsRoundTripperContext{}}
+	for _, req := range []struct {
+		method string
+		url    string
+		body   string
+	}{
+		{http.MethodGet, "https://0.0.0.0/api/v1/namespaces/argo/pods/my-pod", ""},
+		{http.MethodPost, "https://0.0.0.0/api/v1/namespaces/argo/pods", `{"kind":"Pod"}`},
+		{http.MethodPut, "https://0.0.0.0/apis/argoproj.io/v1alpha1/namespaces/argo/workflows/my-wf", `{"kind":"Workflow"}`},
+	} {
+		r, err := http.NewRequestWithContext(ctx, req.method, req.url, strings.NewReader(req.body))
+		require.NoError(t, err)
+		_, err = rt.RoundTrip(r)
+		require.NoError(t, err)
+	}
+	r, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://0.0.0.0/api/v1/namespaces/argo/pods", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(r)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), counter.Requests.Load())
+	assert.Equal(t, int64(len(`{"kind":"Workflow"}`)), counter.WorkflowBytes.Load())
+}
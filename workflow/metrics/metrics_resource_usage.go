@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"context"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addResourceUsageMetrics(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentNodeCpuUsage)
+	if err != nil {
+		return err
+	}
+	err = m.CreateBuiltinInstrument(telemetry.InstrumentNodeIoBytes)
+	if err != nil {
+		return err
+	}
+	return m.CreateBuiltinInstrument(telemetry.InstrumentNodeMemoryPeak)
+}
+
+// RecordResourceUsage records the resource usage of the main containers of a node
+func (m *Metrics) RecordResourceUsage(ctx context.Context, usages []wfv1.ContainerResourceUsage, namespace, template string) {
+	attribs := telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+		{Name: telemetry.AttribNodeTemplate, Value: template},
+	}
+	var cpu, ioRead, ioWrite int64
+	for _, usage := range usages {
+		cpu += usage.CPUMilliseconds
+		ioRead += usage.IOReadBytes
+		ioWrite += usage.IOWriteBytes
+		m.Record(ctx, telemetry.InstrumentNodeMemoryPeak.Name(), float64(usage.MemoryPeakBytes), attribs)
+	}
+	m.AddInt(ctx, telemetry.InstrumentNodeCpuUsage.Name(), cpu, attribs)
+	m.AddInt(ctx, telemetry.InstrumentNodeIoBytes.Name(), ioRead, append(attribs, telemetry.InstAttrib{Name: telemetry.AttribIODirection, Value: "read"}))
+	m.AddInt(ctx, telemetry.InstrumentNodeIoBytes.Name(), ioWrite, append(attribs, telemetry.InstAttrib{Name: telemetry.AttribIODirection, Value: "write"}))
+}
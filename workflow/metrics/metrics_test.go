@@ -39,7 +39,7 @@ func TestErrors(t *testing.T) {
 	require.NoError(t, err)
 	err = m.UpsertCustomMetric(ctx, &wfv1.Prometheus{
 		Name: "invalid.name",
-	}, "owner", func() float64 { return 0.0 })
+	}, "owner", "my-ns", func() float64 { return 0.0 })
 	require.Error(t, err)
 
 	err = m.UpsertCustomMetric(ctx, &wfv1.Prometheus{
@@ -48,7 +48,7 @@ func TestErrors(t *testing.T) {
 			Key:   "invalid-key",
 			Value: "value",
 		}},
-	}, "owner", func() float64 { return 0.0 })
+	}, "owner", "my-ns", func() float64 { return 0.0 })
 	require.Error(t, err)
 }
 
@@ -73,7 +73,7 @@ func TestMetricGC(t *testing.T) {
 		Labels:  labels,
 		Help:    "none",
 		Counter: &wfv1.Counter{Value: "0.0"},
-	}, "owner", nil)
+	}, "owner", "my-ns", nil)
 	require.NoError(t, err)
 	baseCm := m.GetCustomMetric(key)
 	assert.NotNil(t, baseCm)
@@ -121,6 +121,7 @@ func TestRealtimeMetricGC(t *testing.T) {
 			Realtime: ptr.To(true),
 		}},
 		wfKey,
+		"my-ns",
 		func() float64 { return 1.0 },
 	)
 	require.NoError(t, err)
@@ -199,7 +200,7 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 			Realtime:  ptr.To(true),
 			Operation: wfv1.GaugeOperationAdd,
 		},
-	}, "123", func() float64 { return 0.0 })
+	}, "123", "my-ns", func() float64 { return 0.0 })
 	require.NoError(t, err)
 
 	baseCm := m.GetCustomMetric(key)
@@ -226,7 +227,7 @@ func TestRealTimeMetricDeletion(t *testing.T) {
 			Realtime:  ptr.To(true),
 			Operation: wfv1.GaugeOperationAdd,
 		},
-	}, "456", nil)
+	}, "456", "my-ns", nil)
 	require.NoError(t, err)
 
 	assert.Len(t, cm.values, 1)
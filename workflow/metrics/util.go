@@ -29,6 +29,9 @@ func ValidateMetricValues(metric *wfv1.Prometheus) error {
 			if strings.Contains(metric.Gauge.Value, "resourcesDuration.") {
 				return errors.New("'resourcesDuration.*' metrics cannot be used in real-time")
 			}
+			if strings.Contains(metric.Gauge.Value, "resourceUsage.") {
+				return errors.New("'resourceUsage.*' metrics cannot be used in real-time")
+			}
 		}
 	}
 	if metric.Counter != nil && metric.Counter.Value == "" {
@@ -0,0 +1,78 @@
+package progress
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// UpdateEstimatedFinishedAt estimates when the running workflow will complete, from the estimated duration and the
+// fraction complete of its executable nodes, weighting the progress more as it grows. The expected nodes are the
+// number of executable nodes the workflow is expected to have, such as those of its previous run, or zero if not
+// known, so that the nodes not yet created count as not started. It must be called after UpdateProgress.
+func UpdateEstimatedFinishedAt(wf *wfv1.Workflow, expectedNodes int, now time.Time) {
+	wf.Status.EstimatedFinishedAt = nil
+	if wf.Status.Phase != wfv1.WorkflowRunning || wf.Status.StartedAt.IsZero() {
+		return
+	}
+	estimatedDuration := wf.Status.EstimatedDuration.ToDuration()
+	fraction := fractionComplete(wf, expectedNodes)
+	var total time.Duration
+	switch {
+	case fraction == 0 && estimatedDuration == 0:
+		return
+	case fraction == 0:
+		total = estimatedDuration
+	default:
+		// extrapolate the time taken so far to the work left to do
+		total = time.Duration(float64(now.Sub(wf.Status.StartedAt.Time)) / fraction)
+		if estimatedDuration > 0 {
+			total = time.Duration(fraction*float64(total) + (1-fraction)*float64(estimatedDuration))
+		}
+	}
+	finishedAt := wf.Status.StartedAt.Add(total)
+	if finishedAt.Before(now) {
+		finishedAt = now
+	}
+	wf.Status.EstimatedFinishedAt = ptr.To(metav1.NewTime(finishedAt.Truncate(time.Second)))
+}
+
+// fractionComplete is the mean fraction complete of the executable nodes, or of the expected nodes if there are more
+// of them, from 0 to 1
+func fractionComplete(wf *wfv1.Workflow, expectedNodes int) float64 {
+	var sum float64
+	var n int
+	for _, node := range wf.Status.Nodes {
+		if !executable(node.Type) {
+			continue
+		}
+		n++
+		switch {
+		case node.Fulfilled():
+			sum++
+		case node.ProgressDetail.IsValid():
+			sum += node.ProgressDetail.Fraction()
+		case node.Progress.IsValid():
+			sum += float64(node.Progress.N()) / float64(node.Progress.M())
+		}
+	}
+	n = max(n, expectedNodes)
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// ExecutableNodes returns the number of executable nodes, whose fraction complete is that of the workflow
+func ExecutableNodes(nodes wfv1.Nodes) int {
+	var n int
+	for _, node := range nodes {
+		if executable(node.Type) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,80 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestUpdateEstimatedFinishedAt(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := startedAt.Add(10 * time.Minute)
+	newWorkflow := func(estimatedDuration time.Duration, nodes wfv1.Nodes) *wfv1.Workflow {
+		return &wfv1.Workflow{Status: wfv1.WorkflowStatus{
+			Phase:               wfv1.WorkflowRunning,
+			StartedAt:           metav1.NewTime(startedAt),
+			EstimatedDuration:   wfv1.NewEstimatedDuration(estimatedDuration),
+			EstimatedFinishedAt: ptr.To(metav1.NewTime(startedAt)),
+			Nodes:               nodes,
+		}}
+	}
+	halfDone := wfv1.Nodes{
+		"pod-1": wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod},
+		"pod-2": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, Progress: "0/1", ProgressDetail: &wfv1.ProgressDetail{Stages: []wfv1.ProgressStage{{Name: "download", Percent: 100}, {Name: "train", Percent: 0}}}},
+		"dag":   wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypeDAG, Children: []string{"pod-1", "pod-2"}},
+	}
+
+	t.Run("NotRunning", func(t *testing.T) {
+		wf := newWorkflow(time.Hour, halfDone)
+		wf.Status.Phase = wfv1.WorkflowSucceeded
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		assert.Nil(t, wf.Status.EstimatedFinishedAt)
+	})
+	t.Run("NoEstimate", func(t *testing.T) {
+		wf := newWorkflow(0, wfv1.Nodes{"pod": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, Progress: "0/1"}})
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		assert.Nil(t, wf.Status.EstimatedFinishedAt)
+	})
+	t.Run("EstimatedDurationOnly", func(t *testing.T) {
+		wf := newWorkflow(time.Hour, nil)
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		assert.Equal(t, startedAt.Add(time.Hour), wf.Status.EstimatedFinishedAt.Time)
+	})
+	t.Run("ProgressOnly", func(t *testing.T) {
+		wf := newWorkflow(0, halfDone)
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		// 3/4 done in 10m
+		assert.Equal(t, startedAt.Add(13*time.Minute+20*time.Second), wf.Status.EstimatedFinishedAt.Time)
+	})
+	t.Run("Combined", func(t *testing.T) {
+		wf := newWorkflow(time.Hour, halfDone)
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		// 3/4 × 13m20s + 1/4 × 60m
+		assert.Equal(t, startedAt.Add(25*time.Minute), wf.Status.EstimatedFinishedAt.Time)
+	})
+	t.Run("BetweenSteps", func(t *testing.T) {
+		// the first of three sequential steps has completed, and the next has not been created yet
+		wf := newWorkflow(time.Hour, wfv1.Nodes{
+			"pod-1": wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod},
+			"steps": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypeSteps, Children: []string{"pod-1"}},
+		})
+		UpdateEstimatedFinishedAt(wf, 3, now)
+		// 1/3 × 30m + 2/3 × 60m
+		assert.Equal(t, startedAt.Add(50*time.Minute), wf.Status.EstimatedFinishedAt.Time)
+	})
+	t.Run("MoreNodesThanExpected", func(t *testing.T) {
+		wf := newWorkflow(time.Hour, halfDone)
+		UpdateEstimatedFinishedAt(wf, 1, now)
+		assert.Equal(t, startedAt.Add(25*time.Minute), wf.Status.EstimatedFinishedAt.Time)
+	})
+	t.Run("Overdue", func(t *testing.T) {
+		wf := newWorkflow(time.Minute, nil)
+		UpdateEstimatedFinishedAt(wf, 0, now)
+		assert.Equal(t, now, wf.Status.EstimatedFinishedAt.Time)
+	})
+}
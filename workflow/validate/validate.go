@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/robfig/cron/v3"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sintstr "k8s.io/apimachinery/pkg/util/intstr"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/yaml"
 
@@ -178,6 +180,15 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 
 	if hasWorkflowTemplateRef {
 		wfArgs.Parameters = util.MergeParameters(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
+		// the schema is declared by the template, so it applies to values supplied by the workflow too
+		for i, param := range wfArgs.Parameters {
+			if param.Schema != nil {
+				continue
+			}
+			if tmplParam := wfSpecHolder.GetWorkflowSpec().Arguments.GetParameterByName(param.Name); tmplParam != nil {
+				wfArgs.Parameters[i].Schema = tmplParam.Schema
+			}
+		}
 		wfArgs.Artifacts = util.MergeArtifacts(wfArgs.Artifacts, wfSpecHolder.GetWorkflowSpec().Arguments.Artifacts)
 	}
 	if err != nil {
@@ -295,6 +306,14 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 	if _, err := wf.Spec.PodGC.GetLabelSelector(); err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
+	if err := validateWorkflowRetryStrategy(wf.Spec.WorkflowRetryStrategy); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "workflowRetryStrategy.%s", err.Error())
+	}
+	if wf.Spec.SLO != nil {
+		if _, err := wf.Spec.SLO.Deadline(time.Now()); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "slo.%s", err.Error())
+		}
+	}
 
 	// Check if all templates can be resolved.
 	// If the Workflow is using a WorkflowTemplateRef, then the templates of the referred WorkflowTemplate will be validated.
@@ -479,6 +498,15 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 
 	}
 
+	if newTmpl.Memoize != nil {
+		if newTmpl.Memoize.Auto && newTmpl.Memoize.Key != "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.key may not be set when memoize.auto is set", newTmpl.Name)
+		}
+		if !newTmpl.Memoize.Auto && newTmpl.Memoize.Key == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.key is required unless memoize.auto is set", newTmpl.Name)
+		}
+	}
+
 	templateScope := tmplCtx.GetTemplateScope()
 	tmplID := getTemplateID(tmpl)
 	_, ok := tctx.results[templateScope+tmplID]
@@ -591,6 +619,9 @@ func (tctx *templateValidationCtx) validateTemplateHolder(ctx context.Context, t
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if err := validateRetryRules(resolvedTmpl.RetryStrategy); err != nil {
+			return nil, fmt.Errorf("retryStrategy.%w", err)
+		}
 	}
 
 	return resolvedTmpl, tctx.validateTemplate(ctx, resolvedTmpl, tmplCtx, args, workflowTemplateValidation)
@@ -627,6 +658,10 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
+		err = validateParameterSchema(fmt.Sprintf("templates.%s.inputs.parameters.%s", tmpl.Name, param.Name), param)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if len(tmpl.Inputs.Parameters) > 0 {
 		scope["inputs.parameters"] = true
@@ -729,6 +764,9 @@ func validateNonLeaf(tmpl *wfv1.Template) error {
 	if tmpl.ActiveDeadlineSeconds != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds is only valid for leaf templates", tmpl.Name)
 	}
+	if tmpl.Checkpoint != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint is only valid for container and script templates", tmpl.Name)
+	}
 	return nil
 }
 
@@ -854,6 +892,24 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 	if tmpl.Parallelism != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.parallelism is only valid for steps and dag templates", tmpl.Name)
 	}
+	if tmpl.Checkpoint != nil {
+		if err := validateCheckpoint(tmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCheckpoint(tmpl *wfv1.Template) error {
+	if tmpl.GetType() != wfv1.TemplateTypeContainer && tmpl.GetType() != wfv1.TemplateTypeScript {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint is only valid for container and script templates", tmpl.Name)
+	}
+	if !path.IsAbs(tmpl.Checkpoint.Path) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.path must be an absolute path", tmpl.Name)
+	}
+	if _, err := tmpl.Checkpoint.GetInterval(); err != nil && !placeholderGenerator.IsPlaceholder(tmpl.Checkpoint.Interval) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.interval %s", tmpl.Name, err)
+	}
 	return nil
 }
 
@@ -899,6 +955,10 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef and supplied", prefix, param.Name)
 			}
 		}
+		err := validateParameterSchema(prefix+param.Name, param)
+		if err != nil {
+			return err
+		}
 		// validate enum
 		if param.Enum != nil {
 			if len(param.Enum) == 0 {
@@ -978,6 +1038,11 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 				return err
 			}
 
+			err = validateFanOut(step.ShouldExpand(), step.Parallelism, step.BatchSize, step.FailFastThreshold, step.SuccessThreshold)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			}
+
 			stepBytes, err := json.Marshal(step)
 			if err != nil {
 				return errors.InternalWrapError(err)
@@ -1111,6 +1176,8 @@ func (tctx *templateValidationCtx) addOutputsToScope(ctx context.Context, tmpl *
 		default:
 			scope[fmt.Sprintf("%s.outputs.parameters", prefix)] = true
 		}
+		scope[fmt.Sprintf("%s.outputs.succeededItems", prefix)] = true
+		scope[fmt.Sprintf("%s.outputs.failedItems", prefix)] = true
 	}
 	if isAncestor {
 		scope[fmt.Sprintf("%s.status", prefix)] = true
@@ -1135,9 +1202,23 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs %s", tmpl.Name, err.Error())
 	}
 
+	if tmpl.Outputs.ResultsFile != "" {
+		switch tmpl.GetType() {
+		case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript:
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.resultsFile only valid in container/script templates", tmpl.Name)
+		}
+	}
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
-		if tmpl.IsLeaf() {
+		if art.ResultsKey != "" {
+			if tmpl.Outputs.ResultsFile == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.resultsKey requires outputs.resultsFile", tmpl.Name, artRef)
+			}
+			if art.Path != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s shouldn't have both path and resultsKey specified", tmpl.Name, artRef)
+			}
+		} else if tmpl.IsLeaf() {
 			err = art.CleanPath()
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s: %s", tmpl.Name, artRef, err.Error())
@@ -1160,12 +1241,19 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 		if err != nil {
 			return err
 		}
+		err = validateParameterSchema(paramRef, param)
+		if err != nil {
+			return err
+		}
 		if param.ValueFrom != nil {
+			if param.ValueFrom.ResultsKey != "" && tmpl.Outputs.ResultsFile == "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s.resultsKey requires outputs.resultsFile", paramRef)
+			}
 			tmplType := tmpl.GetType()
 			switch tmplType {
 			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript:
-				if param.ValueFrom.Path == "" {
-					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
+				if param.ValueFrom.Path == "" && param.ValueFrom.ResultsKey == "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.path or resultsKey must be specified for %s templates", paramRef, tmplType)
 				}
 			case wfv1.TemplateTypeResource:
 				if param.ValueFrom.JQFilter == "" && param.ValueFrom.JSONPath == "" {
@@ -1202,7 +1290,7 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 		return errors.Errorf(errors.CodeBadRequest, "%s does not have valueFrom or value specified", paramRef)
 	}
 	paramTypes := 0
-	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter, param.ValueFrom.Expression} {
+	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter, param.ValueFrom.Expression, param.ValueFrom.ResultsKey} {
 		if value != "" {
 			paramTypes++
 		}
@@ -1212,10 +1300,33 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	}
 	switch paramTypes {
 	case 0:
-		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression")
+		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression, resultsKey")
 	case 1:
 	default:
-		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw")
+		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression, resultsKey")
+	}
+	return nil
+}
+
+// validateParameterSchema verifies that the schema of a parameter is valid and that its value and default match it
+func validateParameterSchema(paramRef string, param wfv1.Parameter) error {
+	if param.Schema == nil {
+		return nil
+	}
+	if err := param.Schema.Check(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s.schema %s", paramRef, err.Error())
+	}
+	for _, field := range []struct {
+		name  string
+		value *wfv1.AnyString
+	}{{"value", param.Value}, {"default", param.Default}} {
+		// values containing variables can only be checked once they are resolved
+		if field.value == nil || strings.Contains(field.value.String(), "{{") {
+			continue
+		}
+		if err := param.Schema.Validate(field.value.String()); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.%s %s", paramRef, field.name, err.Error())
+		}
 	}
 	return nil
 }
@@ -1313,7 +1424,26 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 	if err != nil {
 		return err
 	}
-	if len(tmpl.DAG.Tasks) == 0 {
+	if tmpl.DAG.TasksFrom != "" {
+		if strings.Contains(tmpl.DAG.TasksFrom, "{{") || placeholderGenerator.IsPlaceholder(tmpl.DAG.TasksFrom) {
+			// the tasks are not known until runtime, when they are validated by ValidateExpandedDAG
+			err = resolveAllVariables(scope, tctx.globalParams, tmpl.DAG.TasksFrom, workflowTemplateValidation)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.tasksFrom %s", tmpl.Name, err.Error())
+			}
+			if len(tmpl.DAG.Tasks) == 0 {
+				return nil
+			}
+		} else {
+			tasks, err := tmpl.DAG.ExpandTasks()
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.dag.%s", tmpl.Name, err.Error())
+			}
+			tmpl = tmpl.DeepCopy()
+			tmpl.DAG.Tasks = tasks
+			tmpl.DAG.TasksFrom = ""
+		}
+	} else if len(tmpl.DAG.Tasks) == 0 {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s must have at least one task", tmpl.Name)
 	}
 	usingDepends := false
@@ -1373,6 +1503,11 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
 
+		err = validateFanOut(task.ShouldExpand(), task.Parallelism, task.BatchSize, task.FailFastThreshold, task.SuccessThreshold)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
+
 		for depName, depType := range dagValidationCtx.GetTaskDependenciesWithDependencyTypes(ctx, task.Name) {
 			task, ok := dagValidationCtx.tasks[depName]
 			if !ok {
@@ -1381,7 +1516,7 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 					tmpl.Name, task.Name, depName)
 			} else if depType == common.DependencyTypeItems && len(task.WithItems) == 0 && task.WithParam == "" && task.WithSequence == nil {
 				return errors.Errorf(errors.CodeBadRequest,
-					"templates.%s.tasks.%s dependency '%s' uses an items-based condition such as .AnySucceeded or .AllFailed but does not contain any items",
+					"templates.%s.tasks.%s dependency '%s' uses an items-based condition such as .AnySucceeded, .AllFailed or .PartiallySucceeded but does not contain any items",
 					tmpl.Name, task.Name, depName)
 			}
 		}
@@ -1458,6 +1593,114 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 	return nil
 }
 
+// ValidateExpandedDAG validates a DAG template whose TasksFrom was expanded into its tasks when it started.
+// The tasks may refer to global variables and to each other, but not to the inputs of the template.
+func ValidateExpandedDAG(ctx context.Context, tmplCtx *templateresolution.TemplateContext, wf *wfv1.Workflow, tmpl *wfv1.Template, globalParams map[string]string) error {
+	tctx := newTemplateValidationCtx(wf, ValidateOpts{})
+	for k, v := range globalParams {
+		tctx.globalParams[k] = v
+	}
+	if len(tmpl.DAG.Tasks) == 0 {
+		return nil
+	}
+	return tctx.validateDAG(ctx, make(map[string]interface{}), tmplCtx, tmpl, false)
+}
+
+// validateRetryRules validates the jitter of the backoffs and the rules of a retry strategy
+func validateRetryRules(strategy *wfv1.RetryStrategy) error {
+	if err := validateBackoffJitter(strategy.Backoff); err != nil {
+		return err
+	}
+	for i, rule := range strategy.OnExitCodes {
+		if len(rule.ExitCodes) == 0 {
+			return fmt.Errorf("onExitCodes[%d].exitCodes is required", i)
+		}
+		if err := validateBackoffJitter(rule.Backoff); err != nil {
+			return fmt.Errorf("onExitCodes[%d].%w", i, err)
+		}
+	}
+	for i, rule := range strategy.OnErrorMessages {
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("onErrorMessages[%d].pattern is invalid: %w", i, err)
+		}
+		if err := validateBackoffJitter(rule.Backoff); err != nil {
+			return fmt.Errorf("onErrorMessages[%d].%w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateBackoffJitter(backoff *wfv1.Backoff) error {
+	if backoff == nil {
+		return nil
+	}
+	switch backoff.Jitter {
+	case wfv1.BackoffJitterFull, wfv1.BackoffJitterEqual, wfv1.BackoffJitterDecorrelated, "":
+		return nil
+	default:
+		return fmt.Errorf("backoff.jitter %s is not one of Full, Equal or Decorrelated", backoff.Jitter)
+	}
+}
+
+// validateWorkflowRetryStrategy validates the automatic retries of a workflow
+func validateWorkflowRetryStrategy(strategy *wfv1.WorkflowRetryStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+	limit, err := intstr.Int32(strategy.Limit)
+	if err != nil || limit == nil || *limit < 1 {
+		return fmt.Errorf("limit must be at least 1")
+	}
+	if backoff := strategy.Backoff; backoff != nil {
+		if backoff.MaxDuration != "" {
+			return fmt.Errorf("backoff.maxDuration is not supported")
+		}
+		if err := validateBackoffJitter(backoff); err != nil {
+			return err
+		}
+		for name, value := range map[string]string{"duration": backoff.Duration, "cap": backoff.Cap} {
+			if value == "" {
+				continue
+			}
+			if _, err := wfv1.ParseStringToDuration(value); err != nil {
+				return fmt.Errorf("backoff.%s is invalid: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateFanOut validates the parallelism, batch size and thresholds of a step or task
+func validateFanOut(shouldExpand bool, parallelism, batchSize *int64, failFastThreshold, successThreshold *k8sintstr.IntOrString) error {
+	if parallelism == nil && batchSize == nil && failFastThreshold == nil && successThreshold == nil {
+		return nil
+	}
+	if !shouldExpand {
+		return fmt.Errorf("parallelism, batchSize, failFastThreshold and successThreshold are only valid with withItems, withParam or withSequence")
+	}
+	if parallelism != nil && *parallelism < 1 {
+		return fmt.Errorf("parallelism must be at least 1")
+	}
+	if batchSize != nil && *batchSize < 1 {
+		return fmt.Errorf("batchSize must be at least 1")
+	}
+	if err := validateFanOutThreshold("failFastThreshold", failFastThreshold); err != nil {
+		return err
+	}
+	return validateFanOutThreshold("successThreshold", successThreshold)
+}
+
+func validateFanOutThreshold(name string, threshold *k8sintstr.IntOrString) error {
+	if threshold == nil {
+		return nil
+	}
+	value, err := k8sintstr.GetScaledValueFromIntOrPercent(threshold, 100, true)
+	if err != nil || value < 1 || (threshold.Type == k8sintstr.String && value > 100) {
+		return fmt.Errorf("%s must be a positive integer or a percentage between 1%% and 100%%", name)
+	}
+	return nil
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
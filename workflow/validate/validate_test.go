@@ -979,7 +979,7 @@ func TestInvalidOutputParam(t *testing.T) {
 	require.ErrorContains(t, err, "multiple valueFrom")
 
 	err = validate(logging.TestContext(t.Context()), invalidOutputIncompatibleValueFromPath)
-	require.ErrorContains(t, err, ".path must be specified for Container templates")
+	require.ErrorContains(t, err, ".path or resultsKey must be specified for Container templates")
 
 	err = validate(logging.TestContext(t.Context()), invalidOutputIncompatibleValueFromParam)
 	require.ErrorContains(t, err, ".parameter or expression must be specified for Steps templates")
@@ -3365,3 +3365,442 @@ func TestShouldCheckValidationToSpacedParameters(t *testing.T) {
 	// Do not allow leading or trailing spaces in parameters
 	require.ErrorContains(t, err, "failed to resolve {{  workflow.thisdoesnotexist  }}")
 }
+
+var checkpointWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: checkpoint-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: train
+        template: train
+  - name: train
+    retryStrategy:
+      limit: 3
+    checkpoint:
+      path: /mnt/checkpoint
+      interval: 5m
+    container:
+      image: "alpine:3.18"
+`
+
+func TestValidateCheckpoint(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, checkpointWorkflow))
+	})
+	t.Run("RelativePath", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(checkpointWorkflow, "path: /mnt/checkpoint", "path: checkpoint", 1))
+		require.ErrorContains(t, err, "templates.train.checkpoint.path must be an absolute path")
+	})
+	t.Run("InvalidInterval", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(checkpointWorkflow, "interval: 5m", "interval: often", 1))
+		require.ErrorContains(t, err, "templates.train.checkpoint.interval")
+	})
+	t.Run("Steps", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(checkpointWorkflow, "    steps:", "    checkpoint:\n      path: /mnt/checkpoint\n    steps:", 1))
+		require.ErrorContains(t, err, "templates.main.checkpoint")
+	})
+}
+
+var resultsFileWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: results-file-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: "alpine:3.18"
+    outputs:
+      resultsFile: /tmp/results.json
+      parameters:
+      - name: accuracy
+        valueFrom:
+          resultsKey: metrics.accuracy
+          default: "0"
+      artifacts:
+      - name: model
+        resultsKey: model
+`
+
+func TestValidateResultsFile(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, resultsFileWorkflow))
+	})
+	t.Run("NoResultsFile", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(resultsFileWorkflow, "resultsFile: /tmp/results.json", "", 1))
+		require.ErrorContains(t, err, "templates.main.outputs.artifacts.model.resultsKey requires outputs.resultsFile")
+	})
+	t.Run("PathAndResultsKey", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(resultsFileWorkflow, "resultsKey: model", "resultsKey: model\n        path: /tmp/model", 1))
+		require.ErrorContains(t, err, "templates.main.outputs.artifacts.model shouldn't have both path and resultsKey specified")
+	})
+	t.Run("MultipleValueFrom", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(resultsFileWorkflow, "resultsKey: metrics.accuracy", "resultsKey: metrics.accuracy\n          path: /tmp/accuracy", 1))
+		require.ErrorContains(t, err, "multiple valueFrom types specified")
+	})
+}
+
+var parameterSchemaWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: parameter-schema-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: replicas
+      value: "3"
+      schema:
+        type: integer
+        minimum: 1
+        maximum: 5
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: replicas
+        value: "{{workflow.parameters.replicas}}"
+        schema:
+          type: integer
+      - name: tags
+        default: '["a", "b"]'
+        schema:
+          type: array
+          items:
+            type: string
+    container:
+      image: "alpine:3.18"
+    outputs:
+      parameters:
+      - name: result
+        valueFrom:
+          path: /tmp/result.json
+        schema:
+          type: object
+          required: [status]
+`
+
+func TestValidateParameterSchema(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, parameterSchemaWorkflow))
+	})
+	t.Run("ArgumentValue", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(parameterSchemaWorkflow, `value: "3"`, `value: "7"`, 1))
+		require.EqualError(t, err, "spec.arguments.replicas.value value 7 is greater than the maximum 5")
+	})
+	t.Run("InputDefault", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(parameterSchemaWorkflow, `default: '["a", "b"]'`, `default: 'a,b'`, 1))
+		require.EqualError(t, err, `templates.main.inputs.parameters.tags.default value "a,b" is not an array`)
+	})
+	t.Run("UnknownType", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(parameterSchemaWorkflow, "type: object", "type: dict", 1))
+		require.ErrorContains(t, err, `templates.main.outputs.parameters.result.schema unknown type "dict"`)
+	})
+}
+
+var autoMemoizeWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: auto-memoize-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      auto: true
+      cache:
+        configMap:
+          name: my-cache
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateAutoMemoize(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Auto", func(t *testing.T) {
+		require.NoError(t, validate(ctx, autoMemoizeWorkflow))
+	})
+	t.Run("AutoAndKey", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(autoMemoizeWorkflow, "auto: true", "auto: true\n      key: foo", 1))
+		require.EqualError(t, err, "templates.main.memoize.key may not be set when memoize.auto is set")
+	})
+	t.Run("NoKey", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(autoMemoizeWorkflow, "auto: true", "maxAge: 1h", 1))
+		require.EqualError(t, err, "templates.main.memoize.key is required unless memoize.auto is set")
+	})
+}
+
+var dagTasksFromWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-tasks-from-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+      - name: expand
+        depends: generate
+        template: expand
+        arguments:
+          parameters:
+          - name: tasks
+            value: "{{tasks.generate.outputs.result}}"
+  - name: generate
+    script:
+      image: python:alpine3.6
+      command: [python]
+      source: print('[]')
+  - name: expand
+    inputs:
+      parameters:
+      - name: tasks
+    dag:
+      tasksFrom: "{{inputs.parameters.tasks}}"
+  - name: echo
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateDAGTasksFrom(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Parameter", func(t *testing.T) {
+		require.NoError(t, validate(ctx, dagTasksFromWorkflow))
+	})
+	t.Run("UndefinedParameter", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(dagTasksFromWorkflow, `tasksFrom: "{{inputs.parameters.tasks}}"`, `tasksFrom: "{{inputs.parameters.missing}}"`, 1))
+		require.ErrorContains(t, err, "failed to resolve {{inputs.parameters.missing}}")
+	})
+	t.Run("Literal", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(dagTasksFromWorkflow, `tasksFrom: "{{inputs.parameters.tasks}}"`, `tasksFrom: '[{"name": "a", "template": "echo"}]'`, 1))
+		require.NoError(t, err)
+	})
+	t.Run("InvalidLiteral", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(dagTasksFromWorkflow, `tasksFrom: "{{inputs.parameters.tasks}}"`, `tasksFrom: '[{"name": "a", "template": "missing"}]'`, 1))
+		require.EqualError(t, err, "templates.main.tasks.expand templates.expand.tasks.a template name 'missing' undefined")
+	})
+}
+
+var fanOutWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: fan-out-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: echo
+        withSequence:
+          count: "10"
+        parallelism: 2
+        failFastThreshold: 5%
+  - name: echo
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateFanOut(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, fanOutWorkflow))
+	})
+	t.Run("NotExpanded", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(fanOutWorkflow, "withSequence:\n          count: \"10\"\n        ", "", 1))
+		require.EqualError(t, err, "templates.main.tasks.a parallelism, batchSize, failFastThreshold and successThreshold are only valid with withItems, withParam or withSequence")
+	})
+	t.Run("Parallelism", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(fanOutWorkflow, "parallelism: 2", "parallelism: 0", 1))
+		require.EqualError(t, err, "templates.main.tasks.a parallelism must be at least 1")
+	})
+	t.Run("BatchSize", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(fanOutWorkflow, "parallelism: 2", "batchSize: 0", 1))
+		require.EqualError(t, err, "templates.main.tasks.a batchSize must be at least 1")
+	})
+	t.Run("FailFastThreshold", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(fanOutWorkflow, "failFastThreshold: 5%", "failFastThreshold: 150%", 1))
+		require.EqualError(t, err, "templates.main.tasks.a failFastThreshold must be a positive integer or a percentage between 1% and 100%")
+	})
+	t.Run("SuccessThreshold", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(fanOutWorkflow, "failFastThreshold: 5%", "successThreshold: 0", 1))
+		require.EqualError(t, err, "templates.main.tasks.a successThreshold must be a positive integer or a percentage between 1% and 100%")
+	})
+	t.Run("PartiallySucceeded", func(t *testing.T) {
+		require.NoError(t, validate(ctx, partiallySucceededWorkflow))
+	})
+	t.Run("PartiallySucceededWithoutItems", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(partiallySucceededWorkflow, "        withItems: [x, y, z]\n        successThreshold: 2\n", "", 1))
+		require.ErrorContains(t, err, "dependency 'a' uses an items-based condition such as .AnySucceeded, .AllFailed or .PartiallySucceeded but does not contain any items")
+	})
+}
+
+var partiallySucceededWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: partially-succeeded-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: echo
+        withItems: [x, y, z]
+        successThreshold: 2
+      - name: b
+        template: echo-failed
+        depends: a.PartiallySucceeded
+        arguments:
+          parameters:
+          - name: failed
+            value: "{{tasks.a.outputs.failedItems}}"
+  - name: echo
+    container:
+      image: argoproj/argosay:v2
+  - name: echo-failed
+    inputs:
+      parameters:
+      - name: failed
+    container:
+      image: argoproj/argosay:v2
+      args: [echo, "{{inputs.parameters.failed}}"]
+`
+
+var workflowRetryStrategyWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: workflow-retry-
+spec:
+  entrypoint: main
+  workflowRetryStrategy:
+    limit: 2
+    backoff:
+      duration: 1m
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateWorkflowRetryStrategy(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, workflowRetryStrategyWorkflow))
+	})
+	t.Run("Limit", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(workflowRetryStrategyWorkflow, "limit: 2", "limit: 0", 1))
+		require.EqualError(t, err, "workflowRetryStrategy.limit must be at least 1")
+	})
+	t.Run("MaxDuration", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(workflowRetryStrategyWorkflow, "duration: 1m", "maxDuration: 1h", 1))
+		require.EqualError(t, err, "workflowRetryStrategy.backoff.maxDuration is not supported")
+	})
+}
+
+var retryRulesWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-rules-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      backoff:
+        duration: 10s
+        jitter: Full
+      onExitCodes:
+      - exitCodes: [2]
+        limit: 0
+      onErrorMessages:
+      - pattern: OOMKilled
+        backoff:
+          duration: 1m
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateRetryRules(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, retryRulesWorkflow))
+	})
+	t.Run("Jitter", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(retryRulesWorkflow, "jitter: Full", "jitter: Half", 1))
+		require.EqualError(t, err, "retryStrategy.backoff.jitter Half is not one of Full, Equal or Decorrelated")
+	})
+	t.Run("ExitCodes", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(retryRulesWorkflow, "exitCodes: [2]", "exitCodes: []", 1))
+		require.EqualError(t, err, "retryStrategy.onExitCodes[0].exitCodes is required")
+	})
+	t.Run("Pattern", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(retryRulesWorkflow, "pattern: OOMKilled", "pattern: (OOMKilled", 1))
+		require.ErrorContains(t, err, "retryStrategy.onErrorMessages[0].pattern is invalid")
+	})
+}
+
+var sloWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: slo-
+spec:
+  entrypoint: main
+  slo:
+    maxDuration: 2h
+    completeBy: "06:00"
+    timezone: America/Los_Angeles
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestValidateSLO(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validate(ctx, sloWorkflow))
+	})
+	t.Run("MaxDuration", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(sloWorkflow, "maxDuration: 2h", "maxDuration: soon", 1))
+		require.ErrorContains(t, err, "slo.maxDuration is invalid")
+	})
+	t.Run("CompleteBy", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(sloWorkflow, `completeBy: "06:00"`, `completeBy: "6am"`, 1))
+		require.ErrorContains(t, err, "slo.completeBy is invalid")
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(sloWorkflow, "timezone: America/Los_Angeles", "timezone: Mars/Olympus", 1))
+		require.ErrorContains(t, err, "slo.timezone is invalid")
+	})
+	t.Run("Empty", func(t *testing.T) {
+		err := validate(ctx, strings.Replace(sloWorkflow, `    maxDuration: 2h
+    completeBy: "06:00"
+`, "", 1))
+		require.EqualError(t, err, "slo.maxDuration or completeBy is required")
+	})
+}